@@ -7,14 +7,19 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"regexp"
 	"syscall"
 
 	postgres "github.com/IAmFutureHokage/HL-BufferService/internal/app/infrastructure"
 	"github.com/IAmFutureHokage/HL-BufferService/internal/app/migration"
 	"github.com/IAmFutureHokage/HL-BufferService/internal/app/services"
 	pb "github.com/IAmFutureHokage/HL-BufferService/internal/proto"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/alert"
 	"github.com/IAmFutureHokage/HL-BufferService/pkg/database"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/validation"
 	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/outbox"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/publisher"
 	"github.com/Shopify/sarama"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
@@ -22,7 +27,24 @@ import (
 
 var dbConfig database.Config
 var kafkaConfig kafka.KafkaConfig
-var kafkaProducer sarama.SyncProducer
+var publisherConfig publisher.Config
+var validationConfig = validation.DefaultConfig()
+var stationPolicy = services.StationPolicyWarn
+
+// alertsConfig lists the alert sinks. Alerts are published to Topic when it
+// is set; webhooks and mail servers receive the alerts of their level.
+type alertsConfig struct {
+	alert.Config `mapstructure:",squash"`
+	Topic        string                `mapstructure:"topic"`
+	Webhooks     []alert.WebhookConfig `mapstructure:"webhooks"`
+	SMTP         []alert.SMTPConfig    `mapstructure:"smtp"`
+}
+
+var alertConfig = alertsConfig{Config: alert.DefaultConfig()}
+
+var outboxConfig = outbox.DefaultConfig()
+
+var envelopePatterns []*regexp.Regexp
 
 func init() {
 	env := os.Getenv("APP_ENV")
@@ -50,12 +72,48 @@ func init() {
 	kafkaConfig = kafka.KafkaConfig{
 		BrokerList: viper.GetStringSlice("kafka.broker_list"),
 		Topic:      viper.GetString("kafka.topic"),
+		Topics:     viper.GetStringMapString("kafka.topics"),
+		Format:     kafka.Format(viper.GetString("kafka.format")),
+	}
+	if err := viper.UnmarshalKey("kafka.schema_registry", &kafkaConfig.SchemaRegistry); err != nil {
+		log.Fatalf("Error reading schema registry config: %s", err)
+	}
+	if err := viper.UnmarshalKey("kafka.consumer", &kafkaConfig.Consumer); err != nil {
+		log.Fatalf("Error reading Kafka consumer config: %s", err)
 	}
 
-	var err error
-	kafkaProducer, err = kafka.NewKafkaProducer(kafkaConfig)
-	if err != nil {
-		log.Fatalf("Error creating Kafka producer: %v", err)
+	// Ranges from the config file are merged into the default ones.
+	if err := viper.UnmarshalKey("validation", &validationConfig); err != nil {
+		log.Fatalf("Error reading validation config: %s", err)
+	}
+
+	if policy := viper.GetString("stations.policy"); policy != "" {
+		stationPolicy = services.StationPolicy(policy)
+	}
+	switch stationPolicy {
+	case services.StationPolicyIgnore, services.StationPolicyWarn, services.StationPolicyReject:
+	default:
+		log.Fatalf("Unknown station policy: %s", stationPolicy)
+	}
+
+	for _, pattern := range viper.GetStringSlice("envelope.patterns") {
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			log.Fatalf("Error compiling envelope pattern %q: %s", pattern, err)
+		}
+		envelopePatterns = append(envelopePatterns, compiled)
+	}
+
+	if err := viper.UnmarshalKey("alerts", &alertConfig); err != nil {
+		log.Fatalf("Error reading alerts config: %s", err)
+	}
+
+	if err := viper.UnmarshalKey("outbox", &outboxConfig); err != nil {
+		log.Fatalf("Error reading outbox config: %s", err)
+	}
+
+	if err := viper.UnmarshalKey("publisher", &publisherConfig); err != nil {
+		log.Fatalf("Error reading publisher config: %s", err)
 	}
 }
 
@@ -83,13 +141,82 @@ func main() {
 	if _, err := dbPool.Exec(context.Background(), migration.CreateTablesTelegramAndPhenomenia); err != nil {
 		log.Fatalf("Failed to execute migration: %v", err)
 	}
+	if _, err := dbPool.Exec(context.Background(), migration.CreateTableStation); err != nil {
+		log.Fatalf("Failed to execute migration: %v", err)
+	}
+
+	if _, err := dbPool.Exec(context.Background(), migration.CreateTableOutbox); err != nil {
+		log.Fatalf("Failed to execute migration: %v", err)
+	}
+
+	serializer, err := kafka.NewSerializer(kafkaConfig)
+	if err != nil {
+		log.Fatalf("Error creating Kafka serializer: %v", err)
+	}
+
+	messagePublisher, err := publisher.New(publisherConfig, kafkaConfig)
+	if err != nil {
+		log.Fatalf("Error creating publisher: %v", err)
+	}
 
 	postgresStorage := postgres.NewHydrologyBufferStorage(dbPool)
-	hydrologyBufferService := services.NewHydrologyBufferService(postgresStorage, kafkaProducer)
+	hydrologyBufferService := services.NewHydrologyBufferService(postgresStorage, messagePublisher)
+	hydrologyBufferService.SetOutboxConfig(outboxConfig)
 	hydrologyBufferService.SetKafkaConfig(kafkaConfig)
+	hydrologyBufferService.SetSerializer(serializer)
+	hydrologyBufferService.SetValidationConfig(validationConfig)
+	hydrologyBufferService.SetStationRegistry(postgresStorage, stationPolicy)
+	hydrologyBufferService.SetEnvelopePatterns(envelopePatterns)
+
+	if _, err := dbPool.Exec(context.Background(), migration.CreateTableAlert); err != nil {
+		log.Fatalf("Failed to execute migration: %v", err)
+	}
+
+	alerts := alert.NewDispatcher(postgres.NewAlertStorage(dbPool), alertConfig.Config)
+	if alertConfig.Topic != "" {
+		alerts.AddSink(0, alert.NewPublisherSink(messagePublisher, alertConfig.Topic))
+	}
+	for _, webhook := range alertConfig.Webhooks {
+		alerts.AddSink(webhook.Level, alert.NewWebhookSink(webhook))
+	}
+	for _, mail := range alertConfig.SMTP {
+		alerts.AddSink(mail.Level, alert.NewSMTPSink(mail))
+	}
+	hydrologyBufferService.SetAlertDispatcher(alerts)
+
+	alertsCtx, stopAlerts := context.WithCancel(context.Background())
+	defer stopAlerts()
+	go alerts.Run(alertsCtx, func(err error) {
+		log.Printf("Alert delivery: %v", err)
+	})
+
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	defer stopRelay()
+	go hydrologyBufferService.RunOutbox(relayCtx, func(err error) {
+		log.Printf("Outbox relay: %v", err)
+	})
+
+	ingestCtx, stopIngest := context.WithCancel(context.Background())
+	defer stopIngest()
+	var consumer *kafka.Consumer
+	var deadLetterProducer sarama.SyncProducer
+	if kafkaConfig.Consumer.Topic != "" {
+		deadLetterProducer, err = kafka.NewKafkaProducer(kafkaConfig)
+		if err != nil {
+			log.Fatalf("Error creating Kafka producer: %v", err)
+		}
+		consumer, err = kafka.NewConsumer(kafkaConfig.BrokerList, kafkaConfig.Consumer, deadLetterProducer, hydrologyBufferService.IngestTelegram)
+		if err != nil {
+			log.Fatalf("Error creating Kafka consumer: %v", err)
+		}
+		go consumer.Run(ingestCtx, func(err error) {
+			log.Printf("Telegram ingestion: %v", err)
+		})
+	}
 
 	s := grpc.NewServer()
 	pb.RegisterHydrologyBufferServiceServer(s, hydrologyBufferService)
+	pb.RegisterStationRegistryServiceServer(s, services.NewStationRegistryService(postgresStorage))
 
 	log.Printf("Server listening at %v", lis.Addr())
 
@@ -105,8 +232,19 @@ func main() {
 
 	fmt.Println("Shutting down server...")
 	s.GracefulStop()
-	if err := kafkaProducer.Close(); err != nil {
-		log.Printf("Error closing Kafka producer: %v", err)
+	stopAlerts()
+	stopRelay()
+	stopIngest()
+	if consumer != nil {
+		if err := consumer.Close(); err != nil {
+			log.Printf("Error closing Kafka consumer: %v", err)
+		}
+		if err := deadLetterProducer.Close(); err != nil {
+			log.Printf("Error closing Kafka producer: %v", err)
+		}
+	}
+	if err := messagePublisher.Close(); err != nil {
+		log.Printf("Error closing publisher: %v", err)
 	}
 	log.Fatalf("Server gracefully stopped")
 }
@@ -0,0 +1,212 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/alert"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// AlertStorage implements alert.Store.
+type AlertStorage struct {
+	dbPool *pgxpool.Pool
+}
+
+func NewAlertStorage(pool *pgxpool.Pool) *AlertStorage {
+	return &AlertStorage{dbPool: pool}
+}
+
+var alertColumns = []any{
+	"id",
+	"key",
+	"telegramid",
+	"postcode",
+	"stationname",
+	"datetime",
+	"severity",
+	"isdangerous",
+	"reason",
+	"level",
+	"createdat",
+	"notifiedat",
+	"acknowledgedat",
+	"acknowledgedby",
+}
+
+func (r *AlertStorage) Open(ctx context.Context, a *alert.Alert, since time.Time) (opened bool, err error) {
+
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil || !opened {
+			tx.Rollback(ctx)
+			return
+		}
+		err = tx.Commit(ctx)
+	}()
+
+	// Concurrent alerts with the same key wait for each other so that only
+	// one of them is opened.
+	if _, err = tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", a.Key); err != nil {
+		return false, err
+	}
+
+	var open bool
+	err = tx.QueryRow(ctx,
+		"SELECT EXISTS (SELECT 1 FROM alert WHERE key = $1 AND acknowledgedat IS NULL AND createdat >= $2)",
+		a.Key, since,
+	).Scan(&open)
+	if err != nil || open {
+		return false, err
+	}
+
+	sqlScript, args, err := goqu.Insert("alert").Rows(goqu.Record{
+		"id":             a.Id,
+		"key":            a.Key,
+		"telegramid":     a.TelegramId,
+		"postcode":       a.PostCode,
+		"stationname":    a.StationName,
+		"datetime":       a.DateTime,
+		"severity":       a.Severity,
+		"isdangerous":    a.IsDangerous,
+		"reason":         a.Reason,
+		"level":          a.Level,
+		"createdat":      a.CreatedAt,
+		"notifiedat":     a.NotifiedAt,
+		"acknowledgedat": a.AcknowledgedAt,
+		"acknowledgedby": a.AcknowledgedBy,
+	}).ToSQL()
+	if err != nil {
+		return false, err
+	}
+
+	if _, err = tx.Exec(ctx, sqlScript, args...); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *AlertStorage) Acknowledge(ctx context.Context, id uuid.UUID, by string, at time.Time) (*alert.Alert, error) {
+
+	_, err := r.dbPool.Exec(ctx,
+		"UPDATE alert SET acknowledgedat = $2, acknowledgedby = $3 WHERE id = $1 AND acknowledgedat IS NULL",
+		id, at, by,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlScript, args, err := goqu.From("alert").Select(alertColumns...).Where(goqu.Ex{"id": id}).ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := scanAlert(r.dbPool.QueryRow(ctx, sqlScript, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, alert.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func (r *AlertStorage) Undelivered(ctx context.Context) ([]alert.Alert, error) {
+	return r.query(ctx,
+		goqu.C("acknowledgedat").IsNull(),
+		goqu.C("notifiedat").IsNull(),
+	)
+}
+
+func (r *AlertStorage) Due(ctx context.Context, notifiedBefore time.Time, maxLevel int) ([]alert.Alert, error) {
+	return r.query(ctx,
+		goqu.C("acknowledgedat").IsNull(),
+		goqu.C("level").Lt(maxLevel),
+		goqu.L("COALESCE(notifiedat, createdat)").Lt(notifiedBefore),
+	)
+}
+
+// query returns the alerts matching the conditions, oldest first.
+func (r *AlertStorage) query(ctx context.Context, conditions ...goqu.Expression) ([]alert.Alert, error) {
+
+	sqlScript, args, err := goqu.
+		From("alert").
+		Select(alertColumns...).
+		Where(conditions...).
+		Order(goqu.C("createdat").Asc()).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbPool.Query(ctx, sqlScript, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var alerts []alert.Alert
+
+	for rows.Next() {
+		a, err := scanAlert(rows)
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, *a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return alerts, nil
+}
+
+func (r *AlertStorage) Escalate(ctx context.Context, id uuid.UUID, level int, at time.Time) error {
+
+	result, err := r.dbPool.Exec(ctx, "UPDATE alert SET level = $2, notifiedat = $3 WHERE id = $1", id, level, at)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return alert.ErrNotFound
+	}
+
+	return nil
+}
+
+func scanAlert(row pgx.Row) (*alert.Alert, error) {
+
+	var a alert.Alert
+
+	err := row.Scan(
+		&a.Id,
+		&a.Key,
+		&a.TelegramId,
+		&a.PostCode,
+		&a.StationName,
+		&a.DateTime,
+		&a.Severity,
+		&a.IsDangerous,
+		&a.Reason,
+		&a.Level,
+		&a.CreatedAt,
+		&a.NotifiedAt,
+		&a.AcknowledgedAt,
+		&a.AcknowledgedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
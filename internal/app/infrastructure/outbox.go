@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/outbox"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/google/uuid"
+)
+
+// TransferTelegrams marks the telegrams transferred and stores the messages
+// carrying their data in one transaction. It fails when any of them is
+// missing or already transferred.
+func (r *HydrologyBufferStorage) TransferTelegrams(ctx context.Context, ids []uuid.UUID, messages []outbox.Message, at time.Time) (err error) {
+
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+		err = tx.Commit(ctx)
+	}()
+
+	result, err := tx.Exec(ctx, "UPDATE telegram SET transferredat = $2 WHERE id = ANY($1) AND transferredat IS NULL", ids, at)
+	if err != nil {
+		return err
+	}
+	if transferred := result.RowsAffected(); transferred != int64(len(ids)) {
+		return fmt.Errorf("%d of %d telegrams are missing or already transferred", int64(len(ids))-transferred, len(ids))
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	rows := make([]any, len(messages))
+	for i, message := range messages {
+		headers, err := json.Marshal(message.Headers)
+		if err != nil {
+			return err
+		}
+		rows[i] = goqu.Record{
+			"id":            message.Id,
+			"topic":         message.Topic,
+			"key":           message.Key,
+			"headers":       string(headers),
+			"telegramids":   messageTelegrams(message),
+			"payload":       message.Payload,
+			"createdat":     at,
+			"nextattemptat": at,
+		}
+	}
+
+	sqlScript, args, err := goqu.Insert("outbox").Prepared(true).Rows(rows...).ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, sqlScript, args...)
+	return err
+}
+
+// Claim implements outbox.Store. Claims are serialized by an advisory lock
+// because the NOT EXISTS hold-back alone reads the lease of earlier
+// messages as of the start of the statement, which would let a relay take a
+// later message of a key whose earlier one another relay is claiming.
+func (r *HydrologyBufferStorage) Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) (messages []outbox.Message, err error) {
+
+	tx, err := r.dbPool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+		err = tx.Commit(ctx)
+	}()
+
+	if _, err = tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('outbox_claim'))"); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, `
+		UPDATE outbox SET lockeduntil = $2
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE sentat IS NULL AND nextattemptat <= $1 AND (lockeduntil IS NULL OR lockeduntil <= $1)
+				AND NOT EXISTS (
+					SELECT 1 FROM outbox earlier
+					WHERE earlier.key = outbox.key AND earlier.key <> '' AND earlier.seq < outbox.seq
+						AND earlier.sentat IS NULL AND (earlier.nextattemptat > $1 OR earlier.lockeduntil > $1)
+				)
+			ORDER BY seq
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, topic, key, headers, payload, attempts, seq`,
+		now, now.Add(lease), limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var order []int64
+
+	for rows.Next() {
+		var message outbox.Message
+		var seq int64
+		if err = rows.Scan(&message.Id, &message.Topic, &message.Key, &message.Headers, &message.Payload, &message.Attempts, &seq); err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+		order = append(order, seq)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	// RETURNING does not keep the order of the subquery.
+	sortBySeq(messages, order)
+
+	return messages, nil
+}
+
+func (r *HydrologyBufferStorage) MarkSent(ctx context.Context, id uuid.UUID, at time.Time) error {
+	_, err := r.dbPool.Exec(ctx, "UPDATE outbox SET sentat = $2, lockeduntil = NULL WHERE id = $1", id, at)
+	return err
+}
+
+func (r *HydrologyBufferStorage) MarkFailed(ctx context.Context, id uuid.UUID, attempts int, next time.Time, reason string) error {
+	_, err := r.dbPool.Exec(ctx,
+		"UPDATE outbox SET attempts = $2, nextattemptat = $3, lasterror = $4, lockeduntil = NULL WHERE id = $1",
+		id, attempts, next, reason,
+	)
+	return err
+}
+
+// Purge removes the messages sent before and the telegrams transferred
+// before whose own messages were all sent.
+func (r *HydrologyBufferStorage) Purge(ctx context.Context, before time.Time) error {
+
+	if _, err := r.dbPool.Exec(ctx, "DELETE FROM outbox WHERE sentat < $1", before); err != nil {
+		return err
+	}
+
+	_, err := r.dbPool.Exec(ctx, `
+		DELETE FROM telegram
+		WHERE transferredat < $1
+			AND NOT EXISTS (SELECT 1 FROM outbox WHERE sentat IS NULL AND telegram.id = ANY(outbox.telegramids))`,
+		before,
+	)
+	return err
+}
+
+// messageTelegrams lists the telegrams whose data a message carries, which
+// Purge keeps until the message is sent.
+func messageTelegrams(message outbox.Message) textArray {
+
+	ids := message.Headers[kafka.HeaderTelegramId]
+	if ids == "" {
+		return textArray{}
+	}
+
+	return strings.Split(ids, ",")
+}
+
+func sortBySeq(messages []outbox.Message, seq []int64) {
+	for i := 1; i < len(messages); i++ {
+		for j := i; j > 0 && seq[j] < seq[j-1]; j-- {
+			messages[j], messages[j-1] = messages[j-1], messages[j]
+			seq[j], seq[j-1] = seq[j-1], seq[j]
+		}
+	}
+}
@@ -3,12 +3,18 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/IAmFutureHokage/HL-BufferService/internal/app/model"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/validation"
 	"github.com/doug-martin/goqu/v9"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 )
 
@@ -67,6 +73,18 @@ func (r *HydrologyBufferStorage) AddTelegram(ctx context.Context, data []model.T
 				"isreservoirwaterinflowdate": telegram.IsReservoirWaterInflowDate,
 				"inflow":                     telegram.Inflow,
 				"reset":                      telegram.Reset,
+				"measureddischargemonth":     telegram.MeasuredDischargeMonth,
+				"measuredwaterlevel":         telegram.MeasuredWaterLevel,
+				"measureddischarge":          telegram.MeasuredDischarge,
+				"crosssectionarea":           telegram.CrossSectionArea,
+				"averagevelocity":            telegram.AverageVelocity,
+				"maxdepth":                   telegram.MaxDepth,
+				"measurementtime":            telegram.MeasurementTime,
+				"sender":                     telegram.Sender,
+				"bulletintime":               telegram.BulletinTime,
+				"notmeasured":                notMeasured(&telegram),
+				"warnings":                   telegram.Warnings,
+				"severity":                   telegram.Severity,
 			},
 		)
 
@@ -137,6 +155,19 @@ func (r *HydrologyBufferStorage) GetTelegramByID(ctx context.Context, id uuid.UU
 			goqu.I("telegram.isreservoirwaterinflowdate"),
 			goqu.I("telegram.inflow"),
 			goqu.I("telegram.reset"),
+			goqu.I("telegram.measureddischargemonth"),
+			goqu.I("telegram.measuredwaterlevel"),
+			goqu.I("telegram.measureddischarge"),
+			goqu.I("telegram.crosssectionarea"),
+			goqu.I("telegram.averagevelocity"),
+			goqu.I("telegram.maxdepth"),
+			goqu.I("telegram.measurementtime"),
+			goqu.I("telegram.sender"),
+			goqu.I("telegram.bulletintime"),
+			goqu.I("telegram.notmeasured"),
+			goqu.I("telegram.warnings"),
+			goqu.I("telegram.severity"),
+			goqu.COALESCE(goqu.I("station.name"), "").As("stationname"),
 			goqu.I("phenomenia.id").As("phenomenia_id"),
 			goqu.I("phenomenia.telegramid"),
 			goqu.I("phenomenia.phenomen"),
@@ -147,7 +178,11 @@ func (r *HydrologyBufferStorage) GetTelegramByID(ctx context.Context, id uuid.UU
 			goqu.I("phenomenia"),
 			goqu.On(goqu.Ex{"telegram.id": goqu.I("phenomenia.telegramid")}),
 		).
-		Where(goqu.Ex{"telegram.id": id})
+		LeftJoin(
+			goqu.I("station"),
+			goqu.On(goqu.Ex{"telegram.postcode": goqu.I("station.postcode")}),
+		).
+		Where(goqu.Ex{"telegram.id": id}, goqu.I("telegram.transferredat").IsNull())
 
 	sqlScript, args, err := selectBuilder.ToSQL()
 	if err != nil {
@@ -164,6 +199,7 @@ func (r *HydrologyBufferStorage) GetTelegramByID(ctx context.Context, id uuid.UU
 
 	for rows.Next() {
 		var telegram model.Telegram
+		var notMeasuredColumns []string
 		var phenomeniaId *uuid.UUID
 		var phenomeniaTelegramId uuid.UUID
 		var phenomeniaPhenomen sql.NullByte
@@ -197,6 +233,19 @@ func (r *HydrologyBufferStorage) GetTelegramByID(ctx context.Context, id uuid.UU
 			&telegram.IsReservoirWaterInflowDate,
 			&telegram.Inflow,
 			&telegram.Reset,
+			&telegram.MeasuredDischargeMonth,
+			&telegram.MeasuredWaterLevel,
+			&telegram.MeasuredDischarge,
+			&telegram.CrossSectionArea,
+			&telegram.AverageVelocity,
+			&telegram.MaxDepth,
+			&telegram.MeasurementTime,
+			&telegram.Sender,
+			&telegram.BulletinTime,
+			&notMeasuredColumns,
+			&telegram.Warnings,
+			&telegram.Severity,
+			&telegram.StationName,
 			&phenomeniaId,
 			&phenomeniaTelegramId,
 			&phenomeniaPhenomen,
@@ -207,6 +256,8 @@ func (r *HydrologyBufferStorage) GetTelegramByID(ctx context.Context, id uuid.UU
 			return &model.Telegram{}, err
 		}
 
+		setNotMeasured(&telegram, notMeasuredColumns)
+
 		if tg.Id != id {
 			tg = telegram
 		}
@@ -246,7 +297,7 @@ func (r *HydrologyBufferStorage) RemoveTelegrams(ctx context.Context, ids []uuid
 		}
 	}()
 
-	result, err := tx.Exec(ctx, "DELETE FROM telegram WHERE id = ANY($1)", ids)
+	result, err := tx.Exec(ctx, "DELETE FROM telegram WHERE id = ANY($1) AND transferredat IS NULL", ids)
 	if err != nil {
 		return err
 	}
@@ -288,6 +339,19 @@ func (r *HydrologyBufferStorage) GetAll(ctx context.Context) (*[]model.Telegram,
 			goqu.I("telegram.isreservoirwaterinflowdate"),
 			goqu.I("telegram.inflow"),
 			goqu.I("telegram.reset"),
+			goqu.I("telegram.measureddischargemonth"),
+			goqu.I("telegram.measuredwaterlevel"),
+			goqu.I("telegram.measureddischarge"),
+			goqu.I("telegram.crosssectionarea"),
+			goqu.I("telegram.averagevelocity"),
+			goqu.I("telegram.maxdepth"),
+			goqu.I("telegram.measurementtime"),
+			goqu.I("telegram.sender"),
+			goqu.I("telegram.bulletintime"),
+			goqu.I("telegram.notmeasured"),
+			goqu.I("telegram.warnings"),
+			goqu.I("telegram.severity"),
+			goqu.COALESCE(goqu.I("station.name"), "").As("stationname"),
 			goqu.I("phenomenia.id").As("phenomenia_id"),
 			goqu.I("phenomenia.telegramid"),
 			goqu.I("phenomenia.phenomen"),
@@ -297,7 +361,12 @@ func (r *HydrologyBufferStorage) GetAll(ctx context.Context) (*[]model.Telegram,
 		LeftJoin(
 			goqu.I("phenomenia"),
 			goqu.On(goqu.Ex{"telegram.id": goqu.I("phenomenia.telegramid")}),
-		)
+		).
+		LeftJoin(
+			goqu.I("station"),
+			goqu.On(goqu.Ex{"telegram.postcode": goqu.I("station.postcode")}),
+		).
+		Where(goqu.I("telegram.transferredat").IsNull())
 
 	sqlScript, args, err := selectBuilder.ToSQL()
 	if err != nil {
@@ -314,6 +383,7 @@ func (r *HydrologyBufferStorage) GetAll(ctx context.Context) (*[]model.Telegram,
 
 	for rows.Next() {
 		var telegram model.Telegram
+		var notMeasuredColumns []string
 		var phenomeniaId *uuid.UUID
 		var phenomeniaTelegramId uuid.UUID
 		var phenomeniaPhenomen sql.NullByte
@@ -347,6 +417,19 @@ func (r *HydrologyBufferStorage) GetAll(ctx context.Context) (*[]model.Telegram,
 			&telegram.IsReservoirWaterInflowDate,
 			&telegram.Inflow,
 			&telegram.Reset,
+			&telegram.MeasuredDischargeMonth,
+			&telegram.MeasuredWaterLevel,
+			&telegram.MeasuredDischarge,
+			&telegram.CrossSectionArea,
+			&telegram.AverageVelocity,
+			&telegram.MaxDepth,
+			&telegram.MeasurementTime,
+			&telegram.Sender,
+			&telegram.BulletinTime,
+			&notMeasuredColumns,
+			&telegram.Warnings,
+			&telegram.Severity,
+			&telegram.StationName,
 			&phenomeniaId,
 			&phenomeniaTelegramId,
 			&phenomeniaPhenomen,
@@ -357,6 +440,8 @@ func (r *HydrologyBufferStorage) GetAll(ctx context.Context) (*[]model.Telegram,
 			return nil, err
 		}
 
+		setNotMeasured(&telegram, notMeasuredColumns)
+
 		if len(telegrams) == 0 || telegram.Id != telegrams[len(telegrams)-1].Id {
 			telegrams = append(telegrams, telegram)
 		}
@@ -430,6 +515,18 @@ func (r *HydrologyBufferStorage) UpdateTelegram(ctx context.Context, updatedTele
 			"isreservoirwaterinflowdate": updatedTelegram.IsReservoirWaterInflowDate,
 			"inflow":                     updatedTelegram.Inflow,
 			"reset":                      updatedTelegram.Reset,
+			"measureddischargemonth":     updatedTelegram.MeasuredDischargeMonth,
+			"measuredwaterlevel":         updatedTelegram.MeasuredWaterLevel,
+			"measureddischarge":          updatedTelegram.MeasuredDischarge,
+			"crosssectionarea":           updatedTelegram.CrossSectionArea,
+			"averagevelocity":            updatedTelegram.AverageVelocity,
+			"maxdepth":                   updatedTelegram.MaxDepth,
+			"measurementtime":            updatedTelegram.MeasurementTime,
+			"sender":                     updatedTelegram.Sender,
+			"bulletintime":               updatedTelegram.BulletinTime,
+			"notmeasured":                notMeasured(updatedTelegram),
+			"warnings":                   updatedTelegram.Warnings,
+			"severity":                   updatedTelegram.Severity,
 		}).
 		Where(goqu.Ex{"id": updatedTelegram.Id})
 
@@ -498,6 +595,19 @@ func (r *HydrologyBufferStorage) GetTelegramsById(ctx context.Context, ids []uui
 			goqu.I("t.isreservoirwaterinflowdate"),
 			goqu.I("t.inflow"),
 			goqu.I("t.reset"),
+			goqu.I("t.measureddischargemonth"),
+			goqu.I("t.measuredwaterlevel"),
+			goqu.I("t.measureddischarge"),
+			goqu.I("t.crosssectionarea"),
+			goqu.I("t.averagevelocity"),
+			goqu.I("t.maxdepth"),
+			goqu.I("t.measurementtime"),
+			goqu.I("t.sender"),
+			goqu.I("t.bulletintime"),
+			goqu.I("t.notmeasured"),
+			goqu.I("t.warnings"),
+			goqu.I("t.severity"),
+			goqu.COALESCE(goqu.I("s.name"), "").As("stationname"),
 			goqu.I("p.id"),
 			goqu.I("p.telegramid"),
 			goqu.I("p.phenomen"),
@@ -509,7 +619,11 @@ func (r *HydrologyBufferStorage) GetTelegramsById(ctx context.Context, ids []uui
 			goqu.From(goqu.I("phenomenia")).As("p"),
 			goqu.On(goqu.Ex{"t.id": goqu.I("p.telegramid")}),
 		).
-		Where(goqu.I("t.id").In(ids))
+		LeftJoin(
+			goqu.T("station").As("s"),
+			goqu.On(goqu.Ex{"t.postcode": goqu.I("s.postcode")}),
+		).
+		Where(goqu.I("t.id").In(ids), goqu.I("t.transferredat").IsNull())
 
 	sqlScript, args, err := selectBuilder.ToSQL()
 	if err != nil {
@@ -526,6 +640,7 @@ func (r *HydrologyBufferStorage) GetTelegramsById(ctx context.Context, ids []uui
 
 	for rows.Next() {
 		var telegram model.Telegram
+		var notMeasuredColumns []string
 		var phenomeniaId *uuid.UUID
 		var phenomeniaTelegramId uuid.UUID
 		var phenomeniaPhenomen sql.NullByte
@@ -559,6 +674,19 @@ func (r *HydrologyBufferStorage) GetTelegramsById(ctx context.Context, ids []uui
 			&telegram.IsReservoirWaterInflowDate,
 			&telegram.Inflow,
 			&telegram.Reset,
+			&telegram.MeasuredDischargeMonth,
+			&telegram.MeasuredWaterLevel,
+			&telegram.MeasuredDischarge,
+			&telegram.CrossSectionArea,
+			&telegram.AverageVelocity,
+			&telegram.MaxDepth,
+			&telegram.MeasurementTime,
+			&telegram.Sender,
+			&telegram.BulletinTime,
+			&notMeasuredColumns,
+			&telegram.Warnings,
+			&telegram.Severity,
+			&telegram.StationName,
 			&phenomeniaId,
 			&phenomeniaTelegramId,
 			&phenomeniaPhenomen,
@@ -569,6 +697,8 @@ func (r *HydrologyBufferStorage) GetTelegramsById(ctx context.Context, ids []uui
 			return nil, err
 		}
 
+		setNotMeasured(&telegram, notMeasuredColumns)
+
 		if len(telegrams) == 0 || telegram.Id != telegrams[len(telegrams)-1].Id {
 			telegrams = append(telegrams, telegram)
 		}
@@ -590,3 +720,102 @@ func (r *HydrologyBufferStorage) GetTelegramsById(ctx context.Context, ids []uui
 
 	return &telegrams, nil
 }
+
+// GetPreviousWaterLevel returns the latest measured water level of the post
+// observed in [from, before), or nil when there is none.
+func (r *HydrologyBufferStorage) GetPreviousWaterLevel(ctx context.Context, postCode string, from, before time.Time) (*validation.Observation, error) {
+
+	query, _, err := goqu.
+		From("telegram").
+		Select("datetime", "waterlevelontime").
+		Where(
+			goqu.C("postcode").Eq(postCode),
+			goqu.C("datetime").Gte(from),
+			goqu.C("datetime").Lt(before),
+			goqu.C("waterlevelontime").IsNotNull(),
+		).
+		Order(goqu.C("datetime").Desc()).
+		Limit(1).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	var observation validation.Observation
+
+	err = r.dbPool.QueryRow(ctx, query).Scan(&observation.DateTime, &observation.WaterLevel)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &observation, nil
+}
+
+// measurementColumn ties a telegram column to its measurement. The column is
+// NULL unless the value was measured, so values reported as not measured are
+// listed by name in the notmeasured column.
+type measurementColumn struct {
+	name  string
+	field interface {
+		IsNotMeasured() bool
+		SetNotMeasured()
+	}
+}
+
+func measurementColumns(t *model.Telegram) []measurementColumn {
+	return []measurementColumn{
+		{"waterlevelontime", &t.WaterLevelOnTime},
+		{"deltawaterlevel", &t.DeltaWaterLevel},
+		{"waterlevelon20h", &t.WaterLevelOn20h},
+		{"watertemperature", &t.WaterTemperature},
+		{"airtemperature", &t.AirTemperature},
+		{"ice", &t.Ice},
+		{"snow", &t.Snow},
+		{"waterflow", &t.Waterflow},
+		{"precipitationvalue", &t.PrecipitationValue},
+		{"precipitationduration", &t.PrecipitationDuration},
+		{"headwaterlevel", &t.HeadwaterLevel},
+		{"averagereservoirlevel", &t.AverageReservoirLevel},
+		{"downstreamlevel", &t.DownstreamLevel},
+		{"reservoirvolume", &t.ReservoirVolume},
+		{"inflow", &t.Inflow},
+		{"reset", &t.Reset},
+		{"measuredwaterlevel", &t.MeasuredWaterLevel},
+		{"measureddischarge", &t.MeasuredDischarge},
+		{"crosssectionarea", &t.CrossSectionArea},
+		{"averagevelocity", &t.AverageVelocity},
+		{"maxdepth", &t.MaxDepth},
+	}
+}
+
+func notMeasured(t *model.Telegram) textArray {
+
+	columns := textArray{}
+	for _, column := range measurementColumns(t) {
+		if column.field.IsNotMeasured() {
+			columns = append(columns, column.name)
+		}
+	}
+
+	return columns
+}
+
+func setNotMeasured(t *model.Telegram, columns []string) {
+
+	for _, column := range measurementColumns(t) {
+		if slices.Contains(columns, column.name) {
+			column.field.SetNotMeasured()
+		}
+	}
+}
+
+// textArray is stored as a Postgres array literal; goqu would render a plain
+// slice as a value list.
+type textArray []string
+
+func (a textArray) Value() (driver.Value, error) {
+	return "{" + strings.Join(a, ",") + "}", nil
+}
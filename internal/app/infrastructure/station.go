@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/IAmFutureHokage/HL-BufferService/internal/app/model"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	decoder_types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/jackc/pgx/v4"
+)
+
+var stationColumns = []any{
+	"postcode",
+	"name",
+	"river",
+	"basin",
+	"latitude",
+	"longitude",
+	"gaugezero",
+	"timezone",
+	"active",
+	"sections",
+	"favourablelevel",
+	"adverselevel",
+	"dangerouslevel",
+	"icejamlevel",
+	"icejamphenomena",
+}
+
+func (r *HydrologyBufferStorage) AddStation(ctx context.Context, station *model.Station) error {
+
+	sqlScript, args, err := goqu.Insert("station").Rows(stationRecord(station)).ToSQL()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.dbPool.Exec(ctx, sqlScript, args...)
+	return err
+}
+
+func (r *HydrologyBufferStorage) GetStation(ctx context.Context, postCode string) (*model.Station, error) {
+
+	sqlScript, args, err := goqu.
+		From("station").
+		Select(stationColumns...).
+		Where(goqu.Ex{"postcode": postCode}).
+		ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	station, err := scanStation(r.dbPool.QueryRow(ctx, sqlScript, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, model.ErrStationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return station, nil
+}
+
+func (r *HydrologyBufferStorage) GetStations(ctx context.Context, activeOnly bool) ([]model.Station, error) {
+
+	selectBuilder := goqu.
+		From("station").
+		Select(stationColumns...).
+		Order(goqu.C("postcode").Asc())
+	if activeOnly {
+		selectBuilder = selectBuilder.Where(goqu.Ex{"active": true})
+	}
+
+	sqlScript, args, err := selectBuilder.ToSQL()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbPool.Query(ctx, sqlScript, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stations []model.Station
+
+	for rows.Next() {
+		station, err := scanStation(rows)
+		if err != nil {
+			return nil, err
+		}
+		stations = append(stations, *station)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return stations, nil
+}
+
+func (r *HydrologyBufferStorage) UpdateStation(ctx context.Context, station *model.Station) error {
+
+	sqlScript, args, err := goqu.
+		Update("station").
+		Set(stationRecord(station)).
+		Where(goqu.Ex{"postcode": station.PostCode}).
+		ToSQL()
+	if err != nil {
+		return err
+	}
+
+	result, err := r.dbPool.Exec(ctx, sqlScript, args...)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrStationNotFound
+	}
+
+	return nil
+}
+
+func (r *HydrologyBufferStorage) RemoveStation(ctx context.Context, postCode string) error {
+
+	result, err := r.dbPool.Exec(ctx, "DELETE FROM station WHERE postcode = $1", postCode)
+	if err != nil {
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrStationNotFound
+	}
+
+	return nil
+}
+
+func stationRecord(station *model.Station) goqu.Record {
+
+	sections := make(textArray, len(station.Sections))
+	for i, section := range station.Sections {
+		sections[i] = string(section)
+	}
+
+	iceJamPhenomena := make(textArray, len(station.Thresholds.IceJamPhenomena))
+	for i, phenomenon := range station.Thresholds.IceJamPhenomena {
+		iceJamPhenomena[i] = strconv.Itoa(int(phenomenon))
+	}
+
+	return goqu.Record{
+		"postcode":        station.PostCode,
+		"name":            station.Name,
+		"river":           station.River,
+		"basin":           station.Basin,
+		"latitude":        station.Latitude,
+		"longitude":       station.Longitude,
+		"gaugezero":       station.GaugeZero,
+		"timezone":        station.TimeZone,
+		"active":          station.Active,
+		"sections":        sections,
+		"favourablelevel": station.Thresholds.FavourableLevel,
+		"adverselevel":    station.Thresholds.AdverseLevel,
+		"dangerouslevel":  station.Thresholds.DangerousLevel,
+		"icejamlevel":     station.Thresholds.IceJamLevel,
+		"icejamphenomena": iceJamPhenomena,
+	}
+}
+
+func scanStation(row pgx.Row) (*model.Station, error) {
+
+	var station model.Station
+	var sections []string
+	var iceJamPhenomena []int16
+
+	err := row.Scan(
+		&station.PostCode,
+		&station.Name,
+		&station.River,
+		&station.Basin,
+		&station.Latitude,
+		&station.Longitude,
+		&station.GaugeZero,
+		&station.TimeZone,
+		&station.Active,
+		&sections,
+		&station.Thresholds.FavourableLevel,
+		&station.Thresholds.AdverseLevel,
+		&station.Thresholds.DangerousLevel,
+		&station.Thresholds.IceJamLevel,
+		&iceJamPhenomena,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, section := range sections {
+		station.Sections = append(station.Sections, decoder.Section(section))
+	}
+
+	for _, phenomenon := range iceJamPhenomena {
+		station.Thresholds.IceJamPhenomena = append(station.Thresholds.IceJamPhenomena, decoder_types.PhenomenonCode(phenomenon))
+	}
+
+	return &station, nil
+}
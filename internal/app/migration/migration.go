@@ -27,7 +27,20 @@ CREATE TABLE IF NOT EXISTS telegram (
     reservoirvolume DOUBLE PRECISION,
     isreservoirwaterinflowdate TIMESTAMPTZ,
     inflow DOUBLE PRECISION,
-    reset DOUBLE PRECISION
+    reset DOUBLE PRECISION,
+    measureddischargemonth SMALLINT,
+    measuredwaterlevel INTEGER,
+    measureddischarge DOUBLE PRECISION,
+    crosssectionarea DOUBLE PRECISION,
+    averagevelocity INTEGER,
+    maxdepth INTEGER,
+    measurementtime TIMESTAMPTZ,
+    sender TEXT,
+    bulletintime TIMESTAMPTZ,
+    notmeasured TEXT[] NOT NULL DEFAULT '{}',
+    warnings JSONB NOT NULL DEFAULT '[]',
+    severity TEXT NOT NULL DEFAULT '',
+    transferredat TIMESTAMPTZ
 );
 
 CREATE TABLE IF NOT EXISTS phenomenia (
@@ -38,4 +51,159 @@ CREATE TABLE IF NOT EXISTS phenomenia (
     intensity SMALLINT,
     FOREIGN KEY (telegramId) REFERENCES telegram(id) ON DELETE CASCADE
 );
+
+ALTER TABLE telegram
+    ADD COLUMN IF NOT EXISTS measureddischargemonth SMALLINT,
+    ADD COLUMN IF NOT EXISTS measuredwaterlevel INTEGER,
+    ADD COLUMN IF NOT EXISTS measureddischarge DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS crosssectionarea DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS averagevelocity INTEGER,
+    ADD COLUMN IF NOT EXISTS maxdepth INTEGER,
+    ADD COLUMN IF NOT EXISTS measurementtime TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS sender TEXT,
+    ADD COLUMN IF NOT EXISTS bulletintime TIMESTAMPTZ;
+
+ALTER TABLE telegram
+    ADD COLUMN IF NOT EXISTS notmeasured TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE telegram
+    ADD COLUMN IF NOT EXISTS warnings JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE telegram
+    ADD COLUMN IF NOT EXISTS severity TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE telegram
+    ADD COLUMN IF NOT EXISTS transferredat TIMESTAMPTZ;
+
+-- Values reported as not measured were stored as -2147483648 (100 for
+-- snow and precipitation duration) before notmeasured was added.
+UPDATE telegram SET
+    notmeasured = array_remove(ARRAY[
+        CASE WHEN waterlevelontime = -2147483648 THEN 'waterlevelontime' END,
+        CASE WHEN deltawaterlevel = -2147483648 THEN 'deltawaterlevel' END,
+        CASE WHEN waterlevelon20h = -2147483648 THEN 'waterlevelon20h' END,
+        CASE WHEN watertemperature = -2147483648 THEN 'watertemperature' END,
+        CASE WHEN airtemperature = -2147483648 THEN 'airtemperature' END,
+        CASE WHEN ice = -2147483648 THEN 'ice' END,
+        CASE WHEN snow = 100 THEN 'snow' END,
+        CASE WHEN waterflow = -2147483648 THEN 'waterflow' END,
+        CASE WHEN precipitationvalue = -2147483648 THEN 'precipitationvalue' END,
+        CASE WHEN precipitationduration = 100 THEN 'precipitationduration' END,
+        CASE WHEN headwaterlevel = -2147483648 THEN 'headwaterlevel' END,
+        CASE WHEN averagereservoirlevel = -2147483648 THEN 'averagereservoirlevel' END,
+        CASE WHEN downstreamlevel = -2147483648 THEN 'downstreamlevel' END,
+        CASE WHEN reservoirvolume = -2147483648 THEN 'reservoirvolume' END,
+        CASE WHEN inflow = -2147483648 THEN 'inflow' END,
+        CASE WHEN reset = -2147483648 THEN 'reset' END,
+        CASE WHEN measuredwaterlevel = -2147483648 THEN 'measuredwaterlevel' END,
+        CASE WHEN measureddischarge = -2147483648 THEN 'measureddischarge' END,
+        CASE WHEN crosssectionarea = -2147483648 THEN 'crosssectionarea' END,
+        CASE WHEN averagevelocity = -2147483648 THEN 'averagevelocity' END,
+        CASE WHEN maxdepth = -2147483648 THEN 'maxdepth' END
+    ], NULL),
+    waterlevelontime = NULLIF(waterlevelontime, -2147483648),
+    deltawaterlevel = NULLIF(deltawaterlevel, -2147483648),
+    waterlevelon20h = NULLIF(waterlevelon20h, -2147483648),
+    watertemperature = NULLIF(watertemperature, -2147483648),
+    airtemperature = NULLIF(airtemperature, -2147483648),
+    ice = NULLIF(ice, -2147483648),
+    snow = NULLIF(snow, 100),
+    waterflow = NULLIF(waterflow, -2147483648),
+    precipitationvalue = NULLIF(precipitationvalue, -2147483648),
+    precipitationduration = NULLIF(precipitationduration, 100),
+    headwaterlevel = NULLIF(headwaterlevel, -2147483648),
+    averagereservoirlevel = NULLIF(averagereservoirlevel, -2147483648),
+    downstreamlevel = NULLIF(downstreamlevel, -2147483648),
+    reservoirvolume = NULLIF(reservoirvolume, -2147483648),
+    inflow = NULLIF(inflow, -2147483648),
+    reset = NULLIF(reset, -2147483648),
+    measuredwaterlevel = NULLIF(measuredwaterlevel, -2147483648),
+    measureddischarge = NULLIF(measureddischarge, -2147483648),
+    crosssectionarea = NULLIF(crosssectionarea, -2147483648),
+    averagevelocity = NULLIF(averagevelocity, -2147483648),
+    maxdepth = NULLIF(maxdepth, -2147483648)
+WHERE waterlevelontime = -2147483648
+    OR deltawaterlevel = -2147483648
+    OR waterlevelon20h = -2147483648
+    OR watertemperature = -2147483648
+    OR airtemperature = -2147483648
+    OR ice = -2147483648
+    OR snow = 100
+    OR waterflow = -2147483648
+    OR precipitationvalue = -2147483648
+    OR precipitationduration = 100
+    OR headwaterlevel = -2147483648
+    OR averagereservoirlevel = -2147483648
+    OR downstreamlevel = -2147483648
+    OR reservoirvolume = -2147483648
+    OR inflow = -2147483648
+    OR reset = -2147483648
+    OR measuredwaterlevel = -2147483648
+    OR measureddischarge = -2147483648
+    OR crosssectionarea = -2147483648
+    OR averagevelocity = -2147483648
+    OR maxdepth = -2147483648;
+`
+
+const CreateTableStation = `
+CREATE TABLE IF NOT EXISTS station (
+    postcode TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    river TEXT NOT NULL DEFAULT '',
+    basin TEXT NOT NULL DEFAULT '',
+    latitude DOUBLE PRECISION NOT NULL DEFAULT 0,
+    longitude DOUBLE PRECISION NOT NULL DEFAULT 0,
+    gaugezero DOUBLE PRECISION NOT NULL DEFAULT 0,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    sections TEXT[] NOT NULL DEFAULT '{}',
+    favourablelevel INTEGER,
+    adverselevel INTEGER,
+    dangerouslevel INTEGER,
+    icejamlevel INTEGER,
+    icejamphenomena SMALLINT[] NOT NULL DEFAULT '{}'
+);
+`
+
+const CreateTableAlert = `
+CREATE TABLE IF NOT EXISTS alert (
+    id TEXT PRIMARY KEY,
+    key TEXT NOT NULL,
+    telegramid TEXT,
+    postcode TEXT,
+    stationname TEXT NOT NULL DEFAULT '',
+    datetime TIMESTAMPTZ,
+    severity TEXT NOT NULL DEFAULT '',
+    isdangerous BOOLEAN NOT NULL DEFAULT FALSE,
+    reason TEXT NOT NULL DEFAULT '',
+    level SMALLINT NOT NULL DEFAULT 0,
+    createdat TIMESTAMPTZ NOT NULL,
+    notifiedat TIMESTAMPTZ,
+    acknowledgedat TIMESTAMPTZ,
+    acknowledgedby TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS alert_open ON alert (key, createdat) WHERE acknowledgedat IS NULL;
+CREATE INDEX IF NOT EXISTS alert_undelivered ON alert (createdat) WHERE acknowledgedat IS NULL AND notifiedat IS NULL;
+`
+
+const CreateTableOutbox = `
+CREATE TABLE IF NOT EXISTS outbox (
+    id TEXT PRIMARY KEY,
+    seq BIGSERIAL,
+    topic TEXT NOT NULL,
+    key TEXT NOT NULL DEFAULT '',
+    headers JSONB NOT NULL DEFAULT '{}',
+    telegramids TEXT[] NOT NULL DEFAULT '{}',
+    payload BYTEA NOT NULL,
+    createdat TIMESTAMPTZ NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    nextattemptat TIMESTAMPTZ NOT NULL,
+    lockeduntil TIMESTAMPTZ,
+    lasterror TEXT NOT NULL DEFAULT '',
+    sentat TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS outbox_pending ON outbox (seq) WHERE sentat IS NULL;
+CREATE INDEX IF NOT EXISTS outbox_pending_telegrams ON outbox USING GIN (telegramids) WHERE sentat IS NULL;
 `
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	decoder_types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/validation"
+)
+
+var ErrStationNotFound = errors.New("station not found")
+
+// Station is a gauging post registered to send KN-15 telegrams.
+type Station struct {
+	PostCode  string
+	Name      string
+	River     string
+	Basin     string
+	Latitude  float64
+	Longitude float64
+	// GaugeZero is the elevation of the gauge zero in m, levels of the
+	// telegrams are measured from it.
+	GaugeZero float64
+	TimeZone  string
+	Active    bool
+	// Sections are the sections the station is expected to send.
+	Sections   []decoder.Section
+	Thresholds validation.Thresholds
+}
+
+var stationSections = []decoder.Section{
+	decoder.SectionMain,
+	decoder.SectionPreviousDay,
+	decoder.SectionReservoir,
+	decoder.SectionReservoirInflow,
+	decoder.SectionMeasuredDischarge,
+}
+
+// Validate checks the station before it is stored.
+func (s *Station) Validate() error {
+
+	if s == nil {
+		return errors.New("nil pointer to Station")
+	}
+
+	if len(s.PostCode) != 5 {
+		return fmt.Errorf("post code %q must have 5 digits", s.PostCode)
+	}
+	for i := 0; i < len(s.PostCode); i++ {
+		if s.PostCode[i] < '0' || s.PostCode[i] > '9' {
+			return fmt.Errorf("post code %q must have 5 digits", s.PostCode)
+		}
+	}
+
+	if s.Name == "" {
+		return errors.New("station name is empty")
+	}
+
+	if s.Latitude < -90 || s.Latitude > 90 {
+		return fmt.Errorf("latitude %v is outside -90..90", s.Latitude)
+	}
+	if s.Longitude < -180 || s.Longitude > 180 {
+		return fmt.Errorf("longitude %v is outside -180..180", s.Longitude)
+	}
+
+	if _, err := time.LoadLocation(s.TimeZone); err != nil {
+		return fmt.Errorf("time zone %q: %w", s.TimeZone, err)
+	}
+
+	for _, section := range s.Sections {
+		if !slices.Contains(stationSections, section) {
+			return fmt.Errorf("unknown section %q", section)
+		}
+	}
+
+	levels := []*int32{s.Thresholds.FavourableLevel, s.Thresholds.AdverseLevel, s.Thresholds.DangerousLevel}
+	var previous *int32
+	for _, level := range levels {
+		if level == nil {
+			continue
+		}
+		if previous != nil && *level < *previous {
+			return errors.New("favourable, adverse and dangerous levels must not decrease")
+		}
+		previous = level
+	}
+
+	for _, phenomenon := range s.Thresholds.IceJamPhenomena {
+		if _, ok := decoder_types.LookupPhenomenon(phenomenon); !ok {
+			return fmt.Errorf("unknown phenomenon %d", phenomenon)
+		}
+	}
+
+	return nil
+}
+
+// Expects reports whether the station is expected to send section. A
+// station without sections is expected to send any of them.
+func (s *Station) Expects(section decoder.Section) bool {
+	return len(s.Sections) == 0 || slices.Contains(s.Sections, section)
+}
@@ -0,0 +1,96 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	decoder_types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/validation"
+)
+
+func level(v int32) *int32 {
+	return &v
+}
+
+func TestStationValidate(t *testing.T) {
+
+	valid := func() *Station {
+		return &Station{
+			PostCode:  "10950",
+			Name:      "Река - Пост",
+			Latitude:  55.75,
+			Longitude: 37.62,
+			TimeZone:  "Europe/Moscow",
+			Active:    true,
+			Sections:  []decoder.Section{decoder.SectionMain, decoder.SectionPreviousDay},
+			Thresholds: validation.Thresholds{
+				FavourableLevel: level(200),
+				AdverseLevel:    level(300),
+				DangerousLevel:  level(400),
+				IceJamPhenomena: []decoder_types.PhenomenonCode{decoder_types.FrazilJamUpstream},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(s *Station)
+		wantErr bool
+	}{
+		{name: "Valid", modify: func(s *Station) {}},
+		{name: "Without thresholds", modify: func(s *Station) { s.Thresholds = validation.Thresholds{} }},
+		{name: "Equal thresholds", modify: func(s *Station) { s.Thresholds.AdverseLevel = level(200) }},
+		{name: "Gap in thresholds", modify: func(s *Station) { s.Thresholds.AdverseLevel = nil }},
+		{name: "UTC", modify: func(s *Station) { s.TimeZone = "UTC" }},
+		{name: "Short post code", modify: func(s *Station) { s.PostCode = "1095" }, wantErr: true},
+		{name: "Long post code", modify: func(s *Station) { s.PostCode = "109501" }, wantErr: true},
+		{name: "Letters in post code", modify: func(s *Station) { s.PostCode = "1095a" }, wantErr: true},
+		{name: "Empty name", modify: func(s *Station) { s.Name = "" }, wantErr: true},
+		{name: "Latitude out of range", modify: func(s *Station) { s.Latitude = 90.5 }, wantErr: true},
+		{name: "Longitude out of range", modify: func(s *Station) { s.Longitude = -180.5 }, wantErr: true},
+		{name: "Unknown time zone", modify: func(s *Station) { s.TimeZone = "Europe/Atlantis" }, wantErr: true},
+		{name: "Unknown section", modify: func(s *Station) { s.Sections = []decoder.Section{"933"} }, wantErr: true},
+		{name: "Adverse below favourable", modify: func(s *Station) { s.Thresholds.AdverseLevel = level(150) }, wantErr: true},
+		{
+			name: "Dangerous below favourable across a gap",
+			modify: func(s *Station) {
+				s.Thresholds.AdverseLevel = nil
+				s.Thresholds.DangerousLevel = level(150)
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Unknown phenomenon",
+			modify:  func(s *Station) { s.Thresholds.IceJamPhenomena = []decoder_types.PhenomenonCode{99} },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			station := valid()
+			tt.modify(station)
+			if err := station.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	var station *Station
+	if err := station.Validate(); err == nil {
+		t.Error("Validate() of nil succeeded")
+	}
+}
+
+func TestStationExpects(t *testing.T) {
+
+	anySection := &Station{}
+	if !anySection.Expects(decoder.SectionReservoir) {
+		t.Error("station without sections does not expect 944")
+	}
+
+	main := &Station{Sections: []decoder.Section{decoder.SectionMain}}
+	if !main.Expects(decoder.SectionMain) || main.Expects(decoder.SectionPreviousDay) {
+		t.Errorf("station with sections %v expects the wrong ones", main.Sections)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"database/sql"
 	"time"
 
+	decoder_types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/validation"
 	uuid "github.com/google/uuid"
 )
 
@@ -15,26 +17,42 @@ type Telegram struct {
 	DateTime                   time.Time
 	EndBlockNum                byte
 	IsDangerous                bool
-	WaterLevelOnTime           sql.NullInt32
-	DeltaWaterLevel            sql.NullInt32
-	WaterLevelOn20h            sql.NullInt32
-	WaterTemperature           sql.NullFloat64
-	AirTemperature             sql.NullInt32
+	WaterLevelOnTime           decoder_types.Measurement[int32]
+	DeltaWaterLevel            decoder_types.Measurement[int32]
+	WaterLevelOn20h            decoder_types.Measurement[int32]
+	WaterTemperature           decoder_types.Measurement[float64]
+	AirTemperature             decoder_types.Measurement[int32]
 	IcePhenomeniaState         sql.NullByte
 	IcePhenomenia              []*Phenomenia
-	Ice                        sql.NullInt32
-	Snow                       sql.NullByte
-	Waterflow                  sql.NullFloat64
-	PrecipitationValue         sql.NullFloat64
-	PrecipitationDuration      sql.NullByte
+	Ice                        decoder_types.Measurement[int32]
+	Snow                       decoder_types.Measurement[byte]
+	Waterflow                  decoder_types.Measurement[float64]
+	PrecipitationValue         decoder_types.Measurement[float64]
+	PrecipitationDuration      decoder_types.Measurement[byte]
 	ReservoirDate              sql.NullTime
-	HeadwaterLevel             sql.NullInt32
-	AverageReservoirLevel      sql.NullInt32
-	DownstreamLevel            sql.NullInt32
-	ReservoirVolume            sql.NullFloat64
+	HeadwaterLevel             decoder_types.Measurement[int32]
+	AverageReservoirLevel      decoder_types.Measurement[int32]
+	DownstreamLevel            decoder_types.Measurement[int32]
+	ReservoirVolume            decoder_types.Measurement[float64]
 	IsReservoirWaterInflowDate sql.NullTime
-	Inflow                     sql.NullFloat64
-	Reset                      sql.NullFloat64
+	Inflow                     decoder_types.Measurement[float64]
+	Reset                      decoder_types.Measurement[float64]
+	MeasuredDischargeMonth     sql.NullByte
+	MeasuredWaterLevel         decoder_types.Measurement[int32]
+	MeasuredDischarge          decoder_types.Measurement[float64]
+	CrossSectionArea           decoder_types.Measurement[float64]
+	AverageVelocity            decoder_types.Measurement[int32]
+	MaxDepth                   decoder_types.Measurement[int32]
+	MeasurementTime            sql.NullTime
+	Sender                     sql.NullString
+	BulletinTime               sql.NullTime
+	Warnings                   validation.Warnings
+	// Severity is the situation at the station derived from its
+	// thresholds, to be compared with IsDangerous from the sender.
+	Severity validation.Severity
+	// StationName is the name of the registered station sending PostCode.
+	// It is not stored with the telegram.
+	StationName string
 }
 
 type Phenomenia struct {
@@ -24,42 +24,30 @@ func (r *Telegram) Update(draftTg *decoder.Telegram) error {
 
 	r.PostCode = string(draftTg.PostCode)
 
-	if r.DateTime.IsZero() {
-		now := time.Now()
-		r.DateTime = time.Date(now.Year(), now.Month(), int(draftTg.Date), int(draftTg.Time), 0, 0, 0, now.Location())
-	} else {
-		r.DateTime = time.Date(r.DateTime.Year(), r.DateTime.Month(), int(draftTg.Date), int(draftTg.Time), 0, 0, 0, r.DateTime.Location())
+	dateTime := draftTg.DateTime
+	if dateTime.IsZero() {
+		var err error
+		dateTime, err = decoder.ResolveDate(time.Now(), draftTg.Date, draftTg.Time)
+		if err != nil {
+			return err
+		}
 	}
-	r.DateTime = r.DateTime.Truncate(time.Hour)
+	r.DateTime = dateTime.Truncate(time.Hour)
 
 	r.EndBlockNum = draftTg.EndBlockNum
 
 	r.IsDangerous = bool(draftTg.IsDangerous)
 
-	if draftTg.WaterLevelOnTime != nil {
-		r.WaterLevelOnTime = sql.NullInt32{Int32: int32(*draftTg.WaterLevelOnTime), Valid: true}
-	} else {
-		r.WaterLevelOnTime = sql.NullInt32{Valid: false}
-	}
-
-	if draftTg.DeltaWaterLevel != nil {
-		r.DeltaWaterLevel = sql.NullInt32{Int32: int32(*draftTg.DeltaWaterLevel), Valid: true}
-	} else {
-		r.DeltaWaterLevel = sql.NullInt32{Valid: false}
-	}
-
-	if draftTg.WaterLevelOn20h != nil {
-		r.WaterLevelOn20h = sql.NullInt32{Int32: int32(*draftTg.WaterLevelOn20h), Valid: true}
-	} else {
-		r.WaterLevelOn20h = sql.NullInt32{Valid: false}
-	}
+	r.WaterLevelOnTime = int32Measurement(draftTg.WaterLevelOnTime)
+	r.DeltaWaterLevel = int32Measurement(draftTg.DeltaWaterLevel)
+	r.WaterLevelOn20h = int32Measurement(draftTg.WaterLevelOn20h)
 
 	if draftTg.Temperature != nil {
-		r.WaterTemperature = sql.NullFloat64{Float64: *draftTg.Temperature.WaterTemperature, Valid: true}
-		r.AirTemperature = sql.NullInt32{Int32: *draftTg.Temperature.AirTemperature, Valid: true}
+		r.WaterTemperature = draftTg.Temperature.WaterTemperature
+		r.AirTemperature = draftTg.Temperature.AirTemperature
 	} else {
-		r.WaterTemperature = sql.NullFloat64{Valid: false}
-		r.AirTemperature = sql.NullInt32{Valid: false}
+		r.WaterTemperature = decoder_types.Measurement[float64]{}
+		r.AirTemperature = decoder_types.Measurement[int32]{}
 	}
 
 	if draftTg.IcePhenomeniaState != nil {
@@ -88,76 +76,120 @@ func (r *Telegram) Update(draftTg *decoder.Telegram) error {
 	}
 
 	if draftTg.IceInfo != nil {
-		r.Ice = sql.NullInt32{Int32: *draftTg.IceInfo.Ice, Valid: true}
-		r.Snow = sql.NullByte{Byte: byte(*draftTg.IceInfo.Snow), Valid: true}
+		r.Ice = draftTg.IceInfo.Ice
+		r.Snow = byteMeasurement(draftTg.IceInfo.Snow)
 	} else {
-		r.Ice = sql.NullInt32{Valid: false}
-		r.Snow = sql.NullByte{Valid: false}
+		r.Ice = decoder_types.Measurement[int32]{}
+		r.Snow = decoder_types.Measurement[byte]{}
 	}
 
-	if draftTg.Waterflow != nil {
-		r.Waterflow = sql.NullFloat64{Float64: float64(*draftTg.Waterflow), Valid: true}
-	} else {
-		r.Waterflow = sql.NullFloat64{Valid: false}
-	}
+	r.Waterflow = float64Measurement(draftTg.Waterflow)
 
 	if draftTg.Precipitation != nil {
-		r.PrecipitationValue = sql.NullFloat64{Float64: float64(*draftTg.Precipitation.Value), Valid: true}
-		r.PrecipitationDuration = sql.NullByte{Byte: byte(*draftTg.Precipitation.Duration), Valid: true}
+		r.PrecipitationValue = draftTg.Precipitation.Value
+		r.PrecipitationDuration = byteMeasurement(draftTg.Precipitation.Duration)
 	} else {
-		r.PrecipitationValue = sql.NullFloat64{Valid: false}
-		r.PrecipitationDuration = sql.NullByte{Valid: false}
+		r.PrecipitationValue = decoder_types.Measurement[float64]{}
+		r.PrecipitationDuration = decoder_types.Measurement[byte]{}
 	}
 
 	if draftTg.IsReservoirDate != nil {
-		r.ReservoirDate = sql.NullTime{Time: time.Date(r.DateTime.Year(), r.DateTime.Month(), int(*draftTg.IsReservoirDate), 0, 0, 0, 0, r.DateTime.Location()), Valid: true}
+		reservoirDate, err := decoder.ResolveDate(r.DateTime, byte(*draftTg.IsReservoirDate), 0)
+		if err != nil {
+			return err
+		}
+		r.ReservoirDate = sql.NullTime{Time: reservoirDate, Valid: true}
 	} else {
 		r.ReservoirDate = sql.NullTime{Valid: false}
 	}
 
-	if draftTg.Reservoir != nil && draftTg.Reservoir.HeadwaterLevel != nil {
-		r.HeadwaterLevel = sql.NullInt32{Int32: int32(*draftTg.Reservoir.HeadwaterLevel), Valid: true}
+	if draftTg.Reservoir != nil {
+		r.HeadwaterLevel = int32Measurement(draftTg.Reservoir.HeadwaterLevel)
+		r.AverageReservoirLevel = int32Measurement(draftTg.Reservoir.AverageReservoirLevel)
+		r.DownstreamLevel = int32Measurement(draftTg.Reservoir.DownstreamLevel)
+		r.ReservoirVolume = float64Measurement(draftTg.Reservoir.ReservoirVolume)
 	} else {
-		r.HeadwaterLevel = sql.NullInt32{Valid: false}
+		r.HeadwaterLevel = decoder_types.Measurement[int32]{}
+		r.AverageReservoirLevel = decoder_types.Measurement[int32]{}
+		r.DownstreamLevel = decoder_types.Measurement[int32]{}
+		r.ReservoirVolume = decoder_types.Measurement[float64]{}
 	}
 
-	if draftTg.Reservoir != nil && draftTg.Reservoir.AverageReservoirLevel != nil {
-		r.AverageReservoirLevel = sql.NullInt32{Int32: int32(*draftTg.Reservoir.AverageReservoirLevel), Valid: true}
+	if draftTg.IsReservoirWaterInflowDate != nil {
+		inflowDate, err := decoder.ResolveDate(r.DateTime, byte(*draftTg.IsReservoirWaterInflowDate), 0)
+		if err != nil {
+			return err
+		}
+		r.IsReservoirWaterInflowDate = sql.NullTime{Time: inflowDate, Valid: true}
 	} else {
-		r.AverageReservoirLevel = sql.NullInt32{Valid: false}
+		r.IsReservoirWaterInflowDate = sql.NullTime{Valid: false}
 	}
 
-	if draftTg.Reservoir != nil && draftTg.Reservoir.DownstreamLevel != nil {
-		r.DownstreamLevel = sql.NullInt32{Int32: int32(*draftTg.Reservoir.DownstreamLevel), Valid: true}
+	if draftTg.ReservoirWaterInflow != nil {
+		r.Inflow = float64Measurement(draftTg.ReservoirWaterInflow.Inflow)
+		r.Reset = float64Measurement(draftTg.ReservoirWaterInflow.Reset)
 	} else {
-		r.DownstreamLevel = sql.NullInt32{Valid: false}
+		r.Inflow = decoder_types.Measurement[float64]{}
+		r.Reset = decoder_types.Measurement[float64]{}
 	}
 
-	if draftTg.Reservoir != nil && draftTg.Reservoir.ReservoirVolume != nil {
-		r.ReservoirVolume = sql.NullFloat64{Float64: float64(*draftTg.Reservoir.ReservoirVolume), Valid: true}
+	if draftTg.IsMeasuredDischargeMonth != nil {
+		r.MeasuredDischargeMonth = sql.NullByte{Byte: byte(*draftTg.IsMeasuredDischargeMonth), Valid: true}
 	} else {
-		r.ReservoirVolume = sql.NullFloat64{Valid: false}
+		r.MeasuredDischargeMonth = sql.NullByte{Valid: false}
 	}
 
-	if draftTg.IsReservoirWaterInflowDate != nil {
-		r.IsReservoirWaterInflowDate = sql.NullTime{Time: time.Date(r.DateTime.Year(), r.DateTime.Month(), int(*draftTg.IsReservoirWaterInflowDate), 0, 0, 0, 0, r.DateTime.Location()), Valid: true}
+	if draftTg.MeasuredDischarge != nil {
+		r.MeasuredWaterLevel = int32Measurement(draftTg.MeasuredDischarge.WaterLevel)
+		r.MeasuredDischarge = float64Measurement(draftTg.MeasuredDischarge.Discharge)
+		r.CrossSectionArea = float64Measurement(draftTg.MeasuredDischarge.CrossSectionArea)
+		r.AverageVelocity = int32Measurement(draftTg.MeasuredDischarge.AverageVelocity)
+		r.MaxDepth = int32Measurement(draftTg.MeasuredDischarge.MaxDepth)
 	} else {
-		r.IsReservoirWaterInflowDate = sql.NullTime{Valid: false}
+		r.MeasuredWaterLevel = decoder_types.Measurement[int32]{}
+		r.MeasuredDischarge = decoder_types.Measurement[float64]{}
+		r.CrossSectionArea = decoder_types.Measurement[float64]{}
+		r.AverageVelocity = decoder_types.Measurement[int32]{}
+		r.MaxDepth = decoder_types.Measurement[int32]{}
 	}
 
-	if draftTg.ReservoirWaterInflow != nil && draftTg.ReservoirWaterInflow.Inflow != nil {
-		r.Inflow = sql.NullFloat64{Float64: float64(*draftTg.ReservoirWaterInflow.Inflow), Valid: true}
+	if draftTg.IsMeasuredDischargeMonth != nil && draftTg.MeasuredDischarge != nil && draftTg.MeasuredDischarge.MeasurementTime != nil {
+		measurementTime := draftTg.MeasuredDischarge.MeasurementTime
+		measuredAt, err := decoder.ResolveMonthDate(r.DateTime, byte(*draftTg.IsMeasuredDischargeMonth), measurementTime.Day, measurementTime.Hour)
+		if err != nil {
+			return err
+		}
+		r.MeasurementTime = sql.NullTime{Time: measuredAt, Valid: true}
 	} else {
-		r.Inflow = sql.NullFloat64{Valid: false}
+		r.MeasurementTime = sql.NullTime{Valid: false}
 	}
 
-	if draftTg.ReservoirWaterInflow != nil && draftTg.ReservoirWaterInflow.Reset != nil {
-		r.Reset = sql.NullFloat64{Float64: float64(*draftTg.ReservoirWaterInflow.Reset), Valid: true}
+	return nil
+}
+
+// SetEnvelope stores the transport metadata the telegram arrived with. The
+// bulletin time carries only day, hour and minute, so it is resolved
+// against now.
+func (r *Telegram) SetEnvelope(envelope *decoder.Envelope, now time.Time) {
+
+	if envelope == nil {
+		return
+	}
+
+	if envelope.Sender != "" {
+		r.Sender = sql.NullString{String: envelope.Sender, Valid: true}
 	} else {
-		r.Reset = sql.NullFloat64{Valid: false}
+		r.Sender = sql.NullString{Valid: false}
 	}
 
-	return nil
+	r.BulletinTime = sql.NullTime{Valid: false}
+
+	if envelope.Time != nil {
+		bulletinTime, err := envelope.Time.Resolve(now)
+		if err == nil {
+			r.BulletinTime = sql.NullTime{Time: bulletinTime, Valid: true}
+		}
+	}
 }
 
 func (r *Phenomenia) ToModelIcePhenomeniaConvert(draftPh *decoder_types.Phenomenia) error {
@@ -166,7 +198,7 @@ func (r *Phenomenia) ToModelIcePhenomeniaConvert(draftPh *decoder_types.Phenomen
 		return fmt.Errorf("Invalid ice phenomenia")
 	}
 
-	r.Phenomen = draftPh.Phenomen
+	r.Phenomen = draftPh.Phenomen.ToByte()
 	r.IsUntensity = draftPh.IsUntensity
 
 	if r.IsUntensity {
@@ -177,3 +209,15 @@ func (r *Phenomenia) ToModelIcePhenomeniaConvert(draftPh *decoder_types.Phenomen
 
 	return nil
 }
+
+func int32Measurement[T ~int32](m decoder_types.Measurement[T]) decoder_types.Measurement[int32] {
+	return decoder_types.ConvertMeasurement(m, func(v T) int32 { return int32(v) })
+}
+
+func float64Measurement[T ~float64](m decoder_types.Measurement[T]) decoder_types.Measurement[float64] {
+	return decoder_types.ConvertMeasurement(m, func(v T) float64 { return float64(v) })
+}
+
+func byteMeasurement[T ~byte](m decoder_types.Measurement[T]) decoder_types.Measurement[byte] {
+	return decoder_types.ConvertMeasurement(m, func(v T) byte { return byte(v) })
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+)
+
+func TestTelegramUpdateMeasurementTime(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		reference time.Time
+		code      string
+		want      time.Time
+		wantErr   bool
+	}{
+		{
+			name:      "Same year",
+			reference: time.Date(2024, time.April, 10, 9, 0, 0, 0, time.UTC),
+			code:      "10950 10081 96604 60812",
+			want:      time.Date(2024, time.April, 8, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "December measurement in January",
+			reference: time.Date(2024, time.January, 5, 9, 0, 0, 0, time.UTC),
+			code:      "10950 05081 96612 62810",
+			want:      time.Date(2023, time.December, 28, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "Leap day",
+			reference: time.Date(2024, time.March, 5, 9, 0, 0, 0, time.UTC),
+			code:      "10950 05081 96602 62910",
+			want:      time.Date(2024, time.February, 29, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "Leap day in common year",
+			reference: time.Date(2025, time.March, 5, 9, 0, 0, 0, time.UTC),
+			code:      "10950 05081 96602 62910",
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			draft, _, err := decoder.Decode(tt.code, decoder.ReferenceTime(tt.reference))
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+
+			var telegram Telegram
+			err = telegram.Update(draft)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Update() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := telegram.MeasurementTime; !got.Valid || !got.Time.Equal(tt.want) {
+				t.Errorf("MeasurementTime = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
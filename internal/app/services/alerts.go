@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IAmFutureHokage/HL-BufferService/internal/app/model"
+	pb "github.com/IAmFutureHokage/HL-BufferService/internal/proto"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/alert"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/validation"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SetAlertDispatcher makes stored dangerous telegrams raise alerts.
+func (s *HydrologyBufferervice) SetAlertDispatcher(alerts *alert.Dispatcher) {
+	s.alerts = alerts
+}
+
+func (s *HydrologyBufferervice) AcknowledgeAlert(ctx context.Context, req *pb.AcknowledgeAlertRequest) (*pb.AcknowledgeAlertResponse, error) {
+
+	if s.alerts == nil {
+		return nil, status.Error(codes.Unavailable, "alerts are not configured")
+	}
+
+	id, err := uuid.Parse(req.Id)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	acknowledged, err := s.alerts.Acknowledge(ctx, id, req.AcknowledgedBy)
+	if errors.Is(err, alert.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AcknowledgeAlertResponse{Alert: alertToProto(acknowledged)}, nil
+}
+
+// raiseAlerts opens alerts about the dangerous telegrams among the stored
+// ones. The dispatcher sends them in the background and retries until the
+// sinks accept them; an alert that could not be stored is only logged and
+// does not fail the request.
+func (s *HydrologyBufferervice) raiseAlerts(ctx context.Context, telegrams []model.Telegram) {
+
+	if s.alerts == nil {
+		return
+	}
+
+	for i := range telegrams {
+		a, ok := telegramAlert(&telegrams[i])
+		if !ok {
+			continue
+		}
+		if _, err := s.alerts.Notify(ctx, a); err != nil {
+			log.Printf("alert for telegram %s: %v", telegrams[i].Id, err)
+		}
+	}
+}
+
+// telegramAlert returns the alert about t when the sender reported danger
+// or the station thresholds put it at the adverse level or above.
+func telegramAlert(t *model.Telegram) (alert.Alert, bool) {
+
+	var reasons []string
+	if t.IsDangerous {
+		reasons = append(reasons, "danger report 97701")
+	}
+	if t.Severity == validation.SeverityAdverse || t.Severity == validation.SeverityDangerous {
+		reasons = append(reasons, fmt.Sprintf("%s level reached", t.Severity))
+	}
+	if len(reasons) == 0 {
+		return alert.Alert{}, false
+	}
+
+	class := validation.SeverityAdverse
+	if t.IsDangerous || t.Severity == validation.SeverityDangerous {
+		class = validation.SeverityDangerous
+	}
+
+	return alert.Alert{
+		Key:         t.PostCode + "/" + string(class),
+		TelegramId:  t.Id,
+		PostCode:    t.PostCode,
+		StationName: t.StationName,
+		DateTime:    t.DateTime,
+		Severity:    string(t.Severity),
+		IsDangerous: t.IsDangerous,
+		Reason:      strings.Join(reasons, ", "),
+	}, true
+}
+
+func alertToProto(a *alert.Alert) *pb.Alert {
+
+	res := &pb.Alert{
+		Id:             a.Id.String(),
+		TelegramId:     a.TelegramId.String(),
+		PostCode:       a.PostCode,
+		StationName:    a.StationName,
+		Datetime:       timestamppb.New(a.DateTime),
+		Severity:       severityToProto[validation.Severity(a.Severity)],
+		IsDangerous:    a.IsDangerous,
+		Reason:         a.Reason,
+		Level:          int32(a.Level),
+		CreatedAt:      timestamppb.New(a.CreatedAt),
+		AcknowledgedBy: a.AcknowledgedBy,
+	}
+	if a.AcknowledgedAt != nil {
+		res.AcknowledgedAt = timestamppb.New(*a.AcknowledgedAt)
+	}
+
+	return res
+}
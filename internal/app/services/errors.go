@@ -0,0 +1,69 @@
+package services
+
+import (
+	"errors"
+	"strconv"
+
+	pb "github.com/IAmFutureHokage/HL-BufferService/internal/proto"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const decodeErrorDomain = "kn15.decoder"
+
+// encodeErrorCode marks a decoded telegram that could not be converted
+// back into code or into the storage model.
+const encodeErrorCode = "encode_error"
+
+// decodeErrorStatus converts a decoder.DecodeError into an InvalidArgument
+// status carrying the failed group position as ErrorInfo details. Other
+// errors are returned unchanged.
+func decodeErrorStatus(err error) error {
+
+	var decodeErr *decoder.DecodeError
+	if !errors.As(err, &decodeErr) {
+		return err
+	}
+
+	st := status.New(codes.InvalidArgument, decodeErr.Error())
+
+	detailed, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: string(decodeErr.Code),
+		Domain: decodeErrorDomain,
+		Metadata: map[string]string{
+			"block":   strconv.Itoa(decodeErr.Block),
+			"offset":  strconv.Itoa(decodeErr.Offset),
+			"group":   decodeErr.Group,
+			"section": string(decodeErr.Section),
+		},
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+func decodeProblemsToProto(problems []*decoder.DecodeError) []*pb.DecodeProblem {
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	res := make([]*pb.DecodeProblem, len(problems))
+
+	for i, problem := range problems {
+		res[i] = &pb.DecodeProblem{
+			Code:    string(problem.Code),
+			Section: string(problem.Section),
+			Block:   int32(problem.Block),
+			Offset:  int32(problem.Offset),
+			Group:   problem.Group,
+			Message: problem.Message,
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	pb "github.com/IAmFutureHokage/HL-BufferService/internal/proto"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+	"github.com/Shopify/sarama"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IngestTelegram is a kafka.Handler storing the raw KN-15 bulletin in msg
+// the way AddTelegram does. A bulletin that cannot be decoded is rejected
+// as a whole, reports that cannot be stored one by one with their errors;
+// the other reports are stored. Storage errors are returned for the
+// message to be handled again.
+func (s *HydrologyBufferervice) IngestTelegram(ctx context.Context, msg *sarama.ConsumerMessage) error {
+
+	response, err := s.AddTelegram(ctx, &pb.AddTelegramRequest{Code: string(msg.Value)})
+	if status.Code(err) == codes.InvalidArgument {
+		return kafka.DeadLetter(errors.New(status.Convert(err).Message()))
+	}
+	if err != nil {
+		return err
+	}
+
+	var rejected []error
+
+	for _, result := range response.Results {
+		if len(result.Telegrams) != 0 || len(result.Errors) == 0 {
+			continue
+		}
+		rejected = append(rejected, &kafka.DeadLetterError{
+			Value: []byte(result.Code),
+			Err:   fmt.Errorf("report %d: %s", result.Index, describeProblems(result.Errors)),
+		})
+	}
+
+	return errors.Join(rejected...)
+}
+
+func describeProblems(problems []*pb.DecodeProblem) string {
+
+	messages := make([]string, len(problems))
+	for i, problem := range problems {
+		messages[i] = problem.Code + ": " + problem.Message
+	}
+
+	return strings.Join(messages, "; ")
+}
@@ -0,0 +1,61 @@
+package kafka_dto
+
+import (
+	"strings"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+)
+
+// SchemaVersion is the version of the records, sent in every message.
+const SchemaVersion = "1"
+
+// Source is the telegram a record was taken from. It is sent in the
+// message headers rather than the payload, so its fields are unexported.
+type Source struct {
+	telegramId string
+	groupId    string
+}
+
+func (s Source) source() Source {
+	return s
+}
+
+// Meta is the key and headers of a message of records. All records of a
+// message come from one post, which is the key. Like Source it is left
+// out of the payload.
+type Meta struct {
+	postCode   string
+	transferId string
+	sources    []Source
+}
+
+func (m Meta) Key() string {
+	return m.postCode
+}
+
+// Headers lists the telegrams and groups of the records separated by
+// commas, each once.
+func (m Meta) Headers() map[string]string {
+
+	var telegrams, groups []string
+	seenTelegrams, seenGroups := map[string]bool{}, map[string]bool{}
+
+	for _, source := range m.sources {
+		if !seenTelegrams[source.telegramId] {
+			seenTelegrams[source.telegramId] = true
+			telegrams = append(telegrams, source.telegramId)
+		}
+		if !seenGroups[source.groupId] {
+			seenGroups[source.groupId] = true
+			groups = append(groups, source.groupId)
+		}
+	}
+
+	return map[string]string{
+		kafka.HeaderSchemaVersion: SchemaVersion,
+		kafka.HeaderContentType:   kafka.ContentTypeJSON,
+		kafka.HeaderTelegramId:    strings.Join(telegrams, ","),
+		kafka.HeaderGroupId:       strings.Join(groups, ","),
+		kafka.HeaderTransferId:    m.transferId,
+	}
+}
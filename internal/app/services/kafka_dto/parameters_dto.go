@@ -0,0 +1,181 @@
+package kafka_dto
+
+import (
+	"time"
+
+	"github.com/mailru/easyjson"
+)
+
+// Family names a group of parameters published to one topic.
+type Family string
+
+const (
+	FamilyWaterLevel    Family = "water_level"
+	FamilyTemperature   Family = "temperature"
+	FamilyIce           Family = "ice"
+	FamilyWaterflow     Family = "waterflow"
+	FamilyPrecipitation Family = "precipitation"
+	FamilyReservoir     Family = "reservoir"
+	FamilyInflow        Family = "inflow"
+	FamilyDischarge     Family = "discharge"
+)
+
+// Values that were not measured are omitted from the records.
+
+// easyjson:json
+type Temperature struct {
+	Source
+
+	PostCode         string    `json:"post_code"`
+	Date             time.Time `json:"date"`
+	WaterTemperature *float64  `json:"water_temperature,omitempty"`
+	AirTemperature   *int32    `json:"air_temperature,omitempty"`
+}
+
+// easyjson:json
+type TemperatureRecords struct {
+	Meta
+
+	Temperatures []Temperature `json:"temperatures"`
+}
+
+func (r TemperatureRecords) Serialize() ([]byte, error) {
+	return easyjson.Marshal(r)
+}
+
+type IcePhenomenon struct {
+	Code      byte  `json:"code"`
+	Intensity *byte `json:"intensity,omitempty"`
+}
+
+// easyjson:json
+type Ice struct {
+	Source
+
+	PostCode           string          `json:"post_code"`
+	Date               time.Time       `json:"date"`
+	IcePhenomeniaState *byte           `json:"ice_phenomenia_state,omitempty"`
+	IcePhenomenia      []IcePhenomenon `json:"ice_phenomenia,omitempty"`
+	Ice                *int32          `json:"ice,omitempty"`
+	Snow               *byte           `json:"snow,omitempty"`
+}
+
+// easyjson:json
+type IceRecords struct {
+	Meta
+
+	Ice []Ice `json:"ice"`
+}
+
+func (r IceRecords) Serialize() ([]byte, error) {
+	return easyjson.Marshal(r)
+}
+
+// easyjson:json
+type Waterflow struct {
+	Source
+
+	PostCode  string    `json:"post_code"`
+	Date      time.Time `json:"date"`
+	Waterflow float64   `json:"waterflow"`
+}
+
+// easyjson:json
+type WaterflowRecords struct {
+	Meta
+
+	Waterflows []Waterflow `json:"waterflows"`
+}
+
+func (r WaterflowRecords) Serialize() ([]byte, error) {
+	return easyjson.Marshal(r)
+}
+
+// easyjson:json
+type Precipitation struct {
+	Source
+
+	PostCode string    `json:"post_code"`
+	Date     time.Time `json:"date"`
+	Value    *float64  `json:"value,omitempty"`
+	Duration *byte     `json:"duration,omitempty"`
+}
+
+// easyjson:json
+type PrecipitationRecords struct {
+	Meta
+
+	Precipitations []Precipitation `json:"precipitations"`
+}
+
+func (r PrecipitationRecords) Serialize() ([]byte, error) {
+	return easyjson.Marshal(r)
+}
+
+// easyjson:json
+type Reservoir struct {
+	Source
+
+	PostCode              string    `json:"post_code"`
+	Date                  time.Time `json:"date"`
+	HeadwaterLevel        *int32    `json:"headwater_level,omitempty"`
+	AverageReservoirLevel *int32    `json:"average_reservoir_level,omitempty"`
+	DownstreamLevel       *int32    `json:"downstream_level,omitempty"`
+	ReservoirVolume       *float64  `json:"reservoir_volume,omitempty"`
+}
+
+// easyjson:json
+type ReservoirRecords struct {
+	Meta
+
+	Reservoirs []Reservoir `json:"reservoirs"`
+}
+
+func (r ReservoirRecords) Serialize() ([]byte, error) {
+	return easyjson.Marshal(r)
+}
+
+// easyjson:json
+type Inflow struct {
+	Source
+
+	PostCode string    `json:"post_code"`
+	Date     time.Time `json:"date"`
+	Inflow   *float64  `json:"inflow,omitempty"`
+	Reset    *float64  `json:"reset,omitempty"`
+}
+
+// easyjson:json
+type InflowRecords struct {
+	Meta
+
+	Inflows []Inflow `json:"inflows"`
+}
+
+func (r InflowRecords) Serialize() ([]byte, error) {
+	return easyjson.Marshal(r)
+}
+
+// easyjson:json
+type Discharge struct {
+	Source
+
+	PostCode         string    `json:"post_code"`
+	Date             time.Time `json:"date"`
+	WaterLevel       *int32    `json:"water_level,omitempty"`
+	Discharge        *float64  `json:"discharge,omitempty"`
+	CrossSectionArea *float64  `json:"cross_section_area,omitempty"`
+	AverageVelocity  *int32    `json:"average_velocity,omitempty"`
+	MaxDepth         *int32    `json:"max_depth,omitempty"`
+}
+
+// easyjson:json
+type DischargeRecords struct {
+	Meta
+
+	Discharges []Discharge `json:"discharges"`
+}
+
+func (r DischargeRecords) Serialize() ([]byte, error) {
+	return easyjson.Marshal(r)
+}
@@ -0,0 +1,260 @@
+package kafka_dto
+
+import (
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/internal/app/model"
+	decoder_types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+)
+
+// Records are the parameters of telegrams grouped by post and family.
+type Records struct {
+	posts []*PostRecords
+}
+
+// PostRecords are the parameters of the telegrams of one post.
+type PostRecords struct {
+	PostCode       string
+	WaterLevels    []WaterLevel
+	Temperatures   []Temperature
+	Ice            []Ice
+	Waterflows     []Waterflow
+	Precipitations []Precipitation
+	Reservoirs     []Reservoir
+	Inflows        []Inflow
+	Discharges     []Discharge
+}
+
+// NewRecords collects every measured parameter of telegrams. Posts keep
+// the order of their first telegram.
+func NewRecords(telegrams []model.Telegram) *Records {
+
+	r := &Records{}
+	posts := map[string]*PostRecords{}
+
+	for i := range telegrams {
+		post, ok := posts[telegrams[i].PostCode]
+		if !ok {
+			post = &PostRecords{PostCode: telegrams[i].PostCode}
+			posts[post.PostCode] = post
+			r.posts = append(r.posts, post)
+		}
+		post.add(&telegrams[i])
+	}
+
+	return r
+}
+
+// Messages splits every family of every post into messages of at most
+// batchSize records keyed by the post code. Families without records are
+// left out.
+func (r *Records) Messages(transferId string, batchSize int) map[Family][]kafka.MessageProducer {
+
+	messages := map[Family][]kafka.MessageProducer{}
+
+	for _, post := range r.posts {
+		for family, batches := range post.Messages(transferId, batchSize) {
+			messages[family] = append(messages[family], batches...)
+		}
+	}
+
+	return messages
+}
+
+// Messages splits every family into messages of at most batchSize records.
+// Families without records are left out.
+func (r *PostRecords) Messages(transferId string, batchSize int) map[Family][]kafka.MessageProducer {
+
+	messages := map[Family][]kafka.MessageProducer{}
+
+	add := func(family Family, batches []kafka.MessageProducer) {
+		if len(batches) != 0 {
+			messages[family] = batches
+		}
+	}
+
+	meta := Meta{postCode: r.PostCode, transferId: transferId}
+
+	add(FamilyWaterLevel, batch(r.WaterLevels, batchSize, meta, func(b []WaterLevel, m Meta) kafka.MessageProducer {
+		return WaterLevelRecords{Meta: m, Waterlevels: b}
+	}))
+	add(FamilyTemperature, batch(r.Temperatures, batchSize, meta, func(b []Temperature, m Meta) kafka.MessageProducer {
+		return TemperatureRecords{Meta: m, Temperatures: b}
+	}))
+	add(FamilyIce, batch(r.Ice, batchSize, meta, func(b []Ice, m Meta) kafka.MessageProducer {
+		return IceRecords{Meta: m, Ice: b}
+	}))
+	add(FamilyWaterflow, batch(r.Waterflows, batchSize, meta, func(b []Waterflow, m Meta) kafka.MessageProducer {
+		return WaterflowRecords{Meta: m, Waterflows: b}
+	}))
+	add(FamilyPrecipitation, batch(r.Precipitations, batchSize, meta, func(b []Precipitation, m Meta) kafka.MessageProducer {
+		return PrecipitationRecords{Meta: m, Precipitations: b}
+	}))
+	add(FamilyReservoir, batch(r.Reservoirs, batchSize, meta, func(b []Reservoir, m Meta) kafka.MessageProducer {
+		return ReservoirRecords{Meta: m, Reservoirs: b}
+	}))
+	add(FamilyInflow, batch(r.Inflows, batchSize, meta, func(b []Inflow, m Meta) kafka.MessageProducer {
+		return InflowRecords{Meta: m, Inflows: b}
+	}))
+	add(FamilyDischarge, batch(r.Discharges, batchSize, meta, func(b []Discharge, m Meta) kafka.MessageProducer {
+		return DischargeRecords{Meta: m, Discharges: b}
+	}))
+
+	return messages
+}
+
+func (r *PostRecords) add(t *model.Telegram) {
+
+	source := Source{telegramId: t.Id.String(), groupId: t.GroupId.String()}
+
+	if waterLevel, ok := t.WaterLevelOnTime.Get(); ok {
+		r.WaterLevels = append(r.WaterLevels, WaterLevel{
+			Source:     source,
+			PostCode:   t.PostCode,
+			Date:       t.DateTime,
+			WaterLevel: waterLevel,
+		})
+	}
+
+	if waterLevel, ok := t.WaterLevelOn20h.Get(); ok {
+		r.WaterLevels = append(r.WaterLevels, WaterLevel{
+			Source:     source,
+			PostCode:   t.PostCode,
+			Date:       time.Date(t.DateTime.Year(), t.DateTime.Month(), t.DateTime.Day(), 20, 0, 0, 0, t.DateTime.Location()),
+			WaterLevel: waterLevel,
+		})
+	}
+
+	temperature := Temperature{
+		Source:           source,
+		PostCode:         t.PostCode,
+		Date:             t.DateTime,
+		WaterTemperature: measured(t.WaterTemperature),
+		AirTemperature:   measured(t.AirTemperature),
+	}
+	if temperature.WaterTemperature != nil || temperature.AirTemperature != nil {
+		r.Temperatures = append(r.Temperatures, temperature)
+	}
+
+	ice := Ice{
+		Source:   source,
+		PostCode: t.PostCode,
+		Date:     t.DateTime,
+		Ice:      measured(t.Ice),
+		Snow:     measured(t.Snow),
+	}
+	if t.IcePhenomeniaState.Valid {
+		state := t.IcePhenomeniaState.Byte
+		ice.IcePhenomeniaState = &state
+	}
+	for _, phenomenon := range t.IcePhenomenia {
+		p := IcePhenomenon{Code: phenomenon.Phenomen}
+		if phenomenon.IsUntensity && phenomenon.Intensity.Valid {
+			intensity := phenomenon.Intensity.Byte
+			p.Intensity = &intensity
+		}
+		ice.IcePhenomenia = append(ice.IcePhenomenia, p)
+	}
+	if ice.IcePhenomeniaState != nil || len(ice.IcePhenomenia) != 0 || ice.Ice != nil || ice.Snow != nil {
+		r.Ice = append(r.Ice, ice)
+	}
+
+	if waterflow, ok := t.Waterflow.Get(); ok {
+		r.Waterflows = append(r.Waterflows, Waterflow{
+			Source:    source,
+			PostCode:  t.PostCode,
+			Date:      t.DateTime,
+			Waterflow: waterflow,
+		})
+	}
+
+	precipitation := Precipitation{
+		Source:   source,
+		PostCode: t.PostCode,
+		Date:     t.DateTime,
+		Value:    measured(t.PrecipitationValue),
+		Duration: measured(t.PrecipitationDuration),
+	}
+	if precipitation.Value != nil || precipitation.Duration != nil {
+		r.Precipitations = append(r.Precipitations, precipitation)
+	}
+
+	reservoir := Reservoir{
+		Source:                source,
+		PostCode:              t.PostCode,
+		Date:                  dateOr(t.ReservoirDate.Time, t.ReservoirDate.Valid, t.DateTime),
+		HeadwaterLevel:        measured(t.HeadwaterLevel),
+		AverageReservoirLevel: measured(t.AverageReservoirLevel),
+		DownstreamLevel:       measured(t.DownstreamLevel),
+		ReservoirVolume:       measured(t.ReservoirVolume),
+	}
+	if reservoir.HeadwaterLevel != nil || reservoir.AverageReservoirLevel != nil || reservoir.DownstreamLevel != nil || reservoir.ReservoirVolume != nil {
+		r.Reservoirs = append(r.Reservoirs, reservoir)
+	}
+
+	inflow := Inflow{
+		Source:   source,
+		PostCode: t.PostCode,
+		Date:     dateOr(t.IsReservoirWaterInflowDate.Time, t.IsReservoirWaterInflowDate.Valid, t.DateTime),
+		Inflow:   measured(t.Inflow),
+		Reset:    measured(t.Reset),
+	}
+	if inflow.Inflow != nil || inflow.Reset != nil {
+		r.Inflows = append(r.Inflows, inflow)
+	}
+
+	discharge := Discharge{
+		Source:           source,
+		PostCode:         t.PostCode,
+		Date:             dateOr(t.MeasurementTime.Time, t.MeasurementTime.Valid, t.DateTime),
+		WaterLevel:       measured(t.MeasuredWaterLevel),
+		Discharge:        measured(t.MeasuredDischarge),
+		CrossSectionArea: measured(t.CrossSectionArea),
+		AverageVelocity:  measured(t.AverageVelocity),
+		MaxDepth:         measured(t.MaxDepth),
+	}
+	if discharge.WaterLevel != nil || discharge.Discharge != nil || discharge.CrossSectionArea != nil || discharge.AverageVelocity != nil || discharge.MaxDepth != nil {
+		r.Discharges = append(r.Discharges, discharge)
+	}
+}
+
+type record interface {
+	source() Source
+}
+
+func batch[T record](records []T, size int, meta Meta, message func([]T, Meta) kafka.MessageProducer) []kafka.MessageProducer {
+
+	if size <= 0 {
+		size = len(records)
+	}
+
+	var messages []kafka.MessageProducer
+	for start := 0; start < len(records); start += size {
+		end := min(start+size, len(records))
+
+		m := meta
+		m.sources = make([]Source, end-start)
+		for i, record := range records[start:end] {
+			m.sources[i] = record.source()
+		}
+
+		messages = append(messages, message(records[start:end], m))
+	}
+
+	return messages
+}
+
+func measured[T any](m decoder_types.Measurement[T]) *T {
+	if value, ok := m.Get(); ok {
+		return &value
+	}
+	return nil
+}
+
+func dateOr(date time.Time, valid bool, fallback time.Time) time.Time {
+	if valid {
+		return date
+	}
+	return fallback
+}
@@ -0,0 +1,180 @@
+package kafka_dto
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/internal/app/model"
+	decoder_types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+	"github.com/google/uuid"
+)
+
+// TestRecordsFamilies checks that every family of a telegram reporting all
+// of them becomes one message keyed by the post code.
+func TestRecordsFamilies(t *testing.T) {
+
+	telegram := fullTelegram()
+	messages := NewRecords([]model.Telegram{telegram}).Messages("transfer", 0)
+
+	tests := []struct {
+		family  Family
+		records func(kafka.MessageProducer) int
+	}{
+		{FamilyWaterLevel, func(m kafka.MessageProducer) int { return len(m.(WaterLevelRecords).Waterlevels) }},
+		{FamilyTemperature, func(m kafka.MessageProducer) int { return len(m.(TemperatureRecords).Temperatures) }},
+		{FamilyIce, func(m kafka.MessageProducer) int { return len(m.(IceRecords).Ice) }},
+		{FamilyWaterflow, func(m kafka.MessageProducer) int { return len(m.(WaterflowRecords).Waterflows) }},
+		{FamilyPrecipitation, func(m kafka.MessageProducer) int { return len(m.(PrecipitationRecords).Precipitations) }},
+		{FamilyReservoir, func(m kafka.MessageProducer) int { return len(m.(ReservoirRecords).Reservoirs) }},
+		{FamilyInflow, func(m kafka.MessageProducer) int { return len(m.(InflowRecords).Inflows) }},
+		{FamilyDischarge, func(m kafka.MessageProducer) int { return len(m.(DischargeRecords).Discharges) }},
+	}
+
+	if len(messages) != len(tests) {
+		t.Errorf("Messages() has %d families, want %d", len(messages), len(tests))
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.family), func(t *testing.T) {
+
+			if len(messages[tt.family]) != 1 {
+				t.Fatalf("%d messages, want 1", len(messages[tt.family]))
+			}
+			message := messages[tt.family][0]
+
+			// The levels at the observation time and at 20h.
+			want := 1
+			if tt.family == FamilyWaterLevel {
+				want = 2
+			}
+			if got := tt.records(message); got != want {
+				t.Errorf("%d records, want %d", got, want)
+			}
+
+			if message.Key() != "10950" {
+				t.Errorf("Key() = %q, want the post code", message.Key())
+			}
+			headers := message.Headers()
+			if headers[kafka.HeaderTelegramId] != telegram.Id.String() || headers[kafka.HeaderGroupId] != telegram.GroupId.String() || headers[kafka.HeaderTransferId] != "transfer" {
+				t.Errorf("Headers() = %v", headers)
+			}
+		})
+	}
+}
+
+func TestRecordsPosts(t *testing.T) {
+
+	date := time.Date(2024, time.April, 12, 8, 0, 0, 0, time.UTC)
+
+	telegrams := []model.Telegram{
+		{Id: uuid.New(), PostCode: "10950", DateTime: date, WaterLevelOnTime: decoder_types.NewMeasured[int32](245), AirTemperature: decoder_types.NewMeasured[int32](-3)},
+		{Id: uuid.New(), PostCode: "10951", DateTime: date, WaterLevelOnTime: decoder_types.NewMeasured[int32](120)},
+		{Id: uuid.New(), PostCode: "10950", DateTime: date.Add(24 * time.Hour), WaterLevelOnTime: decoder_types.NewMeasured[int32](250)},
+	}
+
+	messages := NewRecords(telegrams).Messages("transfer", 0)
+
+	if len(messages) != 2 {
+		t.Fatalf("Messages() has families %v, want levels and temperatures", messages)
+	}
+
+	// Posts keep the order of their first telegram.
+	levels := messages[FamilyWaterLevel]
+	if len(levels) != 2 || levels[0].Key() != "10950" || levels[1].Key() != "10951" {
+		t.Fatalf("level messages %v, want one per post", levels)
+	}
+	if records := levels[0].(WaterLevelRecords).Waterlevels; len(records) != 2 || records[0].WaterLevel != 245 || records[1].WaterLevel != 250 {
+		t.Errorf("levels of 10950 = %+v", records)
+	}
+	for _, record := range levels[1].(WaterLevelRecords).Waterlevels {
+		if record.PostCode != "10951" {
+			t.Errorf("level of %s in the message of 10951", record.PostCode)
+		}
+	}
+
+	temperatures := messages[FamilyTemperature]
+	if len(temperatures) != 1 || temperatures[0].Key() != "10950" {
+		t.Fatalf("temperature messages %v, want one of 10950", temperatures)
+	}
+	temperature := temperatures[0].(TemperatureRecords).Temperatures[0]
+	if temperature.WaterTemperature != nil || temperature.AirTemperature == nil || *temperature.AirTemperature != -3 {
+		t.Errorf("temperature = %+v, want only the air temperature", temperature)
+	}
+}
+
+func TestRecordsBatchSize(t *testing.T) {
+
+	date := time.Date(2024, time.April, 12, 8, 0, 0, 0, time.UTC)
+
+	var telegrams []model.Telegram
+	var ids []string
+	for i := 0; i < 5; i++ {
+		telegram := model.Telegram{Id: uuid.New(), GroupId: uuid.New(), PostCode: "10950", DateTime: date.AddDate(0, 0, i), WaterLevelOnTime: decoder_types.NewMeasured(int32(240 + i))}
+		telegrams = append(telegrams, telegram)
+		ids = append(ids, telegram.Id.String())
+	}
+
+	levels := NewRecords(telegrams).Messages("transfer", 2)[FamilyWaterLevel]
+	if len(levels) != 3 {
+		t.Fatalf("%d level messages, want 3", len(levels))
+	}
+
+	for i, message := range levels {
+		want := ids[i*2 : min(i*2+2, len(ids))]
+		if got := len(message.(WaterLevelRecords).Waterlevels); got != len(want) {
+			t.Errorf("message %d has %d records, want %d", i, got, len(want))
+		}
+		if got := message.Headers()[kafka.HeaderTelegramId]; got != strings.Join(want, ",") {
+			t.Errorf("message %d lists telegrams %s, want %s", i, got, strings.Join(want, ","))
+		}
+		if message.Key() != "10950" {
+			t.Errorf("message %d has key %q", i, message.Key())
+		}
+	}
+}
+
+func TestRecordsLevelAt20h(t *testing.T) {
+
+	moscow := time.FixedZone("MSK", 3*60*60)
+	telegram := model.Telegram{
+		Id:               uuid.New(),
+		PostCode:         "10950",
+		DateTime:         time.Date(2024, time.April, 12, 8, 0, 0, 0, moscow),
+		WaterLevelOnTime: decoder_types.NewNotMeasured[int32](),
+		WaterLevelOn20h:  decoder_types.NewMeasured[int32](240),
+	}
+
+	levels := NewRecords([]model.Telegram{telegram}).Messages("transfer", 0)[FamilyWaterLevel]
+	if len(levels) != 1 {
+		t.Fatalf("%d level messages, want 1", len(levels))
+	}
+
+	records := levels[0].(WaterLevelRecords).Waterlevels
+	want := time.Date(2024, time.April, 12, 20, 0, 0, 0, moscow)
+	if len(records) != 1 || records[0].WaterLevel != 240 || !records[0].Date.Equal(want) {
+		t.Errorf("levels = %+v, want 240 at %s", records, want)
+	}
+}
+
+func TestRecordsNotMeasured(t *testing.T) {
+
+	telegram := model.Telegram{
+		Id:                    uuid.New(),
+		PostCode:              "10950",
+		DateTime:              time.Date(2024, time.April, 12, 8, 0, 0, 0, time.UTC),
+		WaterLevelOnTime:      decoder_types.NewNotMeasured[int32](),
+		WaterLevelOn20h:       decoder_types.NewNotMeasured[int32](),
+		WaterTemperature:      decoder_types.NewNotMeasured[float64](),
+		AirTemperature:        decoder_types.NewNotMeasured[int32](),
+		Ice:                   decoder_types.NewNotMeasured[int32](),
+		Waterflow:             decoder_types.NewNotMeasured[float64](),
+		PrecipitationValue:    decoder_types.NewNotMeasured[float64](),
+		PrecipitationDuration: decoder_types.NewNotMeasured[byte](),
+	}
+
+	if messages := NewRecords([]model.Telegram{telegram}).Messages("transfer", 0); len(messages) != 0 {
+		t.Errorf("Messages() = %v, want no families", messages)
+	}
+}
@@ -0,0 +1,72 @@
+package kafka_dto
+
+import (
+	pb "github.com/IAmFutureHokage/HL-BufferService/internal/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// The schemas of the records are declared in internal/proto.
+
+func (WaterLevelRecords) ProtoSchema() (string, proto.Message) {
+	return pb.RecordsSchema, &pb.WaterLevelRecords{}
+}
+
+func (WaterLevelRecords) AvroSchema() string {
+	return pb.AvroSchema(string(FamilyWaterLevel))
+}
+
+func (TemperatureRecords) ProtoSchema() (string, proto.Message) {
+	return pb.RecordsSchema, &pb.TemperatureRecords{}
+}
+
+func (TemperatureRecords) AvroSchema() string {
+	return pb.AvroSchema(string(FamilyTemperature))
+}
+
+func (IceRecords) ProtoSchema() (string, proto.Message) {
+	return pb.RecordsSchema, &pb.IceRecords{}
+}
+
+func (IceRecords) AvroSchema() string {
+	return pb.AvroSchema(string(FamilyIce))
+}
+
+func (WaterflowRecords) ProtoSchema() (string, proto.Message) {
+	return pb.RecordsSchema, &pb.WaterflowRecords{}
+}
+
+func (WaterflowRecords) AvroSchema() string {
+	return pb.AvroSchema(string(FamilyWaterflow))
+}
+
+func (PrecipitationRecords) ProtoSchema() (string, proto.Message) {
+	return pb.RecordsSchema, &pb.PrecipitationRecords{}
+}
+
+func (PrecipitationRecords) AvroSchema() string {
+	return pb.AvroSchema(string(FamilyPrecipitation))
+}
+
+func (ReservoirRecords) ProtoSchema() (string, proto.Message) {
+	return pb.RecordsSchema, &pb.ReservoirRecords{}
+}
+
+func (ReservoirRecords) AvroSchema() string {
+	return pb.AvroSchema(string(FamilyReservoir))
+}
+
+func (InflowRecords) ProtoSchema() (string, proto.Message) {
+	return pb.RecordsSchema, &pb.InflowRecords{}
+}
+
+func (InflowRecords) AvroSchema() string {
+	return pb.AvroSchema(string(FamilyInflow))
+}
+
+func (DischargeRecords) ProtoSchema() (string, proto.Message) {
+	return pb.RecordsSchema, &pb.DischargeRecords{}
+}
+
+func (DischargeRecords) AvroSchema() string {
+	return pb.AvroSchema(string(FamilyDischarge))
+}
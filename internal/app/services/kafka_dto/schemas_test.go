@@ -0,0 +1,257 @@
+package kafka_dto
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/internal/app/model"
+	decoder_types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+	"github.com/google/uuid"
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestSchemas encodes a record of every family with the schemas in
+// internal/proto and checks that decoding it gives back the JSON payload.
+func TestSchemas(t *testing.T) {
+
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]int{"id": 1})
+	}))
+	defer registry.Close()
+
+	messages := NewRecords([]model.Telegram{fullTelegram()}).Messages(uuid.New().String(), 0)
+
+	families := []Family{
+		FamilyWaterLevel, FamilyTemperature, FamilyIce, FamilyWaterflow,
+		FamilyPrecipitation, FamilyReservoir, FamilyInflow, FamilyDischarge,
+	}
+
+	for _, format := range []kafka.Format{kafka.FormatProtobuf, kafka.FormatAvro} {
+
+		serializer, err := kafka.NewSerializer(kafka.KafkaConfig{
+			Format:         format,
+			SchemaRegistry: kafka.SchemaRegistryConfig{URL: registry.URL},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, family := range families {
+			t.Run(string(format)+"/"+string(family), func(t *testing.T) {
+
+				if len(messages[family]) != 1 {
+					t.Fatalf("%d messages, want 1", len(messages[family]))
+				}
+				message := messages[family][0]
+
+				payload, err := message.Serialize()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				encoded, err := serializer.Encode(context.Background(), "records", message)
+				if err != nil {
+					t.Fatalf("Encode() error = %v", err)
+				}
+				framed, err := encoded.Serialize()
+				if err != nil {
+					t.Fatal(err)
+				}
+				_, value, err := kafka.Unframe(framed)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var decoded []byte
+				switch format {
+				case kafka.FormatProtobuf:
+					decoded = decodeProto(t, message.(kafka.ProtoEncodable), value)
+				case kafka.FormatAvro:
+					decoded = decodeAvro(t, message.(kafka.AvroEncodable), value)
+				}
+
+				if want, got := jsonValue(t, payload), jsonValue(t, decoded); !reflect.DeepEqual(got, want) {
+					t.Errorf("decoded %s\nwant %s", decoded, payload)
+				}
+			})
+		}
+	}
+}
+
+// fullTelegram has a value in every family.
+func fullTelegram() model.Telegram {
+
+	date := time.Date(2024, time.April, 12, 8, 0, 0, 0, time.UTC)
+	day := time.Date(2024, time.April, 11, 0, 0, 0, 0, time.UTC)
+
+	return model.Telegram{
+		Id:                         uuid.New(),
+		GroupId:                    uuid.New(),
+		PostCode:                   "10950",
+		DateTime:                   date,
+		WaterLevelOnTime:           decoder_types.NewMeasured[int32](245),
+		WaterLevelOn20h:            decoder_types.NewMeasured[int32](240),
+		WaterTemperature:           decoder_types.NewMeasured(4.5),
+		AirTemperature:             decoder_types.NewMeasured[int32](-3),
+		IcePhenomeniaState:         sql.NullByte{Byte: 0, Valid: true},
+		IcePhenomenia:              []*model.Phenomenia{{Phenomen: 11}, {Phenomen: 13, IsUntensity: true, Intensity: sql.NullByte{Byte: 2, Valid: true}}},
+		Ice:                        decoder_types.NewMeasured[int32](35),
+		Snow:                       decoder_types.NewMeasured[byte](4),
+		Waterflow:                  decoder_types.NewMeasured(12.5),
+		PrecipitationValue:         decoder_types.NewMeasured(0.7),
+		PrecipitationDuration:      decoder_types.NewMeasured[byte](2),
+		ReservoirDate:              sql.NullTime{Time: day, Valid: true},
+		HeadwaterLevel:             decoder_types.NewMeasured[int32](1520),
+		AverageReservoirLevel:      decoder_types.NewMeasured[int32](1518),
+		DownstreamLevel:            decoder_types.NewMeasured[int32](310),
+		ReservoirVolume:            decoder_types.NewMeasured(23.4),
+		IsReservoirWaterInflowDate: sql.NullTime{Time: day, Valid: true},
+		Inflow:                     decoder_types.NewMeasured(150.0),
+		Reset:                      decoder_types.NewMeasured(142.5),
+		MeasuredWaterLevel:         decoder_types.NewMeasured[int32](246),
+		MeasuredDischarge:          decoder_types.NewMeasured(118.0),
+		CrossSectionArea:           decoder_types.NewMeasured(86.3),
+		AverageVelocity:            decoder_types.NewMeasured[int32](137),
+		MaxDepth:                   decoder_types.NewMeasured[int32](420),
+		MeasurementTime:            sql.NullTime{Time: day.Add(10 * time.Hour), Valid: true},
+	}
+}
+
+// decodeProto reads a payload after its schema id and returns it as JSON
+// with the field names of the schema.
+func decodeProto(t *testing.T, encodable kafka.ProtoEncodable, value []byte) []byte {
+
+	// The message indexes: a single zero or their count and the indexes.
+	count, n := binary.Varint(value)
+	if n <= 0 {
+		t.Fatal("no message indexes")
+	}
+	value = value[n:]
+	for i := int64(0); i < count; i++ {
+		_, n := binary.Varint(value)
+		if n <= 0 {
+			t.Fatal("truncated message indexes")
+		}
+		value = value[n:]
+	}
+
+	_, message := encodable.ProtoSchema()
+	if err := proto.Unmarshal(value, message); err != nil {
+		t.Fatalf("proto.Unmarshal() error = %v", err)
+	}
+
+	decoded, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(message)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return decoded
+}
+
+// decodeAvro reads a payload after its schema id and returns it as JSON in
+// the form of the payload: unions unwrapped, timestamps in RFC 3339.
+func decodeAvro(t *testing.T, encodable kafka.AvroEncodable, value []byte) []byte {
+
+	codec, err := goavro.NewCodec(encodable.AvroSchema())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	native, rest, err := codec.NativeFromBinary(value)
+	if err != nil {
+		t.Fatalf("NativeFromBinary() error = %v", err)
+	}
+	if len(rest) != 0 {
+		t.Fatalf("%d bytes left after the record", len(rest))
+	}
+
+	var schema any
+	if err := json.Unmarshal([]byte(encodable.AvroSchema()), &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := json.Marshal(fromAvro(schema, native))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return decoded
+}
+
+// fromAvro leaves out nulls and empty arrays like the omitempty fields of
+// the payload.
+func fromAvro(schema, native any) any {
+
+	switch schema := schema.(type) {
+	case []any:
+		union, ok := native.(map[string]any)
+		if !ok {
+			return nil
+		}
+		for _, branch := range schema {
+			if branch == "null" {
+				continue
+			}
+			for _, value := range union {
+				return fromAvro(branch, value)
+			}
+		}
+		return nil
+
+	case map[string]any:
+		if _, ok := schema["logicalType"]; ok {
+			return native.(time.Time).UTC().Format(time.RFC3339Nano)
+		}
+
+		switch schema["type"] {
+		case "record":
+			object := native.(map[string]any)
+			record := map[string]any{}
+			for _, field := range schema["fields"].([]any) {
+				field := field.(map[string]any)
+				name := field["name"].(string)
+				value := fromAvro(field["type"], object[name])
+				if array, ok := value.([]any); value == nil || ok && len(array) == 0 {
+					continue
+				}
+				record[name] = value
+			}
+			return record
+
+		case "array":
+			items := native.([]any)
+			array := make([]any, len(items))
+			for i, item := range items {
+				array[i] = fromAvro(schema["items"], item)
+			}
+			return array
+
+		default:
+			return fromAvro(schema["type"], native)
+		}
+	}
+
+	return native
+}
+
+func jsonValue(t *testing.T, payload []byte) any {
+
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	if err := decoder.Decode(&value); err != nil {
+		t.Fatalf("%s: %v", payload, err)
+	}
+
+	return value
+}
@@ -8,6 +8,8 @@ import (
 
 // easyjson:json
 type WaterLevel struct {
+	Source
+
 	PostCode   string    `json:"post_code"`
 	Date       time.Time `json:"date"`
 	WaterLevel int32     `json:"water_level"`
@@ -15,6 +17,8 @@ type WaterLevel struct {
 
 // easyjson:json
 type WaterLevelRecords struct {
+	Meta
+
 	Waterlevels []WaterLevel `json:"waterlevels"`
 }
 
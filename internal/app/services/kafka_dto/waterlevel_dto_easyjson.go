@@ -44,7 +44,7 @@ func easyjson5cb20343DecodeGithubComIAmFutureHokageHLBufferServiceInternalAppSer
 				in.Delim('[')
 				if out.Waterlevels == nil {
 					if !in.IsDelim(']') {
-						out.Waterlevels = make([]WaterLevel, 0, 1)
+						out.Waterlevels = make([]WaterLevel, 0, 0)
 					} else {
 						out.Waterlevels = []WaterLevel{}
 					}
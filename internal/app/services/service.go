@@ -2,18 +2,29 @@ package services
 
 import (
 	"context"
-	"sync"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/IAmFutureHokage/HL-BufferService/internal/app/model"
 	"github.com/IAmFutureHokage/HL-BufferService/internal/app/services/kafka_dto"
 	pb "github.com/IAmFutureHokage/HL-BufferService/internal/proto"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/alert"
 	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
 	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/encoder"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/render"
 	decoder_types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/validation"
 	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
-	"github.com/Shopify/sarama"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/outbox"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/publisher"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -25,31 +36,145 @@ type Strorage interface {
 	GetAll(ctx context.Context) (*[]model.Telegram, error)
 	UpdateTelegram(ctx context.Context, updatedTelegram *model.Telegram) error
 	GetTelegramsById(ctx context.Context, ids []uuid.UUID) (*[]model.Telegram, error)
+	GetPreviousWaterLevel(ctx context.Context, postCode string, from, before time.Time) (*validation.Observation, error)
+	TransferTelegrams(ctx context.Context, ids []uuid.UUID, messages []outbox.Message, at time.Time) error
+	outbox.Store
 }
 
 type HydrologyBufferervice struct {
 	pb.UnimplementedHydrologyBufferServiceServer
-	storage       Strorage
-	KafkaProducer sarama.SyncProducer
-	KafkaConfig   kafka.KafkaConfig
+	storage          Strorage
+	publisher        publisher.Publisher
+	relay            *outbox.Relay
+	KafkaConfig      kafka.KafkaConfig
+	serializer       *kafka.Serializer
+	validationConfig validation.Config
+	stations         StationStorage
+	stationPolicy    StationPolicy
+	alerts           *alert.Dispatcher
+	envelopePatterns []*regexp.Regexp
 }
 
-func NewHydrologyBufferService(storage Strorage, kafkaProducer sarama.SyncProducer) *HydrologyBufferervice {
+// NewHydrologyBufferService returns a service publishing transferred
+// telegrams with publisher.
+func NewHydrologyBufferService(storage Strorage, publisher publisher.Publisher) *HydrologyBufferervice {
 	return &HydrologyBufferervice{
-		storage:       storage,
-		KafkaProducer: kafkaProducer,
+		storage:          storage,
+		publisher:        publisher,
+		relay:            outbox.NewRelay(storage, publisher, outbox.DefaultConfig()),
+		validationConfig: validation.DefaultConfig(),
 	}
 }
 
 func (s *HydrologyBufferervice) AddTelegram(ctx context.Context, req *pb.AddTelegramRequest) (*pb.AddTelegramResponse, error) {
 
-	draftTelegrams, err := decoder.NewtelegramsSlice(req.Code)
+	receivedAt := time.Now()
+	code, envelope := decoder.StripEnvelope(req.Code, s.envelopePatterns...)
+
+	// Telegram dates are resolved against the bulletin time when the
+	// heading carries one, since data may arrive after the day it reports.
+	opts := []decoder.Option{decoder.ReferenceTime(receivedAt)}
+	if envelope.Time != nil {
+		if sentAt, err := envelope.Time.Resolve(receivedAt); err == nil {
+			opts = append(opts, decoder.ReferenceTime(sentAt))
+		}
+	}
+	if req.ValidateOnly {
+		opts = append(opts, decoder.Lenient())
+	}
+	if req.Strict {
+		opts = append(opts, decoder.Strict())
+	}
+
+	bulletin, err := decoder.DecodeBulletin(code, opts...)
 	if err != nil {
+		return nil, decodeErrorStatus(err)
+	}
+
+	response := &pb.AddTelegramResponse{}
+	var telegrams []model.Telegram
+
+	for _, entry := range bulletin {
+
+		result := &pb.TelegramResult{
+			Index:  int32(entry.Index),
+			Offset: int32(entry.Offset),
+			Code:   entry.Text,
+		}
+		response.Results = append(response.Results, result)
+
+		problems := entry.Report.Errors
+		var decodeErr *decoder.DecodeError
+		if errors.As(entry.Err, &decodeErr) {
+			problems = append(problems, decodeErr)
+		}
+
+		result.Errors = decodeProblemsToProto(problems)
+		result.Warnings = decodeProblemsToProto(entry.Report.Warnings)
+		response.Errors = append(response.Errors, result.Errors...)
+		response.Warnings = append(response.Warnings, result.Warnings...)
+
+		if entry.Err != nil {
+			continue
+		}
+
+		station, stationWarnings, rejected, err := s.checkStation(ctx, entry.Telegrams)
+		if err != nil {
+			return nil, err
+		}
+		if rejected {
+			result.Errors = append(result.Errors, &pb.DecodeProblem{Code: string(stationWarnings[0].Code), Message: stationWarnings[0].Message})
+			response.Errors = append(response.Errors, result.Errors[len(result.Errors)-1])
+			continue
+		}
+
+		deltaWarnings, err := s.checkDeltas(ctx, entry.Telegrams, req.FillMissingDelta)
+		if err != nil {
+			return nil, err
+		}
+
+		groupTelegrams, err := newGroupTelegrams(entry.Telegrams, req.ValidateOnly)
+		if err != nil {
+			result.Errors = append(result.Errors, &pb.DecodeProblem{Code: encodeErrorCode, Message: err.Error()})
+			response.Errors = append(response.Errors, result.Errors[len(result.Errors)-1])
+			continue
+		}
+
+		for i := range groupTelegrams {
+			groupTelegrams[i].SetEnvelope(envelope, receivedAt)
+			s.assess(&groupTelegrams[i], entry.Telegrams[i], station, deltaWarnings[i], stationWarnings)
+		}
+
+		result.Success = len(problems) == 0
+		result.GroupId = groupTelegrams[0].GroupId.String()
+
+		for i := range groupTelegrams {
+			result.Telegrams = append(result.Telegrams, telegramToProto(&groupTelegrams[i]))
+		}
+
+		response.Telegrams = append(response.Telegrams, result.Telegrams...)
+		telegrams = append(telegrams, groupTelegrams...)
+	}
+
+	if req.ValidateOnly || len(telegrams) == 0 {
+		return response, nil
+	}
+
+	if err := s.storage.AddTelegram(ctx, telegrams); err != nil {
 		return nil, err
 	}
 
+	s.raiseAlerts(ctx, telegrams)
+
+	return response, nil
+}
+
+// newGroupTelegrams converts the telegrams decoded from one station report
+// into storage models sharing a group id. With allowEncodeErrors a telegram
+// that cannot be encoded is kept without its code.
+func newGroupTelegrams(draftTelegrams []*decoder.Telegram, allowEncodeErrors bool) ([]model.Telegram, error) {
+
 	telegrams := make([]model.Telegram, len(draftTelegrams))
-	respose := make([]*pb.Telegram, len(draftTelegrams))
 	groupId := uuid.New()
 
 	for i := 0; i < len(telegrams); i++ {
@@ -61,7 +186,7 @@ func (s *HydrologyBufferervice) AddTelegram(ctx context.Context, req *pb.AddTele
 		telegrams[i].GroupId = groupId
 		telegrams[i].TelegramCode = codeTg
 
-		if err != nil {
+		if err != nil && !allowEncodeErrors {
 			return nil, err
 		}
 
@@ -69,17 +194,163 @@ func (s *HydrologyBufferervice) AddTelegram(ctx context.Context, req *pb.AddTele
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		respose[i] = telegramToProto(&telegrams[i])
+	return telegrams, nil
+}
+
+// checkDeltas compares the change reported by every telegram with the level
+// preceding it, taken from the other telegrams of the report or from
+// storage. With fill a missing change is computed instead.
+func (s *HydrologyBufferervice) checkDeltas(ctx context.Context, draftTelegrams []*decoder.Telegram, fill bool) ([]validation.Warnings, error) {
+
+	warnings := make([]validation.Warnings, len(draftTelegrams))
+
+	lookback := s.validationConfig.DeltaLookback
+	if lookback <= 0 {
+		return warnings, nil
 	}
 
-	if err := s.storage.AddTelegram(ctx, telegrams); err != nil {
-		return nil, err
+	for i, draft := range draftTelegrams {
+
+		if !draft.WaterLevelOnTime.IsMeasured() || draft.DateTime.IsZero() {
+			continue
+		}
+
+		before := draft.DateTime.Truncate(time.Hour)
+		from := before.Add(-lookback)
+
+		previous, err := s.storage.GetPreviousWaterLevel(ctx, string(draft.PostCode), from, before)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, other := range draftTelegrams {
+			level, ok := other.WaterLevelOnTime.Get()
+			at := other.DateTime.Truncate(time.Hour)
+			if !ok || other.PostCode != draft.PostCode || at.Before(from) || !at.Before(before) {
+				continue
+			}
+			if previous == nil || at.After(previous.DateTime) {
+				previous = &validation.Observation{DateTime: at, WaterLevel: int32(level)}
+			}
+		}
+
+		if previous == nil {
+			continue
+		}
+
+		if fill {
+			if warning, ok := validation.FillDelta(draft, *previous); ok {
+				warnings[i] = append(warnings[i], warning)
+				continue
+			}
+		}
+
+		warnings[i] = append(warnings[i], validation.ValidateDelta(draft, *previous, s.validationConfig)...)
 	}
 
-	return &pb.AddTelegramResponse{
-		Telegrams: respose,
-	}, nil
+	return warnings, nil
+}
+
+// checkStation looks up the station sending a report. Reports of unknown
+// or inactive stations are warned about or rejected as the station policy
+// says; sections the station is not expected to send are only warned about.
+func (s *HydrologyBufferervice) checkStation(ctx context.Context, draftTelegrams []*decoder.Telegram) (*model.Station, validation.Warnings, bool, error) {
+
+	if s.stations == nil || len(draftTelegrams) == 0 {
+		return nil, nil, false, nil
+	}
+
+	postCode := string(draftTelegrams[0].PostCode)
+
+	station, err := s.stations.GetStation(ctx, postCode)
+	if err != nil && !errors.Is(err, model.ErrStationNotFound) {
+		return nil, nil, false, err
+	}
+
+	if s.stationPolicy == StationPolicyIgnore {
+		return station, nil, false, nil
+	}
+
+	var warnings validation.Warnings
+
+	switch {
+	case station == nil:
+		warnings = append(warnings, validation.Warning{
+			Code:    validation.CodeUnknownStation,
+			Field:   validation.FieldPostCode,
+			Message: fmt.Sprintf("post %s is not registered", postCode),
+		})
+	case !station.Active:
+		warnings = append(warnings, validation.Warning{
+			Code:    validation.CodeInactiveStation,
+			Field:   validation.FieldPostCode,
+			Message: fmt.Sprintf("station %s %s is inactive", postCode, station.Name),
+		})
+	default:
+		for _, section := range reportSections(draftTelegrams) {
+			if !station.Expects(section) {
+				warnings = append(warnings, validation.Warning{
+					Code:    validation.CodeUnexpectedSection,
+					Field:   validation.FieldPostCode,
+					Message: fmt.Sprintf("station %s %s is not expected to send section %s", postCode, station.Name, section),
+				})
+			}
+		}
+	}
+
+	rejected := s.stationPolicy == StationPolicyReject && (station == nil || !station.Active)
+
+	return station, warnings, rejected, nil
+}
+
+// assess stores the findings about draft in telegram: its warnings, the
+// station name and the severity under the station thresholds.
+func (s *HydrologyBufferervice) assess(telegram *model.Telegram, draft *decoder.Telegram, station *model.Station, warnings ...validation.Warnings) {
+
+	telegram.Warnings = validation.Validate(draft, s.validationConfig)
+	for _, w := range warnings {
+		telegram.Warnings = append(telegram.Warnings, w...)
+	}
+
+	telegram.StationName = ""
+	telegram.Severity = validation.SeverityUnknown
+	if station != nil {
+		telegram.StationName = station.Name
+		telegram.Severity = validation.Evaluate(draft, station.Thresholds)
+	}
+
+	telegram.Warnings = append(telegram.Warnings, validation.CheckDanger(draft, telegram.Severity)...)
+}
+
+// reportSections lists the sections of a report decoded into
+// draftTelegrams, every telegram after the first is a 922 section.
+func reportSections(draftTelegrams []*decoder.Telegram) []decoder.Section {
+
+	sections := []decoder.Section{decoder.SectionMain}
+	if len(draftTelegrams) > 1 {
+		sections = append(sections, decoder.SectionPreviousDay)
+	}
+
+	var reservoir, inflow, discharge bool
+	for _, draft := range draftTelegrams {
+		reservoir = reservoir || draft.Reservoir != nil
+		inflow = inflow || draft.ReservoirWaterInflow != nil
+		discharge = discharge || draft.MeasuredDischarge != nil
+	}
+
+	if reservoir {
+		sections = append(sections, decoder.SectionReservoir)
+	}
+	if inflow {
+		sections = append(sections, decoder.SectionReservoirInflow)
+	}
+	if discharge {
+		sections = append(sections, decoder.SectionMeasuredDischarge)
+	}
+
+	return sections
 }
 
 func (s *HydrologyBufferervice) RemoveTelegrams(ctx context.Context, req *pb.RemoveTelegramsRequest) (*pb.RemoveTelegramsResponse, error) {
@@ -118,19 +389,37 @@ func (s *HydrologyBufferervice) UpdateTelegramByInfo(ctx context.Context, req *p
 
 	draftTelegram.DateAndTime.EndBlockNum = telegram.EndBlockNum
 
+	station, stationWarnings, rejected, err := s.checkStation(ctx, []*decoder.Telegram{draftTelegram})
+	if err != nil {
+		return nil, err
+	}
+	if rejected {
+		return nil, status.Error(codes.FailedPrecondition, stationWarnings[0].Message)
+	}
+
+	deltaWarnings, err := s.checkDeltas(ctx, []*decoder.Telegram{draftTelegram}, req.FillMissingDelta)
+	if err != nil {
+		return nil, err
+	}
+
 	telegramCode, err := encoder.Encoder(draftTelegram)
 	if err != nil {
 		return nil, err
 	}
 
-	telegram.Update(draftTelegram)
+	if err := telegram.Update(draftTelegram); err != nil {
+		return nil, err
+	}
 	telegram.TelegramCode = telegramCode
+	s.assess(telegram, draftTelegram, station, deltaWarnings[0], stationWarnings)
 
 	err = s.storage.UpdateTelegram(ctx, telegram)
 	if err != nil {
 		return nil, err
 	}
 
+	s.raiseAlerts(ctx, []model.Telegram{*telegram})
+
 	response := telegramToProto(telegram)
 
 	return &pb.UpdateTelegramResponse{
@@ -140,34 +429,63 @@ func (s *HydrologyBufferervice) UpdateTelegramByInfo(ctx context.Context, req *p
 
 func (s *HydrologyBufferervice) UpdateTelegramByCode(ctx context.Context, req *pb.UpdateTelegramByCodeRequest) (*pb.UpdateTelegramResponse, error) {
 
-	draftTelegram, err := decoder.NewTelegram(req.TelegramCode)
+	telegramId, err := uuid.Parse(req.Id)
 	if err != nil {
 		return nil, err
 	}
 
-	telegramCode, err := encoder.Encoder(draftTelegram)
+	telegram, err := s.storage.GetTelegramByID(ctx, telegramId)
 	if err != nil {
 		return nil, err
 	}
 
-	telegramId, err := uuid.Parse(req.Id)
+	// A correction may come days after the telegram it replaces, so its
+	// date is resolved against when that telegram was sent rather than
+	// against now.
+	code, _ := decoder.StripEnvelope(req.TelegramCode, s.envelopePatterns...)
+	reference := time.Now()
+	if telegram.BulletinTime.Valid {
+		reference = telegram.BulletinTime.Time
+	} else if !telegram.DateTime.IsZero() {
+		reference = telegram.DateTime
+	}
+
+	draftTelegram, _, err := decoder.Decode(code, decoder.ReferenceTime(reference))
+	if err != nil {
+		return nil, decodeErrorStatus(err)
+	}
+
+	station, stationWarnings, rejected, err := s.checkStation(ctx, []*decoder.Telegram{draftTelegram})
 	if err != nil {
 		return nil, err
 	}
+	if rejected {
+		return nil, status.Error(codes.FailedPrecondition, stationWarnings[0].Message)
+	}
 
-	telegram, err := s.storage.GetTelegramByID(ctx, telegramId)
+	deltaWarnings, err := s.checkDeltas(ctx, []*decoder.Telegram{draftTelegram}, req.FillMissingDelta)
 	if err != nil {
 		return nil, err
 	}
 
-	telegram.Update(draftTelegram)
+	telegramCode, err := encoder.Encoder(draftTelegram)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := telegram.Update(draftTelegram); err != nil {
+		return nil, err
+	}
 	telegram.TelegramCode = telegramCode
+	s.assess(telegram, draftTelegram, station, deltaWarnings[0], stationWarnings)
 
 	err = s.storage.UpdateTelegram(ctx, telegram)
 	if err != nil {
 		return nil, err
 	}
 
+	s.raiseAlerts(ctx, []model.Telegram{*telegram})
+
 	response := telegramToProto(telegram)
 
 	return &pb.UpdateTelegramResponse{
@@ -200,11 +518,14 @@ func (s *HydrologyBufferervice) GetTelegrams(ctx context.Context, req *pb.GetTel
 		return nil, err
 	}
 
-	response := make([]*pb.Telegram, len(*telegrams))
+	response := make([]*pb.Telegram, 0, len(*telegrams))
 
-	for i := 0; i < len(response); i++ {
+	for i := 0; i < len(*telegrams); i++ {
 		telegram := telegramToProto(&(*telegrams)[i]) // передаем указатель на элемент среза
-		response[i] = telegram
+		if req.DangerMismatchOnly && !telegram.DangerMismatch {
+			continue
+		}
+		response = append(response, telegram)
 	}
 
 	return &pb.GetTelegramsResponse{
@@ -231,68 +552,34 @@ func (s *HydrologyBufferervice) TransferToSystem(ctx context.Context, req *pb.Tr
 
 	const maxBatchSize = 100 // Максимальное количество элементов в батче
 
-	numBatches := (len(*telegrams)*2 + maxBatchSize - 1) / maxBatchSize
-	batches := make([]kafka_dto.WaterLevelRecords, numBatches)
+	// The messages are stored with the telegrams marked transferred and
+	// published by the outbox relay.
+	var messages []outbox.Message
 
-	for i := 0; i < len(batches); i++ {
-		batches[i] = *kafka_dto.NewWaterLevelRecords(maxBatchSize)
-	}
-
-	addToBatch := func(wl kafka_dto.WaterLevel, idx int) {
-		batchIdx := idx / maxBatchSize
-		batches[batchIdx].Waterlevels = append(batches[batchIdx].Waterlevels, wl)
-	}
+	transferId := uuid.New().String()
 
-	for idx, telegram := range *telegrams {
-		if telegram.WaterLevelOnTime.Valid && telegram.WaterLevelOnTime.Int32 != decoder_types.CouldNotMeasure {
-			addToBatch(kafka_dto.WaterLevel{
-				Date:       telegram.DateTime,
-				WaterLevel: telegram.WaterLevelOnTime.Int32,
-				PostCode:   telegram.PostCode,
-			}, idx)
-			idx++
-		}
-
-		if telegram.WaterLevelOn20h.Valid && telegram.WaterLevelOn20h.Int32 != decoder_types.CouldNotMeasure {
-			settime := time.Date(
-				telegram.DateTime.Year(),
-				telegram.DateTime.Month(),
-				telegram.DateTime.Day(),
-				20, 0, 0, 0,
-				telegram.DateTime.Location(),
-			)
-			addToBatch(kafka_dto.WaterLevel{
-				Date:       settime,
-				WaterLevel: telegram.WaterLevelOn20h.Int32,
-				PostCode:   telegram.PostCode,
-			}, idx)
-			idx++
-		}
-	}
-
-	var wg sync.WaitGroup
-	errCh := make(chan error, len(batches))
-
-	for _, batch := range batches {
-		wg.Add(1)
-		go func(b kafka_dto.WaterLevelRecords) {
-			defer wg.Done()
-			if err := kafka.SendMessageToKafka(s.KafkaProducer, s.KafkaConfig.Topic, &b); err != nil {
-				errCh <- err
+	for family, batches := range kafka_dto.NewRecords(*telegrams).Messages(transferId, maxBatchSize) {
+		topic := s.KafkaConfig.TopicFor(string(family))
+		for _, batch := range batches {
+			encoded, err := s.serializer.Encode(ctx, topic, batch)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", family, err)
 			}
-		}(batch)
+			message, err := outbox.NewMessage(topic, encoded)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", family, err)
+			}
+			messages = append(messages, message)
+		}
 	}
-	go func() {
-		wg.Wait()
-		close(errCh)
-	}()
 
-	for err := range errCh {
+	if err := s.storage.TransferTelegrams(ctx, uuids, messages, time.Now()); err != nil {
 		return nil, err
 	}
 
-	if err := s.storage.RemoveTelegrams(ctx, uuids); err != nil {
-		return nil, err
+	// Messages the relay cannot publish now are retried by RunOutbox.
+	if _, err := s.relay.Flush(ctx); err != nil {
+		log.Printf("Transfer: %v", err)
 	}
 
 	return &pb.TransferToSystemResponse{
@@ -300,113 +587,222 @@ func (s *HydrologyBufferervice) TransferToSystem(ctx context.Context, req *pb.Tr
 	}, nil
 }
 
-func telegramToProto(req *model.Telegram) (res *pb.Telegram) {
-	res = &pb.Telegram{}
-
-	res.Id = req.Id.String()
-	res.GroupId = req.GroupId.String()
-	res.TelegramCode = req.TelegramCode
-	res.PostCode = req.PostCode
-	res.Datetime = timestamppb.New(req.DateTime)
-	res.IsDangerous = req.IsDangerous
+func (s *HydrologyBufferervice) ListPhenomena(ctx context.Context, req *pb.ListPhenomenaRequest) (*pb.ListPhenomenaResponse, error) {
 
-	if req.WaterLevelOnTime.Valid {
-		res.WaterLevelOnTime = &wrapperspb.Int32Value{
-			Value: req.WaterLevelOnTime.Int32,
-		}
+	phenomena := decoder_types.Phenomena()
+	response := &pb.ListPhenomenaResponse{
+		Phenomena: make([]*pb.PhenomenonInfo, len(phenomena)),
 	}
-	if req.DeltaWaterLevel.Valid {
-		res.DeltaWaterLevel = &wrapperspb.Int32Value{
-			Value: req.DeltaWaterLevel.Int32,
+
+	for i, info := range phenomena {
+		response.Phenomena[i] = &pb.PhenomenonInfo{
+			Code:             pb.Phenomenon(info.Code),
+			NameRu:           info.NameRu,
+			NameEn:           info.NameEn,
+			Category:         phenomenonCategoryToProto[info.Category],
+			IntensityAllowed: info.IntensityAllowed,
 		}
 	}
-	if req.WaterLevelOn20h.Valid {
-		res.WaterLevelOn20H = &wrapperspb.Int32Value{
-			Value: req.WaterLevelOn20h.Int32,
+
+	return response, nil
+}
+
+// DescribeTelegram renders the telegrams decoded from req.Code, or the stored
+// telegram req.Id when no code is given, as readable text.
+func (s *HydrologyBufferervice) DescribeTelegram(ctx context.Context, req *pb.DescribeTelegramRequest) (*pb.DescribeTelegramResponse, error) {
+
+	var telegrams []*decoder.Telegram
+
+	if req.Code != "" {
+		code, _ := decoder.StripEnvelope(req.Code, s.envelopePatterns...)
+
+		decoded, _, err := decoder.DecodeSlice(code, decoder.ReferenceTime(time.Now()))
+		if err != nil {
+			return nil, decodeErrorStatus(err)
 		}
-	}
-	if req.WaterTemperature.Valid {
-		res.WaterTemperature = &wrapperspb.DoubleValue{
-			Value: req.WaterTemperature.Float64,
+		telegrams = decoded
+	} else {
+		telegramId, err := uuid.Parse(req.Id)
+		if err != nil {
+			return nil, err
 		}
-	}
-	if req.AirTemperature.Valid {
-		res.AirTemperature = &wrapperspb.Int32Value{
-			Value: req.AirTemperature.Int32,
+
+		telegram, err := s.storage.GetTelegramByID(ctx, telegramId)
+		if err != nil {
+			return nil, err
 		}
+		telegrams = append(telegrams, protoToDraft(telegramToProto(telegram)))
 	}
-	if req.IcePhenomeniaState.Valid {
-		res.IcePhenomeniaState = &wrapperspb.Int32Value{
-			Value: int32(req.IcePhenomeniaState.Byte),
-		}
+
+	language, ok := languageFromProto[req.Language]
+	if !ok {
+		return nil, errors.New("unsupported language")
 	}
-	if req.Ice.Valid {
-		res.IceHeight = &wrapperspb.Int32Value{
-			Value: req.Ice.Int32,
-		}
+
+	response := &pb.DescribeTelegramResponse{
+		Descriptions: make([]string, len(telegrams)),
 	}
-	if req.Snow.Valid {
-		res.SnowHeight = &wrapperspb.Int32Value{
-			Value: int32(req.Snow.Byte),
+
+	for i, telegram := range telegrams {
+		description, err := render.Describe(telegram, language)
+		if err != nil {
+			return nil, err
 		}
+		response.Descriptions[i] = description
 	}
-	if req.Waterflow.Valid {
-		res.WaterFlow = &wrapperspb.DoubleValue{
-			Value: req.Waterflow.Float64,
-		}
+
+	return response, nil
+}
+
+// TokenizeTelegram classifies every group of req.Code for highlighting. It
+// accepts partial and invalid code; problems are returned per token.
+func (s *HydrologyBufferervice) TokenizeTelegram(ctx context.Context, req *pb.TokenizeTelegramRequest) (*pb.TokenizeTelegramResponse, error) {
+
+	code, _ := decoder.StripEnvelope(req.Code, s.envelopePatterns...)
+	tokens := decoder.Tokenize(code)
+
+	response := &pb.TokenizeTelegramResponse{
+		Tokens: make([]*pb.TelegramToken, len(tokens)),
 	}
-	if req.PrecipitationValue.Valid {
-		res.PrecipitationValue = &wrapperspb.DoubleValue{
-			Value: req.PrecipitationValue.Float64,
+
+	for i, token := range tokens {
+		res := &pb.TelegramToken{
+			Group:   token.Group,
+			Start:   int32(token.Start),
+			End:     int32(token.End),
+			Section: string(token.Section),
+			Kind:    string(token.Kind),
 		}
-	}
-	if req.PrecipitationDuration.Valid {
-		res.PrecipitationDuration = &wrapperspb.Int32Value{
-			Value: int32(req.PrecipitationDuration.Byte),
+
+		if token.Err != nil {
+			res.Problem = decodeProblemsToProto([]*decoder.DecodeError{token.Err})[0]
+		} else {
+			res.Value = tokenValueText(token.Value)
 		}
+
+		response.Tokens[i] = res
 	}
-	if req.ReservoirDate.Valid {
-		res.ReservoirDate = timestamppb.New(req.ReservoirDate.Time)
-	}
-	if req.HeadwaterLevel.Valid {
-		res.HeadwaterLevel = &wrapperspb.Int32Value{
-			Value: req.HeadwaterLevel.Int32,
-		}
+
+	return response, nil
+}
+
+func tokenValueText(value any) string {
+
+	phenomena, ok := value.([]decoder_types.Phenomenia)
+	if !ok {
+		return fmt.Sprintf("%+v", value)
 	}
-	if req.AverageReservoirLevel.Valid {
-		res.AverageReservoirLevel = &wrapperspb.Int32Value{
-			Value: req.AverageReservoirLevel.Int32,
+
+	parts := make([]string, len(phenomena))
+	for i, phenomenon := range phenomena {
+		parts[i] = strconv.Itoa(int(phenomenon.Phenomen))
+		if phenomenon.IsUntensity && phenomenon.Intensity != nil {
+			parts[i] += "/" + strconv.Itoa(int(*phenomenon.Intensity))
 		}
 	}
-	if req.DownstreamLevel.Valid {
-		res.DownstreamLevel = &wrapperspb.Int32Value{
-			Value: req.DownstreamLevel.Int32,
+
+	return strings.Join(parts, " ")
+}
+
+var languageFromProto = map[pb.Language]render.Language{
+	pb.Language_LANGUAGE_UNSPECIFIED: render.Russian,
+	pb.Language_LANGUAGE_RU:          render.Russian,
+	pb.Language_LANGUAGE_EN:          render.English,
+}
+
+var phenomenonCategoryToProto = map[decoder_types.PhenomenonCategory]pb.PhenomenonCategory{
+	decoder_types.CategoryIce:        pb.PhenomenonCategory_PHENOMENON_CATEGORY_ICE,
+	decoder_types.CategoryJam:        pb.PhenomenonCategory_PHENOMENON_CATEGORY_JAM,
+	decoder_types.CategoryIcing:      pb.PhenomenonCategory_PHENOMENON_CATEGORY_ICING,
+	decoder_types.CategoryWater:      pb.PhenomenonCategory_PHENOMENON_CATEGORY_WATER,
+	decoder_types.CategoryVegetation: pb.PhenomenonCategory_PHENOMENON_CATEGORY_VEGETATION,
+	decoder_types.CategoryDebris:     pb.PhenomenonCategory_PHENOMENON_CATEGORY_DEBRIS,
+	decoder_types.CategoryChannel:    pb.PhenomenonCategory_PHENOMENON_CATEGORY_CHANNEL,
+}
+
+var severityToProto = map[validation.Severity]pb.Severity{
+	validation.SeverityUnknown:    pb.Severity_SEVERITY_UNKNOWN,
+	validation.SeverityNone:       pb.Severity_SEVERITY_NONE,
+	validation.SeverityFavourable: pb.Severity_SEVERITY_FAVOURABLE,
+	validation.SeverityAdverse:    pb.Severity_SEVERITY_ADVERSE,
+	validation.SeverityDangerous:  pb.Severity_SEVERITY_DANGEROUS,
+}
+
+func telegramToProto(req *model.Telegram) (res *pb.Telegram) {
+	res = &pb.Telegram{}
+
+	res.Id = req.Id.String()
+	res.GroupId = req.GroupId.String()
+	res.TelegramCode = req.TelegramCode
+	res.PostCode = req.PostCode
+	res.Datetime = timestamppb.New(req.DateTime)
+	res.IsDangerous = req.IsDangerous
+	res.StationName = req.StationName
+	res.Severity = severityToProto[req.Severity]
+	res.DangerMismatch = validation.DangerMismatch(req.IsDangerous, req.Severity)
+
+	res.WaterLevelOnTime = int32MeasurementToProto(req.WaterLevelOnTime)
+	res.DeltaWaterLevel = int32MeasurementToProto(req.DeltaWaterLevel)
+	res.WaterLevelOn20H = int32MeasurementToProto(req.WaterLevelOn20h)
+	res.WaterTemperature = doubleMeasurementToProto(req.WaterTemperature)
+	res.AirTemperature = int32MeasurementToProto(req.AirTemperature)
+	if req.IcePhenomeniaState.Valid {
+		res.IcePhenomeniaState = &wrapperspb.Int32Value{
+			Value: int32(req.IcePhenomeniaState.Byte),
 		}
 	}
-	if req.ReservoirVolume.Valid {
-		res.ReservoirVolume = &wrapperspb.DoubleValue{
-			Value: req.ReservoirVolume.Float64,
-		}
+	res.IceHeight = int32MeasurementToProto(req.Ice)
+	res.SnowHeight = int32MeasurementToProto(req.Snow)
+	res.WaterFlow = doubleMeasurementToProto(req.Waterflow)
+	res.PrecipitationValue = doubleMeasurementToProto(req.PrecipitationValue)
+	res.PrecipitationDuration = int32MeasurementToProto(req.PrecipitationDuration)
+	if req.ReservoirDate.Valid {
+		res.ReservoirDate = timestamppb.New(req.ReservoirDate.Time)
 	}
+	res.HeadwaterLevel = int32MeasurementToProto(req.HeadwaterLevel)
+	res.AverageReservoirLevel = int32MeasurementToProto(req.AverageReservoirLevel)
+	res.DownstreamLevel = int32MeasurementToProto(req.DownstreamLevel)
+	res.ReservoirVolume = doubleMeasurementToProto(req.ReservoirVolume)
 	if req.IsReservoirWaterInflowDate.Valid {
 		res.ReservoirWaterInflowDate = timestamppb.New(req.IsReservoirWaterInflowDate.Time)
 	}
-	if req.Inflow.Valid {
-		res.Inflow = &wrapperspb.DoubleValue{
-			Value: req.Inflow.Float64,
+	res.Inflow = doubleMeasurementToProto(req.Inflow)
+	res.Reset_ = doubleMeasurementToProto(req.Reset)
+	if req.MeasuredDischargeMonth.Valid {
+		res.MeasuredDischargeMonth = &wrapperspb.Int32Value{
+			Value: int32(req.MeasuredDischargeMonth.Byte),
 		}
 	}
-	if req.Reset.Valid {
-		res.Reset_ = &wrapperspb.DoubleValue{
-			Value: req.Reset.Float64,
+	res.MeasuredWaterLevel = int32MeasurementToProto(req.MeasuredWaterLevel)
+	res.MeasuredDischarge = doubleMeasurementToProto(req.MeasuredDischarge)
+	res.CrossSectionArea = doubleMeasurementToProto(req.CrossSectionArea)
+	res.AverageVelocity = int32MeasurementToProto(req.AverageVelocity)
+	res.MaxDepth = int32MeasurementToProto(req.MaxDepth)
+	if req.MeasurementTime.Valid {
+		res.MeasurementTime = timestamppb.New(req.MeasurementTime.Time)
+	}
+	if req.Sender.Valid {
+		res.Sender = &wrapperspb.StringValue{
+			Value: req.Sender.String,
 		}
 	}
+	if req.BulletinTime.Valid {
+		res.BulletinTime = timestamppb.New(req.BulletinTime.Time)
+	}
+
+	for _, warning := range req.Warnings {
+		res.Warnings = append(res.Warnings, &pb.ValidationWarning{
+			Code:    string(warning.Code),
+			Field:   warning.Field,
+			Message: warning.Message,
+		})
+	}
 
 	if len(req.IcePhenomenia) != 0 {
 		res.IcePhenomenias = make([]*pb.IcePhenomenia, len(req.IcePhenomenia))
 
 		for i := 0; i < len(res.IcePhenomenias); i++ {
-			res.IcePhenomenias[i] = &pb.IcePhenomenia{Phenomen: int32(req.IcePhenomenia[i].Phenomen)}
+			res.IcePhenomenias[i] = &pb.IcePhenomenia{Phenomen: pb.Phenomenon(req.IcePhenomenia[i].Phenomen)}
 
 			if req.IcePhenomenia[i].IsUntensity && req.IcePhenomenia[i].Intensity.Valid {
 				res.IcePhenomenias[i].Intensity = &wrapperspb.Int32Value{
@@ -424,39 +820,22 @@ func protoToDraft(req *pb.Telegram) (res *decoder.Telegram) {
 
 	res.PostCode = decoder_types.PostCode(req.PostCode)
 
-	res.DateAndTime.Date = byte(req.Datetime.AsTime().Day())
-	res.DateAndTime.Time = byte(req.Datetime.AsTime().Hour())
+	res.DateTime = req.Datetime.AsTime().Truncate(time.Hour)
+	res.DateAndTime.Date = byte(res.DateTime.Day())
+	res.DateAndTime.Time = byte(res.DateTime.Hour())
 	res.DateAndTime.EndBlockNum = 1
 
 	res.IsDangerous = decoder_types.IsDangerous(req.IsDangerous)
 
-	if req.WaterLevelOnTime != nil {
-		buffer := decoder_types.WaterLevelOnTime(req.WaterLevelOnTime.Value)
-		res.WaterLevelOnTime = &buffer
-	}
-
-	if req.DeltaWaterLevel != nil {
-		buffer := decoder_types.DeltaWaterLevel(req.DeltaWaterLevel.Value)
-		res.DeltaWaterLevel = &buffer
-	}
-
-	if req.WaterLevelOn20H != nil {
-		buffer := decoder_types.WaterLevelOn20h(req.WaterLevelOn20H.Value)
-		res.WaterLevelOn20h = &buffer
-	}
+	res.WaterLevelOnTime = int32MeasurementFromProto[decoder_types.WaterLevelOnTime](req.WaterLevelOnTime)
+	res.DeltaWaterLevel = int32MeasurementFromProto[decoder_types.DeltaWaterLevel](req.DeltaWaterLevel)
+	res.WaterLevelOn20h = int32MeasurementFromProto[decoder_types.WaterLevelOn20h](req.WaterLevelOn20H)
 
 	if req.WaterTemperature != nil || req.AirTemperature != nil {
 		res.Temperature = &decoder_types.Temperature{}
-	}
 
-	if req.WaterTemperature != nil {
-		buffer := req.WaterTemperature.Value
-		res.Temperature.WaterTemperature = &buffer
-	}
-
-	if req.AirTemperature != nil {
-		buffer := req.AirTemperature.Value
-		res.Temperature.AirTemperature = &buffer
+		res.Temperature.WaterTemperature = doubleMeasurementFromProto[float64](req.WaterTemperature)
+		res.Temperature.AirTemperature = int32MeasurementFromProto[int32](req.AirTemperature)
 	}
 
 	if req.IcePhenomeniaState != nil {
@@ -466,35 +845,18 @@ func protoToDraft(req *pb.Telegram) (res *decoder.Telegram) {
 
 	if req.IceHeight != nil || req.SnowHeight != nil {
 		res.IceInfo = &decoder_types.IceInfo{}
-	}
 
-	if req.IceHeight != nil {
-		buffer := req.IceHeight.Value
-		res.IceInfo.Ice = &buffer
+		res.IceInfo.Ice = int32MeasurementFromProto[int32](req.IceHeight)
+		res.IceInfo.Snow = int32MeasurementFromProto[decoder_types.SnowHeight](req.SnowHeight)
 	}
 
-	if req.SnowHeight != nil {
-		buffer := decoder_types.SnowHeight(req.SnowHeight.Value)
-		res.IceInfo.Snow = &buffer
-	}
-
-	if req.WaterFlow != nil {
-		buffer := decoder_types.Waterflow(req.WaterFlow.Value)
-		res.Waterflow = &buffer
-	}
+	res.Waterflow = doubleMeasurementFromProto[decoder_types.Waterflow](req.WaterFlow)
 
 	if req.PrecipitationValue != nil || req.PrecipitationDuration != nil {
 		res.Precipitation = &decoder_types.Precipitation{}
-	}
 
-	if req.PrecipitationValue != nil {
-		buffer := req.PrecipitationValue.Value
-		res.Precipitation.Value = &buffer
-	}
-
-	if req.PrecipitationDuration != nil {
-		buffer := decoder_types.PrecipitationDuration(req.PrecipitationDuration.Value)
-		res.Precipitation.Duration = &buffer
+		res.Precipitation.Value = doubleMeasurementFromProto[float64](req.PrecipitationValue)
+		res.Precipitation.Duration = int32MeasurementFromProto[decoder_types.PrecipitationDuration](req.PrecipitationDuration)
 	}
 
 	if req.ReservoirDate != nil {
@@ -502,25 +864,10 @@ func protoToDraft(req *pb.Telegram) (res *decoder.Telegram) {
 		res.IsReservoirDate = &buffer
 		res.Reservoir = &decoder_types.Reservoir{}
 
-		if req.HeadwaterLevel != nil {
-			buffer := decoder_types.HeadwaterLevel(req.HeadwaterLevel.Value)
-			res.Reservoir.HeadwaterLevel = &buffer
-		}
-
-		if req.AverageReservoirLevel != nil {
-			buffer := decoder_types.AverageReservoirLevel(req.AverageReservoirLevel.Value)
-			res.Reservoir.AverageReservoirLevel = &buffer
-		}
-
-		if req.DownstreamLevel != nil {
-			buffer := decoder_types.DownstreamLevel(req.DownstreamLevel.Value)
-			res.Reservoir.DownstreamLevel = &buffer
-		}
-
-		if req.ReservoirVolume != nil {
-			buffer := decoder_types.ReservoirVolume(req.ReservoirVolume.Value)
-			res.Reservoir.ReservoirVolume = &buffer
-		}
+		res.Reservoir.HeadwaterLevel = int32MeasurementFromProto[decoder_types.HeadwaterLevel](req.HeadwaterLevel)
+		res.Reservoir.AverageReservoirLevel = int32MeasurementFromProto[decoder_types.AverageReservoirLevel](req.AverageReservoirLevel)
+		res.Reservoir.DownstreamLevel = int32MeasurementFromProto[decoder_types.DownstreamLevel](req.DownstreamLevel)
+		res.Reservoir.ReservoirVolume = doubleMeasurementFromProto[decoder_types.ReservoirVolume](req.ReservoirVolume)
 	}
 
 	if req.ReservoirWaterInflowDate != nil {
@@ -528,14 +875,26 @@ func protoToDraft(req *pb.Telegram) (res *decoder.Telegram) {
 		res.IsReservoirWaterInflowDate = &buffer
 		res.ReservoirWaterInflow = &decoder_types.ReservoirWaterInflow{}
 
-		if req.Inflow != nil {
-			buffer := decoder_types.Inflow(req.Inflow.Value)
-			res.ReservoirWaterInflow.Inflow = &buffer
-		}
+		res.ReservoirWaterInflow.Inflow = doubleMeasurementFromProto[decoder_types.Inflow](req.Inflow)
+		res.ReservoirWaterInflow.Reset = doubleMeasurementFromProto[decoder_types.Reset](req.Reset_)
+	}
 
-		if req.Reset_ != nil {
-			buffer := decoder_types.Reset(req.Reset_.Value)
-			res.ReservoirWaterInflow.Reset = &buffer
+	if req.MeasuredDischargeMonth != nil {
+		buffer := decoder_types.IsMeasuredDischargeMonth(req.MeasuredDischargeMonth.Value)
+		res.IsMeasuredDischargeMonth = &buffer
+		res.MeasuredDischarge = &decoder_types.MeasuredDischarge{}
+
+		res.MeasuredDischarge.WaterLevel = int32MeasurementFromProto[decoder_types.MeasuredWaterLevel](req.MeasuredWaterLevel)
+		res.MeasuredDischarge.Discharge = doubleMeasurementFromProto[decoder_types.Discharge](req.MeasuredDischarge)
+		res.MeasuredDischarge.CrossSectionArea = doubleMeasurementFromProto[decoder_types.CrossSectionArea](req.CrossSectionArea)
+		res.MeasuredDischarge.AverageVelocity = int32MeasurementFromProto[decoder_types.AverageVelocity](req.AverageVelocity)
+		res.MeasuredDischarge.MaxDepth = int32MeasurementFromProto[decoder_types.MaxDepth](req.MaxDepth)
+
+		if req.MeasurementTime != nil {
+			res.MeasuredDischarge.MeasurementTime = &decoder_types.MeasurementTime{
+				Day:  byte(req.MeasurementTime.AsTime().Day()),
+				Hour: byte(req.MeasurementTime.AsTime().Hour()),
+			}
 		}
 	}
 
@@ -543,7 +902,7 @@ func protoToDraft(req *pb.Telegram) (res *decoder.Telegram) {
 		res.IcePhenomenia = make([]*decoder_types.Phenomenia, len(req.IcePhenomenias))
 
 		for i := 0; i < len(res.IcePhenomenia); i++ {
-			res.IcePhenomenia[i] = &decoder_types.Phenomenia{Phenomen: byte(req.IcePhenomenias[i].Phenomen)}
+			res.IcePhenomenia[i] = &decoder_types.Phenomenia{Phenomen: decoder_types.PhenomenonCode(req.IcePhenomenias[i].Phenomen)}
 
 			if req.IcePhenomenias[i].Intensity != nil {
 				res.IcePhenomenia[i].IsUntensity = true
@@ -556,6 +915,92 @@ func protoToDraft(req *pb.Telegram) (res *decoder.Telegram) {
 	return
 }
 
+func int32MeasurementToProto[T ~int32 | ~byte](m decoder_types.Measurement[T]) *pb.Int32Measurement {
+
+	switch m.State() {
+	case decoder_types.Absent:
+		return nil
+	case decoder_types.NotMeasured:
+		return &pb.Int32Measurement{NotMeasured: true}
+	}
+
+	value, _ := m.Get()
+	return &pb.Int32Measurement{Value: int32(value)}
+}
+
+func doubleMeasurementToProto(m decoder_types.Measurement[float64]) *pb.DoubleMeasurement {
+
+	switch m.State() {
+	case decoder_types.Absent:
+		return nil
+	case decoder_types.NotMeasured:
+		return &pb.DoubleMeasurement{NotMeasured: true}
+	}
+
+	value, _ := m.Get()
+	return &pb.DoubleMeasurement{Value: value}
+}
+
+func int32MeasurementFromProto[T ~int32 | ~byte](m *pb.Int32Measurement) decoder_types.Measurement[T] {
+
+	if m == nil {
+		return decoder_types.Measurement[T]{}
+	}
+
+	if m.NotMeasured {
+		return decoder_types.NewNotMeasured[T]()
+	}
+
+	return decoder_types.NewMeasured(T(m.Value))
+}
+
+func doubleMeasurementFromProto[T ~float64](m *pb.DoubleMeasurement) decoder_types.Measurement[T] {
+
+	if m == nil {
+		return decoder_types.Measurement[T]{}
+	}
+
+	if m.NotMeasured {
+		return decoder_types.NewNotMeasured[T]()
+	}
+
+	return decoder_types.NewMeasured(T(m.Value))
+}
+
 func (s *HydrologyBufferervice) SetKafkaConfig(config kafka.KafkaConfig) {
 	s.KafkaConfig = config
 }
+
+// SetOutboxConfig changes how transferred telegrams are published.
+func (s *HydrologyBufferervice) SetOutboxConfig(config outbox.Config) {
+	s.relay = outbox.NewRelay(s.storage, s.publisher, config)
+}
+
+// RunOutbox publishes the messages of transferred telegrams left in the
+// outbox until ctx is done. Errors are passed to report.
+func (s *HydrologyBufferervice) RunOutbox(ctx context.Context, report func(error)) {
+	s.relay.Run(ctx, report)
+}
+
+// SetSerializer makes transferred records encoded in the format of
+// serializer. Without one they are sent as JSON.
+func (s *HydrologyBufferervice) SetSerializer(serializer *kafka.Serializer) {
+	s.serializer = serializer
+}
+
+func (s *HydrologyBufferervice) SetValidationConfig(config validation.Config) {
+	s.validationConfig = config
+}
+
+// SetEnvelopePatterns replaces the patterns of the heading lines stripped
+// from incoming code, decoder.DefaultEnvelopePatterns by default.
+func (s *HydrologyBufferervice) SetEnvelopePatterns(patterns []*regexp.Regexp) {
+	s.envelopePatterns = patterns
+}
+
+// SetStationRegistry makes telegrams checked against the registered
+// stations. Without a registry post codes are not checked.
+func (s *HydrologyBufferervice) SetStationRegistry(stations StationStorage, policy StationPolicy) {
+	s.stations = stations
+	s.stationPolicy = policy
+}
@@ -0,0 +1,488 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/internal/app/model"
+	pb "github.com/IAmFutureHokage/HL-BufferService/internal/proto"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/validation"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/outbox"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/publisher"
+	"github.com/google/uuid"
+)
+
+func TestTransferToSystem(t *testing.T) {
+
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]int{"id": 1})
+	}))
+	defer registry.Close()
+
+	tests := []struct {
+		name            string
+		code            string
+		format          kafka.Format
+		wantTopics      []string
+		wantContentType string
+	}{
+		{
+			name:            "JSON",
+			code:            "10950 31081 10245 20011 40410 70353=",
+			format:          kafka.FormatJSON,
+			wantTopics:      []string{"levels", "temperatures"},
+			wantContentType: kafka.ContentTypeJSON,
+		},
+		{
+			name:            "Protobuf",
+			code:            "10950 31081 10245 20011 40410 70353=",
+			format:          kafka.FormatProtobuf,
+			wantTopics:      []string{"levels", "temperatures"},
+			wantContentType: kafka.ContentTypeProtobuf,
+		},
+		{
+			name:            "Without optional groups",
+			code:            "10950 31081 10245 20011=",
+			format:          kafka.FormatJSON,
+			wantTopics:      []string{"levels"},
+			wantContentType: kafka.ContentTypeJSON,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			ctx := context.Background()
+			published := publisher.NewMemoryPublisher()
+			service := newTestService(published)
+
+			config := service.KafkaConfig
+			config.Format = tt.format
+			config.SchemaRegistry.URL = registry.URL
+			serializer, err := kafka.NewSerializer(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			service.SetSerializer(serializer)
+
+			id := addTelegram(t, service, tt.code).Id
+
+			if _, err := service.TransferToSystem(ctx, &pb.TransferToSystemRequest{Id: []string{id}}); err != nil {
+				t.Fatalf("TransferToSystem() error = %v", err)
+			}
+
+			topics := map[string]bool{}
+			for _, m := range published.Messages() {
+				topics[m.Topic] = true
+				if m.Key != "10950" {
+					t.Errorf("message to %s has key %q, want the post code", m.Topic, m.Key)
+				}
+				if m.Headers[kafka.HeaderTelegramId] != id || m.Headers[kafka.HeaderTransferId] == "" || m.Headers[kafka.HeaderMessageId] == "" {
+					t.Errorf("message to %s has headers %v", m.Topic, m.Headers)
+				}
+				if m.Headers[kafka.HeaderContentType] != tt.wantContentType {
+					t.Errorf("message to %s has content type %q, want %q", m.Topic, m.Headers[kafka.HeaderContentType], tt.wantContentType)
+				}
+			}
+			if len(topics) != len(tt.wantTopics) {
+				t.Errorf("published to %v, want %v", topics, tt.wantTopics)
+			}
+			for _, topic := range tt.wantTopics {
+				if !topics[topic] {
+					t.Errorf("published to %v, want %v", topics, tt.wantTopics)
+				}
+			}
+
+			// Transferred telegrams leave the buffer and are not published again.
+			if _, err := service.GetTelegram(ctx, &pb.GetTelegramRequest{Id: id}); err == nil {
+				t.Error("GetTelegram() of a transferred telegram succeeded")
+			}
+			if sent, err := service.relay.Flush(ctx); sent != 0 || err != nil {
+				t.Errorf("Flush() after the transfer = %d, %v", sent, err)
+			}
+		})
+	}
+}
+
+func TestTransferToSystemErrors(t *testing.T) {
+
+	ctx := context.Background()
+	published := publisher.NewMemoryPublisher()
+	service := newTestService(published)
+
+	id := addTelegram(t, service, "10950 31081 10245 20011=").Id
+	if _, err := service.TransferToSystem(ctx, &pb.TransferToSystemRequest{Id: []string{id}}); err != nil {
+		t.Fatalf("TransferToSystem() error = %v", err)
+	}
+	sent := len(published.Messages())
+
+	tests := []struct {
+		name string
+		ids  []string
+	}{
+		{name: "Invalid id", ids: []string{"not-a-uuid"}},
+		{name: "Unknown telegram", ids: []string{uuid.New().String()}},
+		{name: "Already transferred", ids: []string{id}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := service.TransferToSystem(ctx, &pb.TransferToSystemRequest{Id: tt.ids}); err == nil {
+				t.Error("TransferToSystem() succeeded")
+			}
+			if len(published.Messages()) != sent {
+				t.Errorf("published %d messages, want %d", len(published.Messages()), sent)
+			}
+		})
+	}
+}
+
+func TestTransferToSystemRetry(t *testing.T) {
+
+	ctx := context.Background()
+	published := publisher.NewMemoryPublisher()
+	service := newTestService(published)
+	// Failed messages are due again at once.
+	service.SetOutboxConfig(outbox.Config{BatchSize: 10, Lease: time.Minute})
+
+	published.Hook = func(m publisher.Message) error {
+		return errors.New("broker unavailable")
+	}
+
+	id := addTelegram(t, service, "10950 31081 10245 20011 40410 70353=").Id
+
+	// The telegram is transferred although nothing could be published.
+	if _, err := service.TransferToSystem(ctx, &pb.TransferToSystemRequest{Id: []string{id}}); err != nil {
+		t.Fatalf("TransferToSystem() error = %v", err)
+	}
+	if len(published.Messages()) != 0 {
+		t.Fatalf("published %d messages while the broker was unavailable", len(published.Messages()))
+	}
+	if _, err := service.GetTelegram(ctx, &pb.GetTelegramRequest{Id: id}); err == nil {
+		t.Error("GetTelegram() of a transferred telegram succeeded")
+	}
+
+	published.Hook = nil
+	sent, err := service.relay.Flush(ctx)
+	if err != nil || sent == 0 {
+		t.Fatalf("Flush() after the broker is back = %d, %v", sent, err)
+	}
+
+	// Every message is published once, in the order it was stored.
+	messages := published.Messages()
+	if len(messages) != sent || len(messages) != len(service.storage.(*memoryStorage).messages) {
+		t.Fatalf("published %d messages, want every stored one once", len(messages))
+	}
+	for i, m := range messages {
+		if want := service.storage.(*memoryStorage).messages[i].Id.String(); m.Headers[kafka.HeaderMessageId] != want {
+			t.Errorf("message %d is %s, want %s", i, m.Headers[kafka.HeaderMessageId], want)
+		}
+	}
+}
+
+func TestTelegramWithoutOptionalGroups(t *testing.T) {
+
+	ctx := context.Background()
+	service := newTestService(publisher.NewMemoryPublisher())
+
+	// No temperatures (4), ice and snow (7) or precipitation (0).
+	telegram := addTelegram(t, service, "10950 31081 10245 20011=")
+
+	described, err := service.DescribeTelegram(ctx, &pb.DescribeTelegramRequest{Id: telegram.Id})
+	if err != nil {
+		t.Fatalf("DescribeTelegram() error = %v", err)
+	}
+	if len(described.Descriptions) != 1 || described.Descriptions[0] == "" {
+		t.Errorf("DescribeTelegram() = %v, want one description", described.Descriptions)
+	}
+
+	telegram.WaterLevelOnTime = &pb.Int32Measurement{Value: 250}
+	updated, err := service.UpdateTelegramByInfo(ctx, &pb.UpdateTelegramByInfoRequest{Telegram: telegram})
+	if err != nil {
+		t.Fatalf("UpdateTelegramByInfo() error = %v", err)
+	}
+	if updated.Telegram.TelegramCode != "10950 31081 10250 20011" {
+		t.Errorf("UpdateTelegramByInfo() code = %q", updated.Telegram.TelegramCode)
+	}
+}
+
+func TestUpdateTelegramByCodeDate(t *testing.T) {
+
+	sentAt := time.Date(2024, time.March, 31, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		stored func(telegram *model.Telegram)
+		code   string
+		want   time.Time
+	}{
+		{
+			name:   "Bulletin time",
+			stored: func(telegram *model.Telegram) { telegram.BulletinTime = sql.NullTime{Time: sentAt, Valid: true} },
+			code:   "10950 30081 10250 20011",
+			want:   time.Date(2024, time.March, 30, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "Observation date",
+			stored: func(telegram *model.Telegram) { telegram.DateTime = sentAt },
+			code:   "10950 31081 10250 20011",
+			want:   time.Date(2024, time.March, 31, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "Envelope",
+			stored: func(telegram *model.Telegram) { telegram.BulletinTime = sql.NullTime{Time: sentAt, Valid: true} },
+			code:   "SRUS41 UMMC 310900\n10950 29081 10250 20011=",
+			want:   time.Date(2024, time.March, 29, 8, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			storage := &memoryStorage{}
+			service := NewHydrologyBufferService(storage, publisher.NewMemoryPublisher())
+			id := addTelegram(t, service, "10950 31081 10245 20011=").Id
+
+			// The telegram was sent long before the correction arrives.
+			storage.telegrams[0].DateTime = time.Date(2024, time.March, 31, 8, 0, 0, 0, time.UTC)
+			tt.stored(&storage.telegrams[0])
+
+			updated, err := service.UpdateTelegramByCode(context.Background(), &pb.UpdateTelegramByCodeRequest{Id: id, TelegramCode: tt.code})
+			if err != nil {
+				t.Fatalf("UpdateTelegramByCode() error = %v", err)
+			}
+			if got := updated.Telegram.Datetime.AsTime(); !got.Equal(tt.want) {
+				t.Errorf("UpdateTelegramByCode() date = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateTelegramByCodeInvalidDate(t *testing.T) {
+
+	storage := &memoryStorage{}
+	service := NewHydrologyBufferService(storage, publisher.NewMemoryPublisher())
+	id := addTelegram(t, service, "10950 31081 10245 20011=").Id
+	stored := storage.telegrams[0]
+
+	// February has no day 31.
+	_, err := service.UpdateTelegramByCode(context.Background(), &pb.UpdateTelegramByCodeRequest{Id: id, TelegramCode: "10950 31081 10250 20011 96602 63110"})
+	if err == nil {
+		t.Fatal("UpdateTelegramByCode() error = nil, want the measurement date error")
+	}
+	if got := storage.telegrams[0]; got.WaterLevelOnTime != stored.WaterLevelOnTime || got.TelegramCode != stored.TelegramCode {
+		t.Errorf("stored telegram = %+v, want it unchanged", got)
+	}
+}
+
+func TestEnvelopePatterns(t *testing.T) {
+
+	storage := &memoryStorage{}
+	service := NewHydrologyBufferService(storage, publisher.NewMemoryPublisher())
+	service.SetEnvelopePatterns([]*regexp.Regexp{regexp.MustCompile(`^HYDRO (?P<sender>[A-Z]+)$`)})
+
+	addTelegram(t, service, "HYDRO UMMC\n10950 31081 10245 20011=")
+	if sender := storage.telegrams[0].Sender; sender.String != "UMMC" {
+		t.Errorf("Sender = %q, want the sender of the configured heading", sender.String)
+	}
+
+	// The configured patterns replace the default ones.
+	added, err := service.AddTelegram(context.Background(), &pb.AddTelegramRequest{Code: "ZCZC 123\n10950 31081 10245 20011="})
+	if err == nil && len(added.Telegrams) != 0 {
+		t.Error("AddTelegram() stripped a default heading")
+	}
+}
+
+func newTestService(published publisher.Publisher) *HydrologyBufferervice {
+
+	service := NewHydrologyBufferService(&memoryStorage{}, published)
+	service.SetKafkaConfig(kafka.KafkaConfig{
+		Topic:  "levels",
+		Topics: map[string]string{"temperature": "temperatures"},
+	})
+
+	return service
+}
+
+// addTelegram stores the telegram of code.
+func addTelegram(t *testing.T, service *HydrologyBufferervice, code string) *pb.Telegram {
+
+	t.Helper()
+
+	added, err := service.AddTelegram(context.Background(), &pb.AddTelegramRequest{Code: code})
+	if err != nil {
+		t.Fatalf("AddTelegram() error = %v", err)
+	}
+	if len(added.Telegrams) != 1 {
+		t.Fatalf("AddTelegram() stored %d telegrams, want 1: %v", len(added.Telegrams), added.Errors)
+	}
+
+	return added.Telegrams[0]
+}
+
+var errTelegramNotFound = errors.New("telegram not found")
+
+// memoryStorage keeps telegrams and outbox messages like the postgres
+// storage does.
+type memoryStorage struct {
+	mu          sync.Mutex
+	telegrams   []model.Telegram
+	transferred map[uuid.UUID]bool
+	messages    []outbox.Message
+	next        map[uuid.UUID]time.Time
+	sent        map[uuid.UUID]bool
+}
+
+func (s *memoryStorage) AddTelegram(ctx context.Context, data []model.Telegram) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.telegrams = append(s.telegrams, data...)
+	return nil
+}
+
+func (s *memoryStorage) GetTelegramByID(ctx context.Context, id uuid.UUID) (*model.Telegram, error) {
+	telegrams, err := s.GetTelegramsById(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(*telegrams) == 0 {
+		return nil, errTelegramNotFound
+	}
+	return &(*telegrams)[0], nil
+}
+
+func (s *memoryStorage) RemoveTelegrams(ctx context.Context, ids []uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	remove := map[uuid.UUID]bool{}
+	for _, id := range ids {
+		remove[id] = !s.transferred[id]
+	}
+	kept := s.telegrams[:0]
+	for _, telegram := range s.telegrams {
+		if !remove[telegram.Id] {
+			kept = append(kept, telegram)
+		}
+	}
+	s.telegrams = kept
+	return nil
+}
+
+func (s *memoryStorage) GetAll(ctx context.Context) (*[]model.Telegram, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var telegrams []model.Telegram
+	for _, telegram := range s.telegrams {
+		if !s.transferred[telegram.Id] {
+			telegrams = append(telegrams, telegram)
+		}
+	}
+	return &telegrams, nil
+}
+
+func (s *memoryStorage) UpdateTelegram(ctx context.Context, updatedTelegram *model.Telegram) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.telegrams {
+		if s.telegrams[i].Id == updatedTelegram.Id && !s.transferred[updatedTelegram.Id] {
+			s.telegrams[i] = *updatedTelegram
+			return nil
+		}
+	}
+	return errTelegramNotFound
+}
+
+func (s *memoryStorage) GetTelegramsById(ctx context.Context, ids []uuid.UUID) (*[]model.Telegram, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var telegrams []model.Telegram
+	for _, id := range ids {
+		for _, telegram := range s.telegrams {
+			if telegram.Id == id && !s.transferred[id] {
+				telegrams = append(telegrams, telegram)
+			}
+		}
+	}
+	return &telegrams, nil
+}
+
+func (s *memoryStorage) GetPreviousWaterLevel(ctx context.Context, postCode string, from, before time.Time) (*validation.Observation, error) {
+	return nil, nil
+}
+
+func (s *memoryStorage) TransferTelegrams(ctx context.Context, ids []uuid.UUID, messages []outbox.Message, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.transferred == nil {
+		s.transferred = map[uuid.UUID]bool{}
+		s.next = map[uuid.UUID]time.Time{}
+		s.sent = map[uuid.UUID]bool{}
+	}
+	for _, id := range ids {
+		if s.transferred[id] || !slices.ContainsFunc(s.telegrams, func(t model.Telegram) bool { return t.Id == id }) {
+			return fmt.Errorf("telegram %s is missing or already transferred", id)
+		}
+	}
+	for _, id := range ids {
+		s.transferred[id] = true
+	}
+	for _, message := range messages {
+		s.next[message.Id] = at
+	}
+	s.messages = append(s.messages, messages...)
+	return nil
+}
+
+func (s *memoryStorage) Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]outbox.Message, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var claimed []outbox.Message
+	waiting := map[string]bool{}
+	for _, message := range s.messages {
+		if s.sent[message.Id] {
+			continue
+		}
+		if s.next[message.Id].After(now) {
+			waiting[message.Key] = true
+			continue
+		}
+		if !waiting[message.Key] && len(claimed) < limit {
+			claimed = append(claimed, message)
+		}
+	}
+	return claimed, nil
+}
+
+func (s *memoryStorage) MarkSent(ctx context.Context, id uuid.UUID, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent[id] = true
+	return nil
+}
+
+func (s *memoryStorage) MarkFailed(ctx context.Context, id uuid.UUID, attempts int, next time.Time, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.messages {
+		if s.messages[i].Id == id {
+			s.messages[i].Attempts = attempts
+			s.next[id] = next
+		}
+	}
+	return nil
+}
+
+func (s *memoryStorage) Purge(ctx context.Context, before time.Time) error {
+	return nil
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/IAmFutureHokage/HL-BufferService/internal/app/model"
+	pb "github.com/IAmFutureHokage/HL-BufferService/internal/proto"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	decoder_types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/validation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// StationPolicy decides what happens to telegrams of post codes missing
+// from the registry or of inactive stations.
+type StationPolicy string
+
+const (
+	StationPolicyIgnore StationPolicy = "ignore"
+	StationPolicyWarn   StationPolicy = "warn"
+	StationPolicyReject StationPolicy = "reject"
+)
+
+type StationStorage interface {
+	AddStation(ctx context.Context, station *model.Station) error
+	GetStation(ctx context.Context, postCode string) (*model.Station, error)
+	GetStations(ctx context.Context, activeOnly bool) ([]model.Station, error)
+	UpdateStation(ctx context.Context, station *model.Station) error
+	RemoveStation(ctx context.Context, postCode string) error
+}
+
+type StationRegistryService struct {
+	pb.UnimplementedStationRegistryServiceServer
+	storage StationStorage
+}
+
+func NewStationRegistryService(storage StationStorage) *StationRegistryService {
+	return &StationRegistryService{storage: storage}
+}
+
+func (s *StationRegistryService) AddStation(ctx context.Context, req *pb.AddStationRequest) (*pb.StationResponse, error) {
+
+	station := protoToStation(req.Station)
+	if err := station.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.storage.AddStation(ctx, station); err != nil {
+		return nil, err
+	}
+
+	return &pb.StationResponse{Station: stationToProto(station)}, nil
+}
+
+func (s *StationRegistryService) GetStation(ctx context.Context, req *pb.GetStationRequest) (*pb.StationResponse, error) {
+
+	station, err := s.storage.GetStation(ctx, req.PostCode)
+	if err != nil {
+		return nil, stationErrorStatus(err)
+	}
+
+	return &pb.StationResponse{Station: stationToProto(station)}, nil
+}
+
+func (s *StationRegistryService) ListStations(ctx context.Context, req *pb.ListStationsRequest) (*pb.ListStationsResponse, error) {
+
+	stations, err := s.storage.GetStations(ctx, req.ActiveOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListStationsResponse{Stations: make([]*pb.Station, len(stations))}
+	for i := range stations {
+		response.Stations[i] = stationToProto(&stations[i])
+	}
+
+	return response, nil
+}
+
+func (s *StationRegistryService) UpdateStation(ctx context.Context, req *pb.UpdateStationRequest) (*pb.StationResponse, error) {
+
+	station := protoToStation(req.Station)
+	if err := station.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.storage.UpdateStation(ctx, station); err != nil {
+		return nil, stationErrorStatus(err)
+	}
+
+	return &pb.StationResponse{Station: stationToProto(station)}, nil
+}
+
+func (s *StationRegistryService) RemoveStation(ctx context.Context, req *pb.RemoveStationRequest) (*pb.RemoveStationResponse, error) {
+
+	if err := s.storage.RemoveStation(ctx, req.PostCode); err != nil {
+		return nil, stationErrorStatus(err)
+	}
+
+	return &pb.RemoveStationResponse{Success: true}, nil
+}
+
+// stationErrorStatus converts model.ErrStationNotFound into a NotFound
+// status. Other errors are returned unchanged.
+func stationErrorStatus(err error) error {
+
+	if errors.Is(err, model.ErrStationNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return err
+}
+
+func protoToStation(req *pb.Station) *model.Station {
+
+	if req == nil {
+		return &model.Station{}
+	}
+
+	station := &model.Station{
+		PostCode:  req.PostCode,
+		Name:      req.Name,
+		River:     req.River,
+		Basin:     req.Basin,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		GaugeZero: req.GaugeZero,
+		TimeZone:  req.TimeZone,
+		Active:    req.Active,
+	}
+	if station.TimeZone == "" {
+		station.TimeZone = "UTC"
+	}
+
+	for _, section := range req.Sections {
+		station.Sections = append(station.Sections, decoder.Section(section))
+	}
+
+	if th := req.Thresholds; th != nil {
+		station.Thresholds = validation.Thresholds{
+			FavourableLevel: int32ValueFromProto(th.FavourableLevel),
+			AdverseLevel:    int32ValueFromProto(th.AdverseLevel),
+			DangerousLevel:  int32ValueFromProto(th.DangerousLevel),
+			IceJamLevel:     int32ValueFromProto(th.IceJamLevel),
+		}
+		for _, phenomenon := range th.IceJamPhenomena {
+			station.Thresholds.IceJamPhenomena = append(station.Thresholds.IceJamPhenomena, decoder_types.PhenomenonCode(phenomenon))
+		}
+	}
+
+	return station
+}
+
+func stationToProto(station *model.Station) *pb.Station {
+
+	res := &pb.Station{
+		PostCode:  station.PostCode,
+		Name:      station.Name,
+		River:     station.River,
+		Basin:     station.Basin,
+		Latitude:  station.Latitude,
+		Longitude: station.Longitude,
+		GaugeZero: station.GaugeZero,
+		TimeZone:  station.TimeZone,
+		Active:    station.Active,
+	}
+
+	for _, section := range station.Sections {
+		res.Sections = append(res.Sections, string(section))
+	}
+
+	if th := station.Thresholds; !th.IsZero() {
+		res.Thresholds = &pb.StationThresholds{
+			FavourableLevel: int32ValueToProto(th.FavourableLevel),
+			AdverseLevel:    int32ValueToProto(th.AdverseLevel),
+			DangerousLevel:  int32ValueToProto(th.DangerousLevel),
+			IceJamLevel:     int32ValueToProto(th.IceJamLevel),
+		}
+		for _, phenomenon := range th.IceJamPhenomena {
+			res.Thresholds.IceJamPhenomena = append(res.Thresholds.IceJamPhenomena, pb.Phenomenon(phenomenon))
+		}
+	}
+
+	return res
+}
+
+func int32ValueFromProto(v *wrapperspb.Int32Value) *int32 {
+	if v == nil {
+		return nil
+	}
+	value := v.Value
+	return &value
+}
+
+func int32ValueToProto(v *int32) *wrapperspb.Int32Value {
+	if v == nil {
+		return nil
+	}
+	return &wrapperspb.Int32Value{Value: *v}
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/IAmFutureHokage/HL-BufferService/internal/app/model"
+	pb "github.com/IAmFutureHokage/HL-BufferService/internal/proto"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/validation"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/publisher"
+)
+
+func TestAddTelegramStationPolicy(t *testing.T) {
+
+	stations := stationStorage{
+		"10950": {PostCode: "10950", Name: "Река - Пост", Active: true, Sections: []decoder.Section{decoder.SectionMain}},
+		"10951": {PostCode: "10951", Name: "Река - Закрытый", Active: false},
+	}
+
+	const (
+		unknown    = "10952 31081 10245 20011="
+		inactive   = "10951 31081 10245 20011="
+		unexpected = "10950 31081 10245 20011 92230 10240 20022="
+	)
+
+	tests := []struct {
+		name         string
+		policy       StationPolicy
+		code         string
+		wantRejected bool
+		wantWarnings []validation.Code
+	}{
+		{name: "Ignore unknown", policy: StationPolicyIgnore, code: unknown},
+		{name: "Ignore inactive", policy: StationPolicyIgnore, code: inactive},
+		{name: "Ignore unexpected section", policy: StationPolicyIgnore, code: unexpected},
+		{name: "Warn unknown", policy: StationPolicyWarn, code: unknown, wantWarnings: []validation.Code{validation.CodeUnknownStation}},
+		{name: "Warn inactive", policy: StationPolicyWarn, code: inactive, wantWarnings: []validation.Code{validation.CodeInactiveStation}},
+		{name: "Warn unexpected section", policy: StationPolicyWarn, code: unexpected, wantWarnings: []validation.Code{validation.CodeUnexpectedSection}},
+		{name: "Reject unknown", policy: StationPolicyReject, code: unknown, wantRejected: true, wantWarnings: []validation.Code{validation.CodeUnknownStation}},
+		{name: "Reject inactive", policy: StationPolicyReject, code: inactive, wantRejected: true, wantWarnings: []validation.Code{validation.CodeInactiveStation}},
+		// Unexpected sections are only warned about, whatever the policy.
+		{name: "Reject unexpected section", policy: StationPolicyReject, code: unexpected, wantWarnings: []validation.Code{validation.CodeUnexpectedSection}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			storage := &memoryStorage{}
+			service := NewHydrologyBufferService(storage, publisher.NewMemoryPublisher())
+			service.SetStationRegistry(stations, tt.policy)
+
+			added, err := service.AddTelegram(context.Background(), &pb.AddTelegramRequest{Code: tt.code})
+			if err != nil {
+				t.Fatalf("AddTelegram() error = %v", err)
+			}
+
+			if tt.wantRejected {
+				if len(added.Telegrams) != 0 || len(storage.telegrams) != 0 {
+					t.Errorf("AddTelegram() stored %d telegrams, want none", len(storage.telegrams))
+				}
+				if len(added.Errors) != 1 || added.Errors[0].Code != string(tt.wantWarnings[0]) {
+					t.Errorf("AddTelegram() errors = %v, want %s", added.Errors, tt.wantWarnings[0])
+				}
+				return
+			}
+
+			if len(added.Errors) != 0 || len(added.Telegrams) == 0 || len(storage.telegrams) != len(added.Telegrams) {
+				t.Fatalf("AddTelegram() = %d telegrams, errors %v, want them stored", len(added.Telegrams), added.Errors)
+			}
+
+			var codes []validation.Code
+			for _, warning := range added.Telegrams[0].Warnings {
+				switch code := validation.Code(warning.Code); code {
+				case validation.CodeUnknownStation, validation.CodeInactiveStation, validation.CodeUnexpectedSection:
+					codes = append(codes, code)
+				}
+			}
+			if !reflect.DeepEqual(codes, tt.wantWarnings) {
+				t.Errorf("station warnings = %v, want %v", codes, tt.wantWarnings)
+			}
+
+			if station := stations[added.Telegrams[0].PostCode]; station != nil {
+				if storage.telegrams[0].StationName != station.Name {
+					t.Errorf("StationName = %q, want %q", storage.telegrams[0].StationName, station.Name)
+				}
+			}
+		})
+	}
+}
+
+// stationStorage is a registry backed by a map from post code.
+type stationStorage map[string]*model.Station
+
+func (s stationStorage) AddStation(ctx context.Context, station *model.Station) error {
+	s[station.PostCode] = station
+	return nil
+}
+
+func (s stationStorage) GetStation(ctx context.Context, postCode string) (*model.Station, error) {
+	station, ok := s[postCode]
+	if !ok {
+		return nil, model.ErrStationNotFound
+	}
+	copied := *station
+	return &copied, nil
+}
+
+func (s stationStorage) GetStations(ctx context.Context, activeOnly bool) ([]model.Station, error) {
+	var stations []model.Station
+	for _, station := range s {
+		if station.Active || !activeOnly {
+			stations = append(stations, *station)
+		}
+	}
+	return stations, nil
+}
+
+func (s stationStorage) UpdateStation(ctx context.Context, station *model.Station) error {
+	if _, ok := s[station.PostCode]; !ok {
+		return model.ErrStationNotFound
+	}
+	s[station.PostCode] = station
+	return nil
+}
+
+func (s stationStorage) RemoveStation(ctx context.Context, postCode string) error {
+	if _, ok := s[postCode]; !ok {
+		return model.ErrStationNotFound
+	}
+	delete(s, postCode)
+	return nil
+}
@@ -22,6 +22,304 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Severity int32
+
+const (
+	Severity_SEVERITY_UNKNOWN    Severity = 0
+	Severity_SEVERITY_NONE       Severity = 1
+	Severity_SEVERITY_FAVOURABLE Severity = 2
+	Severity_SEVERITY_ADVERSE    Severity = 3
+	Severity_SEVERITY_DANGEROUS  Severity = 4
+)
+
+// Enum value maps for Severity.
+var (
+	Severity_name = map[int32]string{
+		0: "SEVERITY_UNKNOWN",
+		1: "SEVERITY_NONE",
+		2: "SEVERITY_FAVOURABLE",
+		3: "SEVERITY_ADVERSE",
+		4: "SEVERITY_DANGEROUS",
+	}
+	Severity_value = map[string]int32{
+		"SEVERITY_UNKNOWN":    0,
+		"SEVERITY_NONE":       1,
+		"SEVERITY_FAVOURABLE": 2,
+		"SEVERITY_ADVERSE":    3,
+		"SEVERITY_DANGEROUS":  4,
+	}
+)
+
+func (x Severity) Enum() *Severity {
+	p := new(Severity)
+	*p = x
+	return p
+}
+
+func (x Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_hydrology_buffer_service_proto_enumTypes[0].Descriptor()
+}
+
+func (Severity) Type() protoreflect.EnumType {
+	return &file_internal_proto_hydrology_buffer_service_proto_enumTypes[0]
+}
+
+func (x Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Severity.Descriptor instead.
+func (Severity) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{0}
+}
+
+// KN-15 codes of the 5EEii group. Values are the codes themselves.
+type Phenomenon int32
+
+const (
+	Phenomenon_PHENOMENON_UNSPECIFIED                Phenomenon = 0
+	Phenomenon_PHENOMENON_GREASE_ICE                 Phenomenon = 11
+	Phenomenon_PHENOMENON_SLUSH                      Phenomenon = 12
+	Phenomenon_PHENOMENON_INITIAL_BORDER_ICE         Phenomenon = 13
+	Phenomenon_PHENOMENON_DRIFTED_BORDER_ICE         Phenomenon = 14
+	Phenomenon_PHENOMENON_BORDER_ICE                 Phenomenon = 15
+	Phenomenon_PHENOMENON_FRAZIL_RUN                 Phenomenon = 16
+	Phenomenon_PHENOMENON_FRAZIL_RUN_OVER_ICE_COVER  Phenomenon = 17
+	Phenomenon_PHENOMENON_ICE_RUN                    Phenomenon = 18
+	Phenomenon_PHENOMENON_ICE_RUN_OVER_ICE_COVER     Phenomenon = 19
+	Phenomenon_PHENOMENON_ANCHOR_ICE                 Phenomenon = 20
+	Phenomenon_PHENOMENON_ANCHOR_ICE_ACCUMULATIONS   Phenomenon = 21
+	Phenomenon_PHENOMENON_STRANDED_ICE               Phenomenon = 22
+	Phenomenon_PHENOMENON_ICE_COVER_WITH_POLYNYAS    Phenomenon = 23
+	Phenomenon_PHENOMENON_PARTIAL_FREEZE_UP          Phenomenon = 24
+	Phenomenon_PHENOMENON_ICE_HUMMOCKS               Phenomenon = 25
+	Phenomenon_PHENOMENON_ICE_BRIDGE                 Phenomenon = 26
+	Phenomenon_PHENOMENON_FREEZE_UP                  Phenomenon = 27
+	Phenomenon_PHENOMENON_WATER_ON_ICE               Phenomenon = 28
+	Phenomenon_PHENOMENON_DARKENED_ICE               Phenomenon = 29
+	Phenomenon_PHENOMENON_SHORE_LEADS                Phenomenon = 30
+	Phenomenon_PHENOMENON_ICE_LIFTED                 Phenomenon = 31
+	Phenomenon_PHENOMENON_ICE_SHIFT                  Phenomenon = 32
+	Phenomenon_PHENOMENON_OPEN_LEADS                 Phenomenon = 33
+	Phenomenon_PHENOMENON_ICE_MELTING_IN_PLACE       Phenomenon = 34
+	Phenomenon_PHENOMENON_RESIDUAL_BORDER_ICE        Phenomenon = 35
+	Phenomenon_PHENOMENON_ICE_PILED_ON_BANKS         Phenomenon = 36
+	Phenomenon_PHENOMENON_ICE_JAM_UPSTREAM           Phenomenon = 37
+	Phenomenon_PHENOMENON_ICE_JAM_DOWNSTREAM         Phenomenon = 38
+	Phenomenon_PHENOMENON_FRAZIL_JAM_UPSTREAM        Phenomenon = 39
+	Phenomenon_PHENOMENON_FRAZIL_JAM_DOWNSTREAM      Phenomenon = 40
+	Phenomenon_PHENOMENON_ICE_JAM_BREAK_UP           Phenomenon = 41
+	Phenomenon_PHENOMENON_AUFEIS                     Phenomenon = 42
+	Phenomenon_PHENOMENON_AUFEIS_WATER               Phenomenon = 43
+	Phenomenon_PHENOMENON_FROZEN_TO_BOTTOM           Phenomenon = 44
+	Phenomenon_PHENOMENON_DRIED_UP                   Phenomenon = 45
+	Phenomenon_PHENOMENON_WIND_SETUP                 Phenomenon = 46
+	Phenomenon_PHENOMENON_WIND_SETDOWN               Phenomenon = 47
+	Phenomenon_PHENOMENON_WATER_RELEASE              Phenomenon = 48
+	Phenomenon_PHENOMENON_AQUATIC_VEGETATION_AT_BANK Phenomenon = 49
+	Phenomenon_PHENOMENON_OVERGROWN_CHANNEL          Phenomenon = 50
+	Phenomenon_PHENOMENON_DRIFTWOOD_RUN              Phenomenon = 51
+	Phenomenon_PHENOMENON_LOG_JAM                    Phenomenon = 52
+	Phenomenon_PHENOMENON_TIMBER_RAFTING             Phenomenon = 53
+	Phenomenon_PHENOMENON_BANK_COLLAPSE              Phenomenon = 54
+	Phenomenon_PHENOMENON_CHANNEL_DEFORMATION        Phenomenon = 55
+)
+
+// Enum value maps for Phenomenon.
+var (
+	Phenomenon_name = map[int32]string{
+		0:  "PHENOMENON_UNSPECIFIED",
+		11: "PHENOMENON_GREASE_ICE",
+		12: "PHENOMENON_SLUSH",
+		13: "PHENOMENON_INITIAL_BORDER_ICE",
+		14: "PHENOMENON_DRIFTED_BORDER_ICE",
+		15: "PHENOMENON_BORDER_ICE",
+		16: "PHENOMENON_FRAZIL_RUN",
+		17: "PHENOMENON_FRAZIL_RUN_OVER_ICE_COVER",
+		18: "PHENOMENON_ICE_RUN",
+		19: "PHENOMENON_ICE_RUN_OVER_ICE_COVER",
+		20: "PHENOMENON_ANCHOR_ICE",
+		21: "PHENOMENON_ANCHOR_ICE_ACCUMULATIONS",
+		22: "PHENOMENON_STRANDED_ICE",
+		23: "PHENOMENON_ICE_COVER_WITH_POLYNYAS",
+		24: "PHENOMENON_PARTIAL_FREEZE_UP",
+		25: "PHENOMENON_ICE_HUMMOCKS",
+		26: "PHENOMENON_ICE_BRIDGE",
+		27: "PHENOMENON_FREEZE_UP",
+		28: "PHENOMENON_WATER_ON_ICE",
+		29: "PHENOMENON_DARKENED_ICE",
+		30: "PHENOMENON_SHORE_LEADS",
+		31: "PHENOMENON_ICE_LIFTED",
+		32: "PHENOMENON_ICE_SHIFT",
+		33: "PHENOMENON_OPEN_LEADS",
+		34: "PHENOMENON_ICE_MELTING_IN_PLACE",
+		35: "PHENOMENON_RESIDUAL_BORDER_ICE",
+		36: "PHENOMENON_ICE_PILED_ON_BANKS",
+		37: "PHENOMENON_ICE_JAM_UPSTREAM",
+		38: "PHENOMENON_ICE_JAM_DOWNSTREAM",
+		39: "PHENOMENON_FRAZIL_JAM_UPSTREAM",
+		40: "PHENOMENON_FRAZIL_JAM_DOWNSTREAM",
+		41: "PHENOMENON_ICE_JAM_BREAK_UP",
+		42: "PHENOMENON_AUFEIS",
+		43: "PHENOMENON_AUFEIS_WATER",
+		44: "PHENOMENON_FROZEN_TO_BOTTOM",
+		45: "PHENOMENON_DRIED_UP",
+		46: "PHENOMENON_WIND_SETUP",
+		47: "PHENOMENON_WIND_SETDOWN",
+		48: "PHENOMENON_WATER_RELEASE",
+		49: "PHENOMENON_AQUATIC_VEGETATION_AT_BANK",
+		50: "PHENOMENON_OVERGROWN_CHANNEL",
+		51: "PHENOMENON_DRIFTWOOD_RUN",
+		52: "PHENOMENON_LOG_JAM",
+		53: "PHENOMENON_TIMBER_RAFTING",
+		54: "PHENOMENON_BANK_COLLAPSE",
+		55: "PHENOMENON_CHANNEL_DEFORMATION",
+	}
+	Phenomenon_value = map[string]int32{
+		"PHENOMENON_UNSPECIFIED":                0,
+		"PHENOMENON_GREASE_ICE":                 11,
+		"PHENOMENON_SLUSH":                      12,
+		"PHENOMENON_INITIAL_BORDER_ICE":         13,
+		"PHENOMENON_DRIFTED_BORDER_ICE":         14,
+		"PHENOMENON_BORDER_ICE":                 15,
+		"PHENOMENON_FRAZIL_RUN":                 16,
+		"PHENOMENON_FRAZIL_RUN_OVER_ICE_COVER":  17,
+		"PHENOMENON_ICE_RUN":                    18,
+		"PHENOMENON_ICE_RUN_OVER_ICE_COVER":     19,
+		"PHENOMENON_ANCHOR_ICE":                 20,
+		"PHENOMENON_ANCHOR_ICE_ACCUMULATIONS":   21,
+		"PHENOMENON_STRANDED_ICE":               22,
+		"PHENOMENON_ICE_COVER_WITH_POLYNYAS":    23,
+		"PHENOMENON_PARTIAL_FREEZE_UP":          24,
+		"PHENOMENON_ICE_HUMMOCKS":               25,
+		"PHENOMENON_ICE_BRIDGE":                 26,
+		"PHENOMENON_FREEZE_UP":                  27,
+		"PHENOMENON_WATER_ON_ICE":               28,
+		"PHENOMENON_DARKENED_ICE":               29,
+		"PHENOMENON_SHORE_LEADS":                30,
+		"PHENOMENON_ICE_LIFTED":                 31,
+		"PHENOMENON_ICE_SHIFT":                  32,
+		"PHENOMENON_OPEN_LEADS":                 33,
+		"PHENOMENON_ICE_MELTING_IN_PLACE":       34,
+		"PHENOMENON_RESIDUAL_BORDER_ICE":        35,
+		"PHENOMENON_ICE_PILED_ON_BANKS":         36,
+		"PHENOMENON_ICE_JAM_UPSTREAM":           37,
+		"PHENOMENON_ICE_JAM_DOWNSTREAM":         38,
+		"PHENOMENON_FRAZIL_JAM_UPSTREAM":        39,
+		"PHENOMENON_FRAZIL_JAM_DOWNSTREAM":      40,
+		"PHENOMENON_ICE_JAM_BREAK_UP":           41,
+		"PHENOMENON_AUFEIS":                     42,
+		"PHENOMENON_AUFEIS_WATER":               43,
+		"PHENOMENON_FROZEN_TO_BOTTOM":           44,
+		"PHENOMENON_DRIED_UP":                   45,
+		"PHENOMENON_WIND_SETUP":                 46,
+		"PHENOMENON_WIND_SETDOWN":               47,
+		"PHENOMENON_WATER_RELEASE":              48,
+		"PHENOMENON_AQUATIC_VEGETATION_AT_BANK": 49,
+		"PHENOMENON_OVERGROWN_CHANNEL":          50,
+		"PHENOMENON_DRIFTWOOD_RUN":              51,
+		"PHENOMENON_LOG_JAM":                    52,
+		"PHENOMENON_TIMBER_RAFTING":             53,
+		"PHENOMENON_BANK_COLLAPSE":              54,
+		"PHENOMENON_CHANNEL_DEFORMATION":        55,
+	}
+)
+
+func (x Phenomenon) Enum() *Phenomenon {
+	p := new(Phenomenon)
+	*p = x
+	return p
+}
+
+func (x Phenomenon) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Phenomenon) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_hydrology_buffer_service_proto_enumTypes[1].Descriptor()
+}
+
+func (Phenomenon) Type() protoreflect.EnumType {
+	return &file_internal_proto_hydrology_buffer_service_proto_enumTypes[1]
+}
+
+func (x Phenomenon) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Phenomenon.Descriptor instead.
+func (Phenomenon) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{1}
+}
+
+type PhenomenonCategory int32
+
+const (
+	PhenomenonCategory_PHENOMENON_CATEGORY_UNSPECIFIED PhenomenonCategory = 0
+	PhenomenonCategory_PHENOMENON_CATEGORY_ICE         PhenomenonCategory = 1
+	PhenomenonCategory_PHENOMENON_CATEGORY_JAM         PhenomenonCategory = 2
+	PhenomenonCategory_PHENOMENON_CATEGORY_ICING       PhenomenonCategory = 3
+	PhenomenonCategory_PHENOMENON_CATEGORY_WATER       PhenomenonCategory = 4
+	PhenomenonCategory_PHENOMENON_CATEGORY_VEGETATION  PhenomenonCategory = 5
+	PhenomenonCategory_PHENOMENON_CATEGORY_DEBRIS      PhenomenonCategory = 6
+	PhenomenonCategory_PHENOMENON_CATEGORY_CHANNEL     PhenomenonCategory = 7
+)
+
+// Enum value maps for PhenomenonCategory.
+var (
+	PhenomenonCategory_name = map[int32]string{
+		0: "PHENOMENON_CATEGORY_UNSPECIFIED",
+		1: "PHENOMENON_CATEGORY_ICE",
+		2: "PHENOMENON_CATEGORY_JAM",
+		3: "PHENOMENON_CATEGORY_ICING",
+		4: "PHENOMENON_CATEGORY_WATER",
+		5: "PHENOMENON_CATEGORY_VEGETATION",
+		6: "PHENOMENON_CATEGORY_DEBRIS",
+		7: "PHENOMENON_CATEGORY_CHANNEL",
+	}
+	PhenomenonCategory_value = map[string]int32{
+		"PHENOMENON_CATEGORY_UNSPECIFIED": 0,
+		"PHENOMENON_CATEGORY_ICE":         1,
+		"PHENOMENON_CATEGORY_JAM":         2,
+		"PHENOMENON_CATEGORY_ICING":       3,
+		"PHENOMENON_CATEGORY_WATER":       4,
+		"PHENOMENON_CATEGORY_VEGETATION":  5,
+		"PHENOMENON_CATEGORY_DEBRIS":      6,
+		"PHENOMENON_CATEGORY_CHANNEL":     7,
+	}
+)
+
+func (x PhenomenonCategory) Enum() *PhenomenonCategory {
+	p := new(PhenomenonCategory)
+	*p = x
+	return p
+}
+
+func (x PhenomenonCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PhenomenonCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_hydrology_buffer_service_proto_enumTypes[2].Descriptor()
+}
+
+func (PhenomenonCategory) Type() protoreflect.EnumType {
+	return &file_internal_proto_hydrology_buffer_service_proto_enumTypes[2]
+}
+
+func (x PhenomenonCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PhenomenonCategory.Descriptor instead.
+func (PhenomenonCategory) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{2}
+}
+
 type IcePhenomeniaState int32
 
 const (
@@ -55,11 +353,11 @@ func (x IcePhenomeniaState) String() string {
 }
 
 func (IcePhenomeniaState) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_hydrology_buffer_service_proto_enumTypes[0].Descriptor()
+	return file_internal_proto_hydrology_buffer_service_proto_enumTypes[3].Descriptor()
 }
 
 func (IcePhenomeniaState) Type() protoreflect.EnumType {
-	return &file_internal_proto_hydrology_buffer_service_proto_enumTypes[0]
+	return &file_internal_proto_hydrology_buffer_service_proto_enumTypes[3]
 }
 
 func (x IcePhenomeniaState) Number() protoreflect.EnumNumber {
@@ -68,7 +366,7 @@ func (x IcePhenomeniaState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IcePhenomeniaState.Descriptor instead.
 func (IcePhenomeniaState) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{0}
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{3}
 }
 
 type SnowHeight int32
@@ -125,11 +423,11 @@ func (x SnowHeight) String() string {
 }
 
 func (SnowHeight) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_hydrology_buffer_service_proto_enumTypes[1].Descriptor()
+	return file_internal_proto_hydrology_buffer_service_proto_enumTypes[4].Descriptor()
 }
 
 func (SnowHeight) Type() protoreflect.EnumType {
-	return &file_internal_proto_hydrology_buffer_service_proto_enumTypes[1]
+	return &file_internal_proto_hydrology_buffer_service_proto_enumTypes[4]
 }
 
 func (x SnowHeight) Number() protoreflect.EnumNumber {
@@ -138,7 +436,7 @@ func (x SnowHeight) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SnowHeight.Descriptor instead.
 func (SnowHeight) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{1}
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{4}
 }
 
 type PrecipitationDuration int32
@@ -183,11 +481,11 @@ func (x PrecipitationDuration) String() string {
 }
 
 func (PrecipitationDuration) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_hydrology_buffer_service_proto_enumTypes[2].Descriptor()
+	return file_internal_proto_hydrology_buffer_service_proto_enumTypes[5].Descriptor()
 }
 
 func (PrecipitationDuration) Type() protoreflect.EnumType {
-	return &file_internal_proto_hydrology_buffer_service_proto_enumTypes[2]
+	return &file_internal_proto_hydrology_buffer_service_proto_enumTypes[5]
 }
 
 func (x PrecipitationDuration) Number() protoreflect.EnumNumber {
@@ -196,7 +494,57 @@ func (x PrecipitationDuration) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PrecipitationDuration.Descriptor instead.
 func (PrecipitationDuration) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{2}
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{5}
+}
+
+// Language of a telegram description; unspecified means Russian.
+type Language int32
+
+const (
+	Language_LANGUAGE_UNSPECIFIED Language = 0
+	Language_LANGUAGE_RU          Language = 1
+	Language_LANGUAGE_EN          Language = 2
+)
+
+// Enum value maps for Language.
+var (
+	Language_name = map[int32]string{
+		0: "LANGUAGE_UNSPECIFIED",
+		1: "LANGUAGE_RU",
+		2: "LANGUAGE_EN",
+	}
+	Language_value = map[string]int32{
+		"LANGUAGE_UNSPECIFIED": 0,
+		"LANGUAGE_RU":          1,
+		"LANGUAGE_EN":          2,
+	}
+)
+
+func (x Language) Enum() *Language {
+	p := new(Language)
+	*p = x
+	return p
+}
+
+func (x Language) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Language) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_hydrology_buffer_service_proto_enumTypes[6].Descriptor()
+}
+
+func (Language) Type() protoreflect.EnumType {
+	return &file_internal_proto_hydrology_buffer_service_proto_enumTypes[6]
+}
+
+func (x Language) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Language.Descriptor instead.
+func (Language) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{6}
 }
 
 type PingRequest struct {
@@ -295,26 +643,41 @@ type Telegram struct {
 	PostCode                 string                  `protobuf:"bytes,4,opt,name=post_code,json=postCode,proto3" json:"post_code,omitempty"`
 	Datetime                 *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=datetime,proto3" json:"datetime,omitempty"`
 	IsDangerous              bool                    `protobuf:"varint,6,opt,name=is_dangerous,json=isDangerous,proto3" json:"is_dangerous,omitempty"`
-	WaterLevelOnTime         *wrapperspb.Int32Value  `protobuf:"bytes,7,opt,name=water_level_on_time,json=waterLevelOnTime,proto3" json:"water_level_on_time,omitempty"`
-	DeltaWaterLevel          *wrapperspb.Int32Value  `protobuf:"bytes,8,opt,name=delta_water_level,json=deltaWaterLevel,proto3" json:"delta_water_level,omitempty"`
-	WaterLevelOn20H          *wrapperspb.Int32Value  `protobuf:"bytes,9,opt,name=water_level_on20h,json=waterLevelOn20h,proto3" json:"water_level_on20h,omitempty"`
-	WaterTemperature         *wrapperspb.DoubleValue `protobuf:"bytes,10,opt,name=water_temperature,json=waterTemperature,proto3" json:"water_temperature,omitempty"`
-	AirTemperature           *wrapperspb.Int32Value  `protobuf:"bytes,11,opt,name=air_temperature,json=airTemperature,proto3" json:"air_temperature,omitempty"`
+	WaterLevelOnTime         *Int32Measurement       `protobuf:"bytes,7,opt,name=water_level_on_time,json=waterLevelOnTime,proto3" json:"water_level_on_time,omitempty"`
+	DeltaWaterLevel          *Int32Measurement       `protobuf:"bytes,8,opt,name=delta_water_level,json=deltaWaterLevel,proto3" json:"delta_water_level,omitempty"`
+	WaterLevelOn20H          *Int32Measurement       `protobuf:"bytes,9,opt,name=water_level_on20h,json=waterLevelOn20h,proto3" json:"water_level_on20h,omitempty"`
+	WaterTemperature         *DoubleMeasurement      `protobuf:"bytes,10,opt,name=water_temperature,json=waterTemperature,proto3" json:"water_temperature,omitempty"`
+	AirTemperature           *Int32Measurement       `protobuf:"bytes,11,opt,name=air_temperature,json=airTemperature,proto3" json:"air_temperature,omitempty"`
 	IcePhenomeniaState       *wrapperspb.Int32Value  `protobuf:"bytes,12,opt,name=ice_phenomenia_state,json=icePhenomeniaState,proto3" json:"ice_phenomenia_state,omitempty"`
 	IcePhenomenias           []*IcePhenomenia        `protobuf:"bytes,13,rep,name=ice_phenomenias,json=icePhenomenias,proto3" json:"ice_phenomenias,omitempty"`
-	IceHeight                *wrapperspb.Int32Value  `protobuf:"bytes,14,opt,name=ice_height,json=iceHeight,proto3" json:"ice_height,omitempty"`
-	SnowHeight               *wrapperspb.Int32Value  `protobuf:"bytes,15,opt,name=snow_height,json=snowHeight,proto3" json:"snow_height,omitempty"`
-	WaterFlow                *wrapperspb.DoubleValue `protobuf:"bytes,16,opt,name=water_flow,json=waterFlow,proto3" json:"water_flow,omitempty"`
-	PrecipitationValue       *wrapperspb.DoubleValue `protobuf:"bytes,17,opt,name=precipitation_value,json=precipitationValue,proto3" json:"precipitation_value,omitempty"`
-	PrecipitationDuration    *wrapperspb.Int32Value  `protobuf:"bytes,18,opt,name=precipitation_duration,json=precipitationDuration,proto3" json:"precipitation_duration,omitempty"`
+	IceHeight                *Int32Measurement       `protobuf:"bytes,14,opt,name=ice_height,json=iceHeight,proto3" json:"ice_height,omitempty"`
+	SnowHeight               *Int32Measurement       `protobuf:"bytes,15,opt,name=snow_height,json=snowHeight,proto3" json:"snow_height,omitempty"`
+	WaterFlow                *DoubleMeasurement      `protobuf:"bytes,16,opt,name=water_flow,json=waterFlow,proto3" json:"water_flow,omitempty"`
+	PrecipitationValue       *DoubleMeasurement      `protobuf:"bytes,17,opt,name=precipitation_value,json=precipitationValue,proto3" json:"precipitation_value,omitempty"`
+	PrecipitationDuration    *Int32Measurement       `protobuf:"bytes,18,opt,name=precipitation_duration,json=precipitationDuration,proto3" json:"precipitation_duration,omitempty"`
 	ReservoirDate            *timestamppb.Timestamp  `protobuf:"bytes,19,opt,name=reservoir_date,json=reservoirDate,proto3" json:"reservoir_date,omitempty"`
-	HeadwaterLevel           *wrapperspb.Int32Value  `protobuf:"bytes,20,opt,name=headwater_level,json=headwaterLevel,proto3" json:"headwater_level,omitempty"`
-	AverageReservoirLevel    *wrapperspb.Int32Value  `protobuf:"bytes,21,opt,name=average_reservoir_level,json=averageReservoirLevel,proto3" json:"average_reservoir_level,omitempty"`
-	DownstreamLevel          *wrapperspb.Int32Value  `protobuf:"bytes,22,opt,name=downstream_level,json=downstreamLevel,proto3" json:"downstream_level,omitempty"`
-	ReservoirVolume          *wrapperspb.DoubleValue `protobuf:"bytes,23,opt,name=reservoir_volume,json=reservoirVolume,proto3" json:"reservoir_volume,omitempty"`
+	HeadwaterLevel           *Int32Measurement       `protobuf:"bytes,20,opt,name=headwater_level,json=headwaterLevel,proto3" json:"headwater_level,omitempty"`
+	AverageReservoirLevel    *Int32Measurement       `protobuf:"bytes,21,opt,name=average_reservoir_level,json=averageReservoirLevel,proto3" json:"average_reservoir_level,omitempty"`
+	DownstreamLevel          *Int32Measurement       `protobuf:"bytes,22,opt,name=downstream_level,json=downstreamLevel,proto3" json:"downstream_level,omitempty"`
+	ReservoirVolume          *DoubleMeasurement      `protobuf:"bytes,23,opt,name=reservoir_volume,json=reservoirVolume,proto3" json:"reservoir_volume,omitempty"`
 	ReservoirWaterInflowDate *timestamppb.Timestamp  `protobuf:"bytes,24,opt,name=reservoir_water_inflow_date,json=reservoirWaterInflowDate,proto3" json:"reservoir_water_inflow_date,omitempty"`
-	Inflow                   *wrapperspb.DoubleValue `protobuf:"bytes,25,opt,name=inflow,proto3" json:"inflow,omitempty"`
-	Reset_                   *wrapperspb.DoubleValue `protobuf:"bytes,26,opt,name=reset,proto3" json:"reset,omitempty"`
+	Inflow                   *DoubleMeasurement      `protobuf:"bytes,25,opt,name=inflow,proto3" json:"inflow,omitempty"`
+	Reset_                   *DoubleMeasurement      `protobuf:"bytes,26,opt,name=reset,proto3" json:"reset,omitempty"`
+	MeasuredDischargeMonth   *wrapperspb.Int32Value  `protobuf:"bytes,27,opt,name=measured_discharge_month,json=measuredDischargeMonth,proto3" json:"measured_discharge_month,omitempty"`
+	MeasuredWaterLevel       *Int32Measurement       `protobuf:"bytes,28,opt,name=measured_water_level,json=measuredWaterLevel,proto3" json:"measured_water_level,omitempty"`
+	MeasuredDischarge        *DoubleMeasurement      `protobuf:"bytes,29,opt,name=measured_discharge,json=measuredDischarge,proto3" json:"measured_discharge,omitempty"`
+	CrossSectionArea         *DoubleMeasurement      `protobuf:"bytes,30,opt,name=cross_section_area,json=crossSectionArea,proto3" json:"cross_section_area,omitempty"`
+	AverageVelocity          *Int32Measurement       `protobuf:"bytes,31,opt,name=average_velocity,json=averageVelocity,proto3" json:"average_velocity,omitempty"`
+	MaxDepth                 *Int32Measurement       `protobuf:"bytes,32,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	MeasurementTime          *timestamppb.Timestamp  `protobuf:"bytes,33,opt,name=measurement_time,json=measurementTime,proto3" json:"measurement_time,omitempty"`
+	Sender                   *wrapperspb.StringValue `protobuf:"bytes,34,opt,name=sender,proto3" json:"sender,omitempty"`
+	BulletinTime             *timestamppb.Timestamp  `protobuf:"bytes,35,opt,name=bulletin_time,json=bulletinTime,proto3" json:"bulletin_time,omitempty"`
+	Warnings                 []*ValidationWarning    `protobuf:"bytes,36,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	StationName              string                  `protobuf:"bytes,37,opt,name=station_name,json=stationName,proto3" json:"station_name,omitempty"`
+	// severity is derived from the station thresholds; danger_mismatch is
+	// set when it disagrees with is_dangerous reported by the sender.
+	Severity       Severity `protobuf:"varint,38,opt,name=severity,proto3,enum=hydrologybuffer.Severity" json:"severity,omitempty"`
+	DangerMismatch bool     `protobuf:"varint,39,opt,name=danger_mismatch,json=dangerMismatch,proto3" json:"danger_mismatch,omitempty"`
 }
 
 func (x *Telegram) Reset() {
@@ -391,35 +754,35 @@ func (x *Telegram) GetIsDangerous() bool {
 	return false
 }
 
-func (x *Telegram) GetWaterLevelOnTime() *wrapperspb.Int32Value {
+func (x *Telegram) GetWaterLevelOnTime() *Int32Measurement {
 	if x != nil {
 		return x.WaterLevelOnTime
 	}
 	return nil
 }
 
-func (x *Telegram) GetDeltaWaterLevel() *wrapperspb.Int32Value {
+func (x *Telegram) GetDeltaWaterLevel() *Int32Measurement {
 	if x != nil {
 		return x.DeltaWaterLevel
 	}
 	return nil
 }
 
-func (x *Telegram) GetWaterLevelOn20H() *wrapperspb.Int32Value {
+func (x *Telegram) GetWaterLevelOn20H() *Int32Measurement {
 	if x != nil {
 		return x.WaterLevelOn20H
 	}
 	return nil
 }
 
-func (x *Telegram) GetWaterTemperature() *wrapperspb.DoubleValue {
+func (x *Telegram) GetWaterTemperature() *DoubleMeasurement {
 	if x != nil {
 		return x.WaterTemperature
 	}
 	return nil
 }
 
-func (x *Telegram) GetAirTemperature() *wrapperspb.Int32Value {
+func (x *Telegram) GetAirTemperature() *Int32Measurement {
 	if x != nil {
 		return x.AirTemperature
 	}
@@ -440,35 +803,35 @@ func (x *Telegram) GetIcePhenomenias() []*IcePhenomenia {
 	return nil
 }
 
-func (x *Telegram) GetIceHeight() *wrapperspb.Int32Value {
+func (x *Telegram) GetIceHeight() *Int32Measurement {
 	if x != nil {
 		return x.IceHeight
 	}
 	return nil
 }
 
-func (x *Telegram) GetSnowHeight() *wrapperspb.Int32Value {
+func (x *Telegram) GetSnowHeight() *Int32Measurement {
 	if x != nil {
 		return x.SnowHeight
 	}
 	return nil
 }
 
-func (x *Telegram) GetWaterFlow() *wrapperspb.DoubleValue {
+func (x *Telegram) GetWaterFlow() *DoubleMeasurement {
 	if x != nil {
 		return x.WaterFlow
 	}
 	return nil
 }
 
-func (x *Telegram) GetPrecipitationValue() *wrapperspb.DoubleValue {
+func (x *Telegram) GetPrecipitationValue() *DoubleMeasurement {
 	if x != nil {
 		return x.PrecipitationValue
 	}
 	return nil
 }
 
-func (x *Telegram) GetPrecipitationDuration() *wrapperspb.Int32Value {
+func (x *Telegram) GetPrecipitationDuration() *Int32Measurement {
 	if x != nil {
 		return x.PrecipitationDuration
 	}
@@ -482,28 +845,28 @@ func (x *Telegram) GetReservoirDate() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *Telegram) GetHeadwaterLevel() *wrapperspb.Int32Value {
+func (x *Telegram) GetHeadwaterLevel() *Int32Measurement {
 	if x != nil {
 		return x.HeadwaterLevel
 	}
 	return nil
 }
 
-func (x *Telegram) GetAverageReservoirLevel() *wrapperspb.Int32Value {
+func (x *Telegram) GetAverageReservoirLevel() *Int32Measurement {
 	if x != nil {
 		return x.AverageReservoirLevel
 	}
 	return nil
 }
 
-func (x *Telegram) GetDownstreamLevel() *wrapperspb.Int32Value {
+func (x *Telegram) GetDownstreamLevel() *Int32Measurement {
 	if x != nil {
 		return x.DownstreamLevel
 	}
 	return nil
 }
 
-func (x *Telegram) GetReservoirVolume() *wrapperspb.DoubleValue {
+func (x *Telegram) GetReservoirVolume() *DoubleMeasurement {
 	if x != nil {
 		return x.ReservoirVolume
 	}
@@ -517,31 +880,125 @@ func (x *Telegram) GetReservoirWaterInflowDate() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *Telegram) GetInflow() *wrapperspb.DoubleValue {
+func (x *Telegram) GetInflow() *DoubleMeasurement {
 	if x != nil {
 		return x.Inflow
 	}
 	return nil
 }
 
-func (x *Telegram) GetReset_() *wrapperspb.DoubleValue {
+func (x *Telegram) GetReset_() *DoubleMeasurement {
 	if x != nil {
 		return x.Reset_
 	}
 	return nil
 }
 
-type IcePhenomenia struct {
+func (x *Telegram) GetMeasuredDischargeMonth() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MeasuredDischargeMonth
+	}
+	return nil
+}
+
+func (x *Telegram) GetMeasuredWaterLevel() *Int32Measurement {
+	if x != nil {
+		return x.MeasuredWaterLevel
+	}
+	return nil
+}
+
+func (x *Telegram) GetMeasuredDischarge() *DoubleMeasurement {
+	if x != nil {
+		return x.MeasuredDischarge
+	}
+	return nil
+}
+
+func (x *Telegram) GetCrossSectionArea() *DoubleMeasurement {
+	if x != nil {
+		return x.CrossSectionArea
+	}
+	return nil
+}
+
+func (x *Telegram) GetAverageVelocity() *Int32Measurement {
+	if x != nil {
+		return x.AverageVelocity
+	}
+	return nil
+}
+
+func (x *Telegram) GetMaxDepth() *Int32Measurement {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return nil
+}
+
+func (x *Telegram) GetMeasurementTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MeasurementTime
+	}
+	return nil
+}
+
+func (x *Telegram) GetSender() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *Telegram) GetBulletinTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BulletinTime
+	}
+	return nil
+}
+
+func (x *Telegram) GetWarnings() []*ValidationWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *Telegram) GetStationName() string {
+	if x != nil {
+		return x.StationName
+	}
+	return ""
+}
+
+func (x *Telegram) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_SEVERITY_UNKNOWN
+}
+
+func (x *Telegram) GetDangerMismatch() bool {
+	if x != nil {
+		return x.DangerMismatch
+	}
+	return false
+}
+
+// ValidationWarning is a physically implausible value found after
+// decoding. field names the suspicious value, e.g. "water_temperature".
+type ValidationWarning struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phenomen  int32                  `protobuf:"varint,1,opt,name=phenomen,proto3" json:"phenomen,omitempty"` // енам надо
-	Intensity *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=intensity,proto3" json:"intensity,omitempty"`
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Field   string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *IcePhenomenia) Reset() {
-	*x = IcePhenomenia{}
+func (x *ValidationWarning) Reset() {
+	*x = ValidationWarning{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,13 +1006,13 @@ func (x *IcePhenomenia) Reset() {
 	}
 }
 
-func (x *IcePhenomenia) String() string {
+func (x *ValidationWarning) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IcePhenomenia) ProtoMessage() {}
+func (*ValidationWarning) ProtoMessage() {}
 
-func (x *IcePhenomenia) ProtoReflect() protoreflect.Message {
+func (x *ValidationWarning) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -567,35 +1024,46 @@ func (x *IcePhenomenia) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IcePhenomenia.ProtoReflect.Descriptor instead.
-func (*IcePhenomenia) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidationWarning.ProtoReflect.Descriptor instead.
+func (*ValidationWarning) Descriptor() ([]byte, []int) {
 	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *IcePhenomenia) GetPhenomen() int32 {
+func (x *ValidationWarning) GetCode() string {
 	if x != nil {
-		return x.Phenomen
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *IcePhenomenia) GetIntensity() *wrapperspb.Int32Value {
+func (x *ValidationWarning) GetField() string {
 	if x != nil {
-		return x.Intensity
+		return x.Field
 	}
-	return nil
+	return ""
 }
 
-type AddTelegramRequest struct {
+func (x *ValidationWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// A value of a telegram group. The message is unset when the group is
+// absent from the telegram and has not_measured set when the group reports
+// the value as '/'. The value field matches google.protobuf.Int32Value.
+type Int32Measurement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Value       int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	NotMeasured bool  `protobuf:"varint,2,opt,name=not_measured,json=notMeasured,proto3" json:"not_measured,omitempty"`
 }
 
-func (x *AddTelegramRequest) Reset() {
-	*x = AddTelegramRequest{}
+func (x *Int32Measurement) Reset() {
+	*x = Int32Measurement{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -603,13 +1071,13 @@ func (x *AddTelegramRequest) Reset() {
 	}
 }
 
-func (x *AddTelegramRequest) String() string {
+func (x *Int32Measurement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTelegramRequest) ProtoMessage() {}
+func (*Int32Measurement) ProtoMessage() {}
 
-func (x *AddTelegramRequest) ProtoReflect() protoreflect.Message {
+func (x *Int32Measurement) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -621,28 +1089,37 @@ func (x *AddTelegramRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTelegramRequest.ProtoReflect.Descriptor instead.
-func (*AddTelegramRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Int32Measurement.ProtoReflect.Descriptor instead.
+func (*Int32Measurement) Descriptor() ([]byte, []int) {
 	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AddTelegramRequest) GetCode() string {
+func (x *Int32Measurement) GetValue() int32 {
 	if x != nil {
-		return x.Code
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-type AddTelegramResponse struct {
+func (x *Int32Measurement) GetNotMeasured() bool {
+	if x != nil {
+		return x.NotMeasured
+	}
+	return false
+}
+
+// Like Int32Measurement; the value field matches google.protobuf.DoubleValue.
+type DoubleMeasurement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Telegrams []*Telegram `protobuf:"bytes,1,rep,name=telegrams,proto3" json:"telegrams,omitempty"`
+	Value       float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	NotMeasured bool    `protobuf:"varint,2,opt,name=not_measured,json=notMeasured,proto3" json:"not_measured,omitempty"`
 }
 
-func (x *AddTelegramResponse) Reset() {
-	*x = AddTelegramResponse{}
+func (x *DoubleMeasurement) Reset() {
+	*x = DoubleMeasurement{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -650,13 +1127,13 @@ func (x *AddTelegramResponse) Reset() {
 	}
 }
 
-func (x *AddTelegramResponse) String() string {
+func (x *DoubleMeasurement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddTelegramResponse) ProtoMessage() {}
+func (*DoubleMeasurement) ProtoMessage() {}
 
-func (x *AddTelegramResponse) ProtoReflect() protoreflect.Message {
+func (x *DoubleMeasurement) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -668,28 +1145,36 @@ func (x *AddTelegramResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddTelegramResponse.ProtoReflect.Descriptor instead.
-func (*AddTelegramResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DoubleMeasurement.ProtoReflect.Descriptor instead.
+func (*DoubleMeasurement) Descriptor() ([]byte, []int) {
 	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AddTelegramResponse) GetTelegrams() []*Telegram {
+func (x *DoubleMeasurement) GetValue() float64 {
 	if x != nil {
-		return x.Telegrams
+		return x.Value
 	}
-	return nil
+	return 0
 }
 
-type RemoveTelegramsRequest struct {
+func (x *DoubleMeasurement) GetNotMeasured() bool {
+	if x != nil {
+		return x.NotMeasured
+	}
+	return false
+}
+
+type IcePhenomenia struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id []string `protobuf:"bytes,1,rep,name=id,proto3" json:"id,omitempty"`
+	Phenomen  Phenomenon             `protobuf:"varint,1,opt,name=phenomen,proto3,enum=hydrologybuffer.Phenomenon" json:"phenomen,omitempty"`
+	Intensity *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=intensity,proto3" json:"intensity,omitempty"`
 }
 
-func (x *RemoveTelegramsRequest) Reset() {
-	*x = RemoveTelegramsRequest{}
+func (x *IcePhenomenia) Reset() {
+	*x = IcePhenomenia{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -697,13 +1182,13 @@ func (x *RemoveTelegramsRequest) Reset() {
 	}
 }
 
-func (x *RemoveTelegramsRequest) String() string {
+func (x *IcePhenomenia) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTelegramsRequest) ProtoMessage() {}
+func (*IcePhenomenia) ProtoMessage() {}
 
-func (x *RemoveTelegramsRequest) ProtoReflect() protoreflect.Message {
+func (x *IcePhenomenia) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -715,28 +1200,40 @@ func (x *RemoveTelegramsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTelegramsRequest.ProtoReflect.Descriptor instead.
-func (*RemoveTelegramsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IcePhenomenia.ProtoReflect.Descriptor instead.
+func (*IcePhenomenia) Descriptor() ([]byte, []int) {
 	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RemoveTelegramsRequest) GetId() []string {
+func (x *IcePhenomenia) GetPhenomen() Phenomenon {
 	if x != nil {
-		return x.Id
+		return x.Phenomen
+	}
+	return Phenomenon_PHENOMENON_UNSPECIFIED
+}
+
+func (x *IcePhenomenia) GetIntensity() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Intensity
 	}
 	return nil
 }
 
-type RemoveTelegramsResponse struct {
+type DecodeProblem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Section string `protobuf:"bytes,2,opt,name=section,proto3" json:"section,omitempty"`
+	Block   int32  `protobuf:"varint,3,opt,name=block,proto3" json:"block,omitempty"`
+	Offset  int32  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Group   string `protobuf:"bytes,5,opt,name=group,proto3" json:"group,omitempty"`
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *RemoveTelegramsResponse) Reset() {
-	*x = RemoveTelegramsResponse{}
+func (x *DecodeProblem) Reset() {
+	*x = DecodeProblem{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -744,13 +1241,13 @@ func (x *RemoveTelegramsResponse) Reset() {
 	}
 }
 
-func (x *RemoveTelegramsResponse) String() string {
+func (x *DecodeProblem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveTelegramsResponse) ProtoMessage() {}
+func (*DecodeProblem) ProtoMessage() {}
 
-func (x *RemoveTelegramsResponse) ProtoReflect() protoreflect.Message {
+func (x *DecodeProblem) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -762,28 +1259,66 @@ func (x *RemoveTelegramsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveTelegramsResponse.ProtoReflect.Descriptor instead.
-func (*RemoveTelegramsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DecodeProblem.ProtoReflect.Descriptor instead.
+func (*DecodeProblem) Descriptor() ([]byte, []int) {
 	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *RemoveTelegramsResponse) GetSuccess() bool {
+func (x *DecodeProblem) GetCode() string {
 	if x != nil {
-		return x.Success
+		return x.Code
 	}
-	return false
+	return ""
 }
 
-type UpdateTelegramByInfoRequest struct {
+func (x *DecodeProblem) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *DecodeProblem) GetBlock() int32 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *DecodeProblem) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DecodeProblem) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *DecodeProblem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AddTelegramRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Telegram *Telegram `protobuf:"bytes,1,opt,name=telegram,proto3" json:"telegram,omitempty"`
+	Code             string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ValidateOnly     bool   `protobuf:"varint,2,opt,name=validate_only,json=validateOnly,proto3" json:"validate_only,omitempty"`
+	Strict           bool   `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
+	FillMissingDelta bool   `protobuf:"varint,4,opt,name=fill_missing_delta,json=fillMissingDelta,proto3" json:"fill_missing_delta,omitempty"`
 }
 
-func (x *UpdateTelegramByInfoRequest) Reset() {
-	*x = UpdateTelegramByInfoRequest{}
+func (x *AddTelegramRequest) Reset() {
+	*x = AddTelegramRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -791,13 +1326,13 @@ func (x *UpdateTelegramByInfoRequest) Reset() {
 	}
 }
 
-func (x *UpdateTelegramByInfoRequest) String() string {
+func (x *AddTelegramRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTelegramByInfoRequest) ProtoMessage() {}
+func (*AddTelegramRequest) ProtoMessage() {}
 
-func (x *UpdateTelegramByInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *AddTelegramRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -809,29 +1344,56 @@ func (x *UpdateTelegramByInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTelegramByInfoRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTelegramByInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddTelegramRequest.ProtoReflect.Descriptor instead.
+func (*AddTelegramRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateTelegramByInfoRequest) GetTelegram() *Telegram {
+func (x *AddTelegramRequest) GetCode() string {
 	if x != nil {
-		return x.Telegram
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-type UpdateTelegramByCodeRequest struct {
+func (x *AddTelegramRequest) GetValidateOnly() bool {
+	if x != nil {
+		return x.ValidateOnly
+	}
+	return false
+}
+
+func (x *AddTelegramRequest) GetStrict() bool {
+	if x != nil {
+		return x.Strict
+	}
+	return false
+}
+
+func (x *AddTelegramRequest) GetFillMissingDelta() bool {
+	if x != nil {
+		return x.FillMissingDelta
+	}
+	return false
+}
+
+type TelegramResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TelegramCode string `protobuf:"bytes,2,opt,name=telegram_code,json=telegramCode,proto3" json:"telegram_code,omitempty"`
+	Index     int32            `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Offset    int32            `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Code      string           `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Success   bool             `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	GroupId   string           `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Telegrams []*Telegram      `protobuf:"bytes,6,rep,name=telegrams,proto3" json:"telegrams,omitempty"`
+	Errors    []*DecodeProblem `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+	Warnings  []*DecodeProblem `protobuf:"bytes,8,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
-func (x *UpdateTelegramByCodeRequest) Reset() {
-	*x = UpdateTelegramByCodeRequest{}
+func (x *TelegramResult) Reset() {
+	*x = TelegramResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -839,13 +1401,13 @@ func (x *UpdateTelegramByCodeRequest) Reset() {
 	}
 }
 
-func (x *UpdateTelegramByCodeRequest) String() string {
+func (x *TelegramResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTelegramByCodeRequest) ProtoMessage() {}
+func (*TelegramResult) ProtoMessage() {}
 
-func (x *UpdateTelegramByCodeRequest) ProtoReflect() protoreflect.Message {
+func (x *TelegramResult) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -857,35 +1419,80 @@ func (x *UpdateTelegramByCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTelegramByCodeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTelegramByCodeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TelegramResult.ProtoReflect.Descriptor instead.
+func (*TelegramResult) Descriptor() ([]byte, []int) {
 	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateTelegramByCodeRequest) GetId() string {
+func (x *TelegramResult) GetIndex() int32 {
 	if x != nil {
-		return x.Id
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TelegramResult) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *TelegramResult) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UpdateTelegramByCodeRequest) GetTelegramCode() string {
+func (x *TelegramResult) GetSuccess() bool {
 	if x != nil {
-		return x.TelegramCode
+		return x.Success
+	}
+	return false
+}
+
+func (x *TelegramResult) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
 	}
 	return ""
 }
 
-type UpdateTelegramResponse struct {
+func (x *TelegramResult) GetTelegrams() []*Telegram {
+	if x != nil {
+		return x.Telegrams
+	}
+	return nil
+}
+
+func (x *TelegramResult) GetErrors() []*DecodeProblem {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *TelegramResult) GetWarnings() []*DecodeProblem {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type AddTelegramResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Telegram *Telegram `protobuf:"bytes,1,opt,name=telegram,proto3" json:"telegram,omitempty"`
+	Telegrams []*Telegram       `protobuf:"bytes,1,rep,name=telegrams,proto3" json:"telegrams,omitempty"`
+	Errors    []*DecodeProblem  `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Warnings  []*DecodeProblem  `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Results   []*TelegramResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *UpdateTelegramResponse) Reset() {
-	*x = UpdateTelegramResponse{}
+func (x *AddTelegramResponse) Reset() {
+	*x = AddTelegramResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -893,13 +1500,13 @@ func (x *UpdateTelegramResponse) Reset() {
 	}
 }
 
-func (x *UpdateTelegramResponse) String() string {
+func (x *AddTelegramResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTelegramResponse) ProtoMessage() {}
+func (*AddTelegramResponse) ProtoMessage() {}
 
-func (x *UpdateTelegramResponse) ProtoReflect() protoreflect.Message {
+func (x *AddTelegramResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -911,28 +1518,49 @@ func (x *UpdateTelegramResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTelegramResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTelegramResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddTelegramResponse.ProtoReflect.Descriptor instead.
+func (*AddTelegramResponse) Descriptor() ([]byte, []int) {
 	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateTelegramResponse) GetTelegram() *Telegram {
+func (x *AddTelegramResponse) GetTelegrams() []*Telegram {
 	if x != nil {
-		return x.Telegram
+		return x.Telegrams
 	}
 	return nil
 }
 
-type GetTelegramRequest struct {
+func (x *AddTelegramResponse) GetErrors() []*DecodeProblem {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *AddTelegramResponse) GetWarnings() []*DecodeProblem {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *AddTelegramResponse) GetResults() []*TelegramResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type RemoveTelegramsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id []string `protobuf:"bytes,1,rep,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetTelegramRequest) Reset() {
-	*x = GetTelegramRequest{}
+func (x *RemoveTelegramsRequest) Reset() {
+	*x = RemoveTelegramsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -940,13 +1568,13 @@ func (x *GetTelegramRequest) Reset() {
 	}
 }
 
-func (x *GetTelegramRequest) String() string {
+func (x *RemoveTelegramsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTelegramRequest) ProtoMessage() {}
+func (*RemoveTelegramsRequest) ProtoMessage() {}
 
-func (x *GetTelegramRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveTelegramsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -958,28 +1586,28 @@ func (x *GetTelegramRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTelegramRequest.ProtoReflect.Descriptor instead.
-func (*GetTelegramRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveTelegramsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTelegramsRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetTelegramRequest) GetId() string {
+func (x *RemoveTelegramsRequest) GetId() []string {
 	if x != nil {
 		return x.Id
 	}
-	return ""
+	return nil
 }
 
-type GetTelegramResponse struct {
+type RemoveTelegramsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Telegram *Telegram `protobuf:"bytes,1,opt,name=Telegram,proto3" json:"Telegram,omitempty"`
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 }
 
-func (x *GetTelegramResponse) Reset() {
-	*x = GetTelegramResponse{}
+func (x *RemoveTelegramsResponse) Reset() {
+	*x = RemoveTelegramsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -987,13 +1615,13 @@ func (x *GetTelegramResponse) Reset() {
 	}
 }
 
-func (x *GetTelegramResponse) String() string {
+func (x *RemoveTelegramsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTelegramResponse) ProtoMessage() {}
+func (*RemoveTelegramsResponse) ProtoMessage() {}
 
-func (x *GetTelegramResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveTelegramsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1005,26 +1633,29 @@ func (x *GetTelegramResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTelegramResponse.ProtoReflect.Descriptor instead.
-func (*GetTelegramResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveTelegramsResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTelegramsResponse) Descriptor() ([]byte, []int) {
 	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetTelegramResponse) GetTelegram() *Telegram {
+func (x *RemoveTelegramsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Telegram
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type GetTelegramsRequest struct {
+type UpdateTelegramByInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Telegram         *Telegram `protobuf:"bytes,1,opt,name=telegram,proto3" json:"telegram,omitempty"`
+	FillMissingDelta bool      `protobuf:"varint,2,opt,name=fill_missing_delta,json=fillMissingDelta,proto3" json:"fill_missing_delta,omitempty"`
 }
 
-func (x *GetTelegramsRequest) Reset() {
-	*x = GetTelegramsRequest{}
+func (x *UpdateTelegramByInfoRequest) Reset() {
+	*x = UpdateTelegramByInfoRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1032,13 +1663,13 @@ func (x *GetTelegramsRequest) Reset() {
 	}
 }
 
-func (x *GetTelegramsRequest) String() string {
+func (x *UpdateTelegramByInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTelegramsRequest) ProtoMessage() {}
+func (*UpdateTelegramByInfoRequest) ProtoMessage() {}
 
-func (x *GetTelegramsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateTelegramByInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1050,21 +1681,37 @@ func (x *GetTelegramsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTelegramsRequest.ProtoReflect.Descriptor instead.
-func (*GetTelegramsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateTelegramByInfoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTelegramByInfoRequest) Descriptor() ([]byte, []int) {
 	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{13}
 }
 
-type GetTelegramsResponse struct {
+func (x *UpdateTelegramByInfoRequest) GetTelegram() *Telegram {
+	if x != nil {
+		return x.Telegram
+	}
+	return nil
+}
+
+func (x *UpdateTelegramByInfoRequest) GetFillMissingDelta() bool {
+	if x != nil {
+		return x.FillMissingDelta
+	}
+	return false
+}
+
+type UpdateTelegramByCodeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Telegrams []*Telegram `protobuf:"bytes,1,rep,name=telegrams,proto3" json:"telegrams,omitempty"`
+	Id               string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TelegramCode     string `protobuf:"bytes,2,opt,name=telegram_code,json=telegramCode,proto3" json:"telegram_code,omitempty"`
+	FillMissingDelta bool   `protobuf:"varint,3,opt,name=fill_missing_delta,json=fillMissingDelta,proto3" json:"fill_missing_delta,omitempty"`
 }
 
-func (x *GetTelegramsResponse) Reset() {
-	*x = GetTelegramsResponse{}
+func (x *UpdateTelegramByCodeRequest) Reset() {
+	*x = UpdateTelegramByCodeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1072,14 +1719,1367 @@ func (x *GetTelegramsResponse) Reset() {
 	}
 }
 
+func (x *UpdateTelegramByCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTelegramByCodeRequest) ProtoMessage() {}
+
+func (x *UpdateTelegramByCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTelegramByCodeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTelegramByCodeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateTelegramByCodeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTelegramByCodeRequest) GetTelegramCode() string {
+	if x != nil {
+		return x.TelegramCode
+	}
+	return ""
+}
+
+func (x *UpdateTelegramByCodeRequest) GetFillMissingDelta() bool {
+	if x != nil {
+		return x.FillMissingDelta
+	}
+	return false
+}
+
+type UpdateTelegramResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Telegram *Telegram `protobuf:"bytes,1,opt,name=telegram,proto3" json:"telegram,omitempty"`
+}
+
+func (x *UpdateTelegramResponse) Reset() {
+	*x = UpdateTelegramResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateTelegramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTelegramResponse) ProtoMessage() {}
+
+func (x *UpdateTelegramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTelegramResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTelegramResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateTelegramResponse) GetTelegram() *Telegram {
+	if x != nil {
+		return x.Telegram
+	}
+	return nil
+}
+
+type GetTelegramRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetTelegramRequest) Reset() {
+	*x = GetTelegramRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTelegramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTelegramRequest) ProtoMessage() {}
+
+func (x *GetTelegramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTelegramRequest.ProtoReflect.Descriptor instead.
+func (*GetTelegramRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetTelegramRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetTelegramResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Telegram *Telegram `protobuf:"bytes,1,opt,name=Telegram,proto3" json:"Telegram,omitempty"`
+}
+
+func (x *GetTelegramResponse) Reset() {
+	*x = GetTelegramResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTelegramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTelegramResponse) ProtoMessage() {}
+
+func (x *GetTelegramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTelegramResponse.ProtoReflect.Descriptor instead.
+func (*GetTelegramResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetTelegramResponse) GetTelegram() *Telegram {
+	if x != nil {
+		return x.Telegram
+	}
+	return nil
+}
+
+type GetTelegramsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// danger_mismatch_only limits the result to telegrams whose 977 flag
+	// disagrees with the station thresholds.
+	DangerMismatchOnly bool `protobuf:"varint,1,opt,name=danger_mismatch_only,json=dangerMismatchOnly,proto3" json:"danger_mismatch_only,omitempty"`
+}
+
+func (x *GetTelegramsRequest) Reset() {
+	*x = GetTelegramsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTelegramsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTelegramsRequest) ProtoMessage() {}
+
+func (x *GetTelegramsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTelegramsRequest.ProtoReflect.Descriptor instead.
+func (*GetTelegramsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTelegramsRequest) GetDangerMismatchOnly() bool {
+	if x != nil {
+		return x.DangerMismatchOnly
+	}
+	return false
+}
+
+type GetTelegramsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Telegrams []*Telegram `protobuf:"bytes,1,rep,name=telegrams,proto3" json:"telegrams,omitempty"`
+}
+
+func (x *GetTelegramsResponse) Reset() {
+	*x = GetTelegramsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
 func (x *GetTelegramsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTelegramsResponse) ProtoMessage() {}
+func (*GetTelegramsResponse) ProtoMessage() {}
+
+func (x *GetTelegramsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTelegramsResponse.ProtoReflect.Descriptor instead.
+func (*GetTelegramsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTelegramsResponse) GetTelegrams() []*Telegram {
+	if x != nil {
+		return x.Telegrams
+	}
+	return nil
+}
+
+type TransferToSystemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id []string `protobuf:"bytes,1,rep,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TransferToSystemRequest) Reset() {
+	*x = TransferToSystemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferToSystemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferToSystemRequest) ProtoMessage() {}
+
+func (x *TransferToSystemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferToSystemRequest.ProtoReflect.Descriptor instead.
+func (*TransferToSystemRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TransferToSystemRequest) GetId() []string {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type TransferToSystemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *TransferToSystemResponse) Reset() {
+	*x = TransferToSystemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferToSystemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferToSystemResponse) ProtoMessage() {}
+
+func (x *TransferToSystemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferToSystemResponse.ProtoReflect.Descriptor instead.
+func (*TransferToSystemResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TransferToSystemResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListPhenomenaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPhenomenaRequest) Reset() {
+	*x = ListPhenomenaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPhenomenaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPhenomenaRequest) ProtoMessage() {}
+
+func (x *ListPhenomenaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPhenomenaRequest.ProtoReflect.Descriptor instead.
+func (*ListPhenomenaRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{22}
+}
+
+type PhenomenonInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code             Phenomenon         `protobuf:"varint,1,opt,name=code,proto3,enum=hydrologybuffer.Phenomenon" json:"code,omitempty"`
+	NameRu           string             `protobuf:"bytes,2,opt,name=name_ru,json=nameRu,proto3" json:"name_ru,omitempty"`
+	NameEn           string             `protobuf:"bytes,3,opt,name=name_en,json=nameEn,proto3" json:"name_en,omitempty"`
+	Category         PhenomenonCategory `protobuf:"varint,4,opt,name=category,proto3,enum=hydrologybuffer.PhenomenonCategory" json:"category,omitempty"`
+	IntensityAllowed bool               `protobuf:"varint,5,opt,name=intensity_allowed,json=intensityAllowed,proto3" json:"intensity_allowed,omitempty"`
+}
+
+func (x *PhenomenonInfo) Reset() {
+	*x = PhenomenonInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PhenomenonInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhenomenonInfo) ProtoMessage() {}
+
+func (x *PhenomenonInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhenomenonInfo.ProtoReflect.Descriptor instead.
+func (*PhenomenonInfo) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PhenomenonInfo) GetCode() Phenomenon {
+	if x != nil {
+		return x.Code
+	}
+	return Phenomenon_PHENOMENON_UNSPECIFIED
+}
+
+func (x *PhenomenonInfo) GetNameRu() string {
+	if x != nil {
+		return x.NameRu
+	}
+	return ""
+}
+
+func (x *PhenomenonInfo) GetNameEn() string {
+	if x != nil {
+		return x.NameEn
+	}
+	return ""
+}
+
+func (x *PhenomenonInfo) GetCategory() PhenomenonCategory {
+	if x != nil {
+		return x.Category
+	}
+	return PhenomenonCategory_PHENOMENON_CATEGORY_UNSPECIFIED
+}
+
+func (x *PhenomenonInfo) GetIntensityAllowed() bool {
+	if x != nil {
+		return x.IntensityAllowed
+	}
+	return false
+}
+
+type ListPhenomenaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phenomena []*PhenomenonInfo `protobuf:"bytes,1,rep,name=phenomena,proto3" json:"phenomena,omitempty"`
+}
+
+func (x *ListPhenomenaResponse) Reset() {
+	*x = ListPhenomenaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPhenomenaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPhenomenaResponse) ProtoMessage() {}
+
+func (x *ListPhenomenaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPhenomenaResponse.ProtoReflect.Descriptor instead.
+func (*ListPhenomenaResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListPhenomenaResponse) GetPhenomena() []*PhenomenonInfo {
+	if x != nil {
+		return x.Phenomena
+	}
+	return nil
+}
+
+// DescribeTelegramRequest names a stored telegram by id or carries a
+// telegram code to decode; code takes precedence when both are set.
+type DescribeTelegramRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code     string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Language Language `protobuf:"varint,3,opt,name=language,proto3,enum=hydrologybuffer.Language" json:"language,omitempty"`
+}
+
+func (x *DescribeTelegramRequest) Reset() {
+	*x = DescribeTelegramRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeTelegramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeTelegramRequest) ProtoMessage() {}
+
+func (x *DescribeTelegramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeTelegramRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTelegramRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DescribeTelegramRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DescribeTelegramRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DescribeTelegramRequest) GetLanguage() Language {
+	if x != nil {
+		return x.Language
+	}
+	return Language_LANGUAGE_UNSPECIFIED
+}
+
+type DescribeTelegramResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Descriptions []string `protobuf:"bytes,1,rep,name=descriptions,proto3" json:"descriptions,omitempty"`
+}
+
+func (x *DescribeTelegramResponse) Reset() {
+	*x = DescribeTelegramResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeTelegramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeTelegramResponse) ProtoMessage() {}
+
+func (x *DescribeTelegramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeTelegramResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTelegramResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DescribeTelegramResponse) GetDescriptions() []string {
+	if x != nil {
+		return x.Descriptions
+	}
+	return nil
+}
+
+type TokenizeTelegramRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *TokenizeTelegramRequest) Reset() {
+	*x = TokenizeTelegramRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenizeTelegramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenizeTelegramRequest) ProtoMessage() {}
+
+func (x *TokenizeTelegramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenizeTelegramRequest.ProtoReflect.Descriptor instead.
+func (*TokenizeTelegramRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TokenizeTelegramRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// TelegramToken is a single group of the request code. start and end are
+// byte offsets into the code; value is set when the group decoded and
+// problem when it did not.
+type TelegramToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group   string         `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Start   int32          `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End     int32          `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Section string         `protobuf:"bytes,4,opt,name=section,proto3" json:"section,omitempty"`
+	Kind    string         `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value   string         `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	Problem *DecodeProblem `protobuf:"bytes,7,opt,name=problem,proto3" json:"problem,omitempty"`
+}
+
+func (x *TelegramToken) Reset() {
+	*x = TelegramToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TelegramToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelegramToken) ProtoMessage() {}
+
+func (x *TelegramToken) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelegramToken.ProtoReflect.Descriptor instead.
+func (*TelegramToken) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TelegramToken) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *TelegramToken) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TelegramToken) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *TelegramToken) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *TelegramToken) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TelegramToken) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TelegramToken) GetProblem() *DecodeProblem {
+	if x != nil {
+		return x.Problem
+	}
+	return nil
+}
+
+type TokenizeTelegramResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens []*TelegramToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *TokenizeTelegramResponse) Reset() {
+	*x = TokenizeTelegramResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenizeTelegramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenizeTelegramResponse) ProtoMessage() {}
+
+func (x *TokenizeTelegramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenizeTelegramResponse.ProtoReflect.Descriptor instead.
+func (*TokenizeTelegramResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TokenizeTelegramResponse) GetTokens() []*TelegramToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// Station is a gauging post. sections lists the KN-15 sections the post is
+// expected to send: "main", "922", "944", "955" or "966".
+type Station struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostCode   string             `protobuf:"bytes,1,opt,name=post_code,json=postCode,proto3" json:"post_code,omitempty"`
+	Name       string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	River      string             `protobuf:"bytes,3,opt,name=river,proto3" json:"river,omitempty"`
+	Basin      string             `protobuf:"bytes,4,opt,name=basin,proto3" json:"basin,omitempty"`
+	Latitude   float64            `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude  float64            `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	GaugeZero  float64            `protobuf:"fixed64,7,opt,name=gauge_zero,json=gaugeZero,proto3" json:"gauge_zero,omitempty"`
+	TimeZone   string             `protobuf:"bytes,8,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Active     bool               `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	Sections   []string           `protobuf:"bytes,10,rep,name=sections,proto3" json:"sections,omitempty"`
+	Thresholds *StationThresholds `protobuf:"bytes,11,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+}
+
+func (x *Station) Reset() {
+	*x = Station{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Station) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Station) ProtoMessage() {}
+
+func (x *Station) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Station.ProtoReflect.Descriptor instead.
+func (*Station) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Station) GetPostCode() string {
+	if x != nil {
+		return x.PostCode
+	}
+	return ""
+}
+
+func (x *Station) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Station) GetRiver() string {
+	if x != nil {
+		return x.River
+	}
+	return ""
+}
+
+func (x *Station) GetBasin() string {
+	if x != nil {
+		return x.Basin
+	}
+	return ""
+}
+
+func (x *Station) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Station) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Station) GetGaugeZero() float64 {
+	if x != nil {
+		return x.GaugeZero
+	}
+	return 0
+}
+
+func (x *Station) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Station) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Station) GetSections() []string {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *Station) GetThresholds() *StationThresholds {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+// StationThresholds are water levels in cm above the gauge zero. Unset
+// levels are not checked. Without ice_jam_phenomena every phenomenon of the
+// jam category indicates a jam.
+type StationThresholds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FavourableLevel *wrapperspb.Int32Value `protobuf:"bytes,1,opt,name=favourable_level,json=favourableLevel,proto3" json:"favourable_level,omitempty"`
+	AdverseLevel    *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=adverse_level,json=adverseLevel,proto3" json:"adverse_level,omitempty"`
+	DangerousLevel  *wrapperspb.Int32Value `protobuf:"bytes,3,opt,name=dangerous_level,json=dangerousLevel,proto3" json:"dangerous_level,omitempty"`
+	IceJamLevel     *wrapperspb.Int32Value `protobuf:"bytes,4,opt,name=ice_jam_level,json=iceJamLevel,proto3" json:"ice_jam_level,omitempty"`
+	IceJamPhenomena []Phenomenon           `protobuf:"varint,5,rep,packed,name=ice_jam_phenomena,json=iceJamPhenomena,proto3,enum=hydrologybuffer.Phenomenon" json:"ice_jam_phenomena,omitempty"`
+}
+
+func (x *StationThresholds) Reset() {
+	*x = StationThresholds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StationThresholds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StationThresholds) ProtoMessage() {}
+
+func (x *StationThresholds) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StationThresholds.ProtoReflect.Descriptor instead.
+func (*StationThresholds) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StationThresholds) GetFavourableLevel() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.FavourableLevel
+	}
+	return nil
+}
+
+func (x *StationThresholds) GetAdverseLevel() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.AdverseLevel
+	}
+	return nil
+}
+
+func (x *StationThresholds) GetDangerousLevel() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.DangerousLevel
+	}
+	return nil
+}
+
+func (x *StationThresholds) GetIceJamLevel() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.IceJamLevel
+	}
+	return nil
+}
+
+func (x *StationThresholds) GetIceJamPhenomena() []Phenomenon {
+	if x != nil {
+		return x.IceJamPhenomena
+	}
+	return nil
+}
+
+type AddStationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Station *Station `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+}
+
+func (x *AddStationRequest) Reset() {
+	*x = AddStationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddStationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddStationRequest) ProtoMessage() {}
+
+func (x *AddStationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddStationRequest.ProtoReflect.Descriptor instead.
+func (*AddStationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddStationRequest) GetStation() *Station {
+	if x != nil {
+		return x.Station
+	}
+	return nil
+}
+
+type GetStationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostCode string `protobuf:"bytes,1,opt,name=post_code,json=postCode,proto3" json:"post_code,omitempty"`
+}
+
+func (x *GetStationRequest) Reset() {
+	*x = GetStationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStationRequest) ProtoMessage() {}
+
+func (x *GetStationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStationRequest.ProtoReflect.Descriptor instead.
+func (*GetStationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetStationRequest) GetPostCode() string {
+	if x != nil {
+		return x.PostCode
+	}
+	return ""
+}
+
+type ListStationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActiveOnly bool `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+}
+
+func (x *ListStationsRequest) Reset() {
+	*x = ListStationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStationsRequest) ProtoMessage() {}
+
+func (x *ListStationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStationsRequest.ProtoReflect.Descriptor instead.
+func (*ListStationsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListStationsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListStationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stations []*Station `protobuf:"bytes,1,rep,name=stations,proto3" json:"stations,omitempty"`
+}
+
+func (x *ListStationsResponse) Reset() {
+	*x = ListStationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStationsResponse) ProtoMessage() {}
+
+func (x *ListStationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStationsResponse.ProtoReflect.Descriptor instead.
+func (*ListStationsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListStationsResponse) GetStations() []*Station {
+	if x != nil {
+		return x.Stations
+	}
+	return nil
+}
+
+type UpdateStationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Station *Station `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+}
+
+func (x *UpdateStationRequest) Reset() {
+	*x = UpdateStationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateStationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStationRequest) ProtoMessage() {}
+
+func (x *UpdateStationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateStationRequest) GetStation() *Station {
+	if x != nil {
+		return x.Station
+	}
+	return nil
+}
+
+type RemoveStationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostCode string `protobuf:"bytes,1,opt,name=post_code,json=postCode,proto3" json:"post_code,omitempty"`
+}
+
+func (x *RemoveStationRequest) Reset() {
+	*x = RemoveStationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveStationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStationRequest) ProtoMessage() {}
+
+func (x *RemoveStationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveStationRequest.ProtoReflect.Descriptor instead.
+func (*RemoveStationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RemoveStationRequest) GetPostCode() string {
+	if x != nil {
+		return x.PostCode
+	}
+	return ""
+}
+
+type RemoveStationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *RemoveStationResponse) Reset() {
+	*x = RemoveStationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveStationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveStationResponse) ProtoMessage() {}
 
-func (x *GetTelegramsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[14]
+func (x *RemoveStationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1090,43 +3090,43 @@ func (x *GetTelegramsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTelegramsResponse.ProtoReflect.Descriptor instead.
-func (*GetTelegramsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use RemoveStationResponse.ProtoReflect.Descriptor instead.
+func (*RemoveStationResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetTelegramsResponse) GetTelegrams() []*Telegram {
+func (x *RemoveStationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Telegrams
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type TransferToSystemRequest struct {
+type StationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id []string `protobuf:"bytes,1,rep,name=id,proto3" json:"id,omitempty"`
+	Station *Station `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
 }
 
-func (x *TransferToSystemRequest) Reset() {
-	*x = TransferToSystemRequest{}
+func (x *StationResponse) Reset() {
+	*x = StationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[15]
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferToSystemRequest) String() string {
+func (x *StationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferToSystemRequest) ProtoMessage() {}
+func (*StationResponse) ProtoMessage() {}
 
-func (x *TransferToSystemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[15]
+func (x *StationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,43 +3137,181 @@ func (x *TransferToSystemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferToSystemRequest.ProtoReflect.Descriptor instead.
-func (*TransferToSystemRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use StationResponse.ProtoReflect.Descriptor instead.
+func (*StationResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *TransferToSystemRequest) GetId() []string {
+func (x *StationResponse) GetStation() *Station {
+	if x != nil {
+		return x.Station
+	}
+	return nil
+}
+
+// Alert is a notification about a dangerous telegram. level counts the
+// escalations sent because nobody acknowledged the alert.
+type Alert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TelegramId     string                 `protobuf:"bytes,2,opt,name=telegram_id,json=telegramId,proto3" json:"telegram_id,omitempty"`
+	PostCode       string                 `protobuf:"bytes,3,opt,name=post_code,json=postCode,proto3" json:"post_code,omitempty"`
+	StationName    string                 `protobuf:"bytes,4,opt,name=station_name,json=stationName,proto3" json:"station_name,omitempty"`
+	Datetime       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	Severity       Severity               `protobuf:"varint,6,opt,name=severity,proto3,enum=hydrologybuffer.Severity" json:"severity,omitempty"`
+	IsDangerous    bool                   `protobuf:"varint,7,opt,name=is_dangerous,json=isDangerous,proto3" json:"is_dangerous,omitempty"`
+	Reason         string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	Level          int32                  `protobuf:"varint,9,opt,name=level,proto3" json:"level,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AcknowledgedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	AcknowledgedBy string                 `protobuf:"bytes,12,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"`
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Alert) GetId() string {
 	if x != nil {
 		return x.Id
 	}
+	return ""
+}
+
+func (x *Alert) GetTelegramId() string {
+	if x != nil {
+		return x.TelegramId
+	}
+	return ""
+}
+
+func (x *Alert) GetPostCode() string {
+	if x != nil {
+		return x.PostCode
+	}
+	return ""
+}
+
+func (x *Alert) GetStationName() string {
+	if x != nil {
+		return x.StationName
+	}
+	return ""
+}
+
+func (x *Alert) GetDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Datetime
+	}
 	return nil
 }
 
-type TransferToSystemResponse struct {
+func (x *Alert) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_SEVERITY_UNKNOWN
+}
+
+func (x *Alert) GetIsDangerous() bool {
+	if x != nil {
+		return x.IsDangerous
+	}
+	return false
+}
+
+func (x *Alert) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Alert) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Alert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Alert) GetAcknowledgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return nil
+}
+
+func (x *Alert) GetAcknowledgedBy() string {
+	if x != nil {
+		return x.AcknowledgedBy
+	}
+	return ""
+}
+
+type AcknowledgeAlertRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AcknowledgedBy string `protobuf:"bytes,2,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"`
 }
 
-func (x *TransferToSystemResponse) Reset() {
-	*x = TransferToSystemResponse{}
+func (x *AcknowledgeAlertRequest) Reset() {
+	*x = AcknowledgeAlertRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[16]
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferToSystemResponse) String() string {
+func (x *AcknowledgeAlertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferToSystemResponse) ProtoMessage() {}
+func (*AcknowledgeAlertRequest) ProtoMessage() {}
 
-func (x *TransferToSystemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[16]
+func (x *AcknowledgeAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1184,16 +3322,70 @@ func (x *TransferToSystemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferToSystemResponse.ProtoReflect.Descriptor instead.
-func (*TransferToSystemResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use AcknowledgeAlertRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeAlertRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *TransferToSystemResponse) GetSuccess() bool {
+func (x *AcknowledgeAlertRequest) GetId() string {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return ""
+}
+
+func (x *AcknowledgeAlertRequest) GetAcknowledgedBy() string {
+	if x != nil {
+		return x.AcknowledgedBy
+	}
+	return ""
+}
+
+type AcknowledgeAlertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alert *Alert `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+}
+
+func (x *AcknowledgeAlertResponse) Reset() {
+	*x = AcknowledgeAlertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcknowledgeAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeAlertResponse) ProtoMessage() {}
+
+func (x *AcknowledgeAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_hydrology_buffer_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeAlertResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeAlertResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AcknowledgeAlertResponse) GetAlert() *Alert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
 }
 
 var File_internal_proto_hydrology_buffer_service_proto protoreflect.FileDescriptor
@@ -1210,8 +3402,8 @@ var file_internal_proto_hydrology_buffer_service_proto_rawDesc = []byte{
 	0x6f, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x22, 0x2c, 0x0a, 0x0c, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xe7,
-	0x0c, 0x0a, 0x08, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xa7,
+	0x14, 0x0a, 0x08, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x67,
 	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
 	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
@@ -1224,227 +3416,706 @@ var file_internal_proto_hydrology_buffer_service_proto_rawDesc = []byte{
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65,
 	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x6f, 0x75, 0x73,
 	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x44, 0x61, 0x6e, 0x67, 0x65, 0x72,
-	0x6f, 0x75, 0x73, 0x12, 0x4a, 0x0a, 0x13, 0x77, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x76,
+	0x6f, 0x75, 0x73, 0x12, 0x50, 0x0a, 0x13, 0x77, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x76,
 	0x65, 0x6c, 0x5f, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x77,
-	0x61, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x47, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
-	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x57, 0x61,
-	0x74, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x47, 0x0a, 0x11, 0x77, 0x61, 0x74, 0x65,
-	0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x6f, 0x6e, 0x32, 0x30, 0x68, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x0f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x6e, 0x32, 0x30,
-	0x68, 0x12, 0x49, 0x0a, 0x11, 0x77, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x77, 0x61, 0x74, 0x65,
-	0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x44, 0x0a, 0x0f,
-	0x61, 0x69, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0e, 0x61, 0x69, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x12, 0x4d, 0x0a, 0x14, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x68, 0x65, 0x6e, 0x6f, 0x6d,
-	0x65, 0x6e, 0x69, 0x61, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x69,
-	0x63, 0x65, 0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x69, 0x61, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x47, 0x0a, 0x0f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65,
-	0x6e, 0x69, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x79, 0x64,
-	0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x49, 0x63, 0x65,
-	0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x69, 0x61, 0x52, 0x0e, 0x69, 0x63, 0x65, 0x50,
-	0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x69, 0x61, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x69, 0x63,
-	0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x69, 0x63, 0x65,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x6e, 0x6f, 0x77, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
-	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x73, 0x6e, 0x6f, 0x77, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x77, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c,
-	0x6f, 0x77, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x77, 0x61, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x6f,
-	0x77, 0x12, 0x4d, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x70, 0x72,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x52, 0x0a, 0x16, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x70,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x6f, 0x69,
-	0x72, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x6f, 0x69, 0x72, 0x44, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x77,
-	0x61, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x68,
-	0x65, 0x61, 0x64, 0x77, 0x61, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x53, 0x0a,
-	0x17, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x6f,
-	0x69, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x32, 0x21, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x10, 0x77, 0x61, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x77,
+	0x61, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x57, 0x61, 0x74, 0x65, 0x72, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x4d, 0x0a, 0x11, 0x77, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x5f, 0x6f, 0x6e, 0x32, 0x30, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x6e,
+	0x32, 0x30, 0x68, 0x12, 0x4f, 0x0a, 0x11, 0x77, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x10, 0x77, 0x61, 0x74, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x61, 0x69, 0x72, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0e, 0x61, 0x69, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x4d, 0x0a, 0x14, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e,
+	0x69, 0x61, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x61, 0x76, 0x65,
-	0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x6f, 0x69, 0x72, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x12, 0x46, 0x0a, 0x10, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x47, 0x0a, 0x10, 0x72, 0x65,
-	0x73, 0x65, 0x72, 0x76, 0x6f, 0x69, 0x72, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x17,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x6f, 0x69, 0x72, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x12, 0x59, 0x0a, 0x1b, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x6f, 0x69, 0x72,
-	0x5f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x69, 0x63, 0x65,
+	0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x69, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x47, 0x0a, 0x0f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x69,
+	0x61, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x49, 0x63, 0x65, 0x50, 0x68,
+	0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x69, 0x61, 0x52, 0x0e, 0x69, 0x63, 0x65, 0x50, 0x68, 0x65,
+	0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x69, 0x61, 0x73, 0x12, 0x40, 0x0a, 0x0a, 0x69, 0x63, 0x65, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68,
+	0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x09, 0x69, 0x63, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x42, 0x0a, 0x0b, 0x73, 0x6e,
+	0x6f, 0x77, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x73, 0x6e, 0x6f, 0x77, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x41,
+	0x0a, 0x0a, 0x77, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x77, 0x61, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x6f,
+	0x77, 0x12, 0x53, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x12, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x58, 0x0a, 0x16, 0x70, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x15, 0x70, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x6f, 0x69, 0x72, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x18, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x6f, 0x69, 0x72, 0x57,
-	0x61, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6c, 0x6f, 0x77, 0x44, 0x61, 0x74, 0x65, 0x12, 0x34,
-	0x0a, 0x06, 0x69, 0x6e, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x6f, 0x69, 0x72, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x68, 0x65, 0x61, 0x64, 0x77, 0x61, 0x74, 0x65, 0x72,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68,
+	0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x0e, 0x68, 0x65, 0x61, 0x64, 0x77, 0x61, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x59, 0x0a, 0x17, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x6f, 0x69, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x15, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x6f, 0x69, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x4c, 0x0a, 0x10, 0x64, 0x6f,
+	0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x4d, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x6f, 0x69, 0x72, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x6f, 0x69,
+	0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x59, 0x0a, 0x1b, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x6f, 0x69, 0x72, 0x5f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x6f,
+	0x77, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x18, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x6f, 0x69, 0x72, 0x57, 0x61, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6c, 0x6f, 0x77, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x69, 0x6e, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x38,
+	0x0a, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x55, 0x0a, 0x18, 0x6d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x64, 0x44, 0x69, 0x73, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12,
+	0x53, 0x0a, 0x14, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x61, 0x74, 0x65,
+	0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x12, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x57, 0x61, 0x74, 0x65, 0x72, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x51, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64,
+	0x5f, 0x64, 0x69, 0x73, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x44, 0x69,
+	0x73, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x12, 0x50, 0x0a, 0x12, 0x63, 0x72, 0x6f, 0x73, 0x73,
+	0x5f, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x65, 0x61, 0x12, 0x4c, 0x0a, 0x10, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x76, 0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x56,
+	0x65, 0x6c, 0x6f, 0x63, 0x69, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64,
+	0x65, 0x70, 0x74, 0x68, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x79, 0x64,
+	0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x45, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x69, 0x6e,
-	0x66, 0x6c, 0x6f, 0x77, 0x12, 0x32, 0x0a, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x1a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x66, 0x0a, 0x0d, 0x49, 0x63, 0x65, 0x50,
-	0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x69, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x68, 0x65,
-	0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x68, 0x65,
-	0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x12, 0x39, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79,
-	0x22, 0x28, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x4e, 0x0a, 0x13, 0x41, 0x64,
-	0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
-	0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52,
-	0x09, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x28, 0x0a, 0x16, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65,
-	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x54, 0x0a, 0x1b, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x79, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x74, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x64,
-	0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6c,
-	0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x08, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x22,
-	0x52, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
-	0x6d, 0x42, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23,
-	0x0a, 0x0d, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x43,
-	0x6f, 0x64, 0x65, 0x22, 0x4f, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c,
-	0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
-	0x08, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65,
-	0x72, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x08, 0x74, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67,
-	0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x35, 0x0a, 0x08, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62,
-	0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x08,
-	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54,
-	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x4f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x67,
-	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x64,
-	0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6c,
-	0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73,
-	0x22, 0x29, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x18, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x2a, 0x37, 0x0a, 0x12, 0x49, 0x63, 0x65, 0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e,
-	0x69, 0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x52, 0x55, 0x45, 0x10,
-	0x00, 0x12, 0x07, 0x0a, 0x03, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x55,
-	0x4c, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x03, 0x2a, 0xb3, 0x01, 0x0a, 0x0a, 0x53,
-	0x6e, 0x6f, 0x77, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x55, 0x4c,
-	0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x35, 0x10, 0x01, 0x12,
-	0x10, 0x0a, 0x0c, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x35, 0x5f, 0x54, 0x4f, 0x5f, 0x31, 0x30, 0x10,
-	0x02, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x31, 0x31, 0x5f, 0x54, 0x4f, 0x5f,
-	0x31, 0x35, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x31, 0x36, 0x5f,
-	0x54, 0x4f, 0x5f, 0x32, 0x30, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x52, 0x4f, 0x4d, 0x5f,
-	0x32, 0x31, 0x5f, 0x54, 0x4f, 0x5f, 0x32, 0x35, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x52,
-	0x4f, 0x4d, 0x5f, 0x32, 0x36, 0x5f, 0x54, 0x4f, 0x5f, 0x33, 0x35, 0x10, 0x06, 0x12, 0x11, 0x0a,
-	0x0d, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x33, 0x36, 0x5f, 0x54, 0x4f, 0x5f, 0x35, 0x30, 0x10, 0x07,
-	0x12, 0x11, 0x0a, 0x0d, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x35, 0x31, 0x5f, 0x54, 0x4f, 0x5f, 0x37,
-	0x30, 0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x52, 0x45, 0x5f, 0x37, 0x30, 0x10, 0x09,
-	0x2a, 0x6f, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x50,
-	0x54, 0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x31, 0x10, 0x01,
-	0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x31, 0x5f, 0x54, 0x4f, 0x5f, 0x33, 0x10,
-	0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x33, 0x5f, 0x54, 0x4f, 0x5f, 0x36,
-	0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x36, 0x5f, 0x54, 0x4f, 0x5f,
-	0x31, 0x32, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x52, 0x45, 0x5f, 0x31, 0x32, 0x10,
-	0x05, 0x32, 0xa2, 0x06, 0x0a, 0x16, 0x48, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x42,
-	0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0b,
-	0x50, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x68, 0x79,
-	0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x50, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x68, 0x79, 0x64, 0x72,
-	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x54,
-	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x23, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c,
-	0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6c,
-	0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x68,
-	0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x41,
-	0x64, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67,
-	0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65,
-	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0d, 0x62, 0x75, 0x6c, 0x6c, 0x65, 0x74, 0x69, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x62, 0x75, 0x6c, 0x6c, 0x65, 0x74, 0x69,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x24, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x25, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x64,
+	0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x76,
+	0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x27, 0x0a, 0x0f, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x27, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72,
+	0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x57, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x4b, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x6f, 0x74, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x22, 0x4c,
+	0x0a, 0x11, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x74,
+	0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x6e, 0x6f, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x22, 0x83, 0x01, 0x0a,
+	0x0d, 0x49, 0x63, 0x65, 0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x69, 0x61, 0x12, 0x37,
+	0x0a, 0x08, 0x70, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x2e, 0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x6f, 0x6e, 0x52, 0x08, 0x70,
+	0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x12, 0x39, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x79, 0x22, 0x9b, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f,
+	0x62, 0x6c, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x93, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x6c,
+	0x5f, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x6c, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0xb4, 0x02, 0x0a, 0x0e, 0x54, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x12, 0x37, 0x0a, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x09,
+	0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x79, 0x64, 0x72,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x3a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xfd, 0x01,
+	0x0a, 0x13, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x52, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x28, 0x0a,
+	0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x82, 0x01, 0x0a,
+	0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x42,
+	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x08,
+	0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
 	0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x79, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x2c, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66,
-	0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
-	0x6d, 0x42, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x08, 0x74, 0x65, 0x6c, 0x65, 0x67,
+	0x72, 0x61, 0x6d, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x66, 0x69, 0x6c, 0x6c, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x22, 0x80, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x42, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x6c, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x22, 0x4f, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x08, 0x74, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4c, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52,
+	0x08, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x30, 0x0a, 0x14, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
+	0x64, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x6e,
+	0x6c, 0x79, 0x22, 0x4f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x09, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x73, 0x22, 0x29, 0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54,
+	0x6f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x34,
+	0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x68, 0x65, 0x6e,
+	0x6f, 0x6d, 0x65, 0x6e, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe1, 0x01, 0x0a,
+	0x0e, 0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x2f, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e,
+	0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x6f, 0x6e, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x75, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65,
+	0x45, 0x6e, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x6f,
+	0x6e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x22, 0x56, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x70, 0x68, 0x65,
+	0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x68,
+	0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x50,
+	0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x70,
+	0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x61, 0x22, 0x74, 0x0a, 0x17, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x64, 0x72,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x4c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x3e,
+	0x0a, 0x18, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2d,
+	0x0a, 0x17, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xcb, 0x01,
+	0x0a, 0x0d, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x38, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x22, 0x52, 0x0a, 0x18, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72,
+	0x61, 0x6d, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22,
+	0xd4, 0x02, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x62, 0x61, 0x73, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x61, 0x75, 0x67, 0x65, 0x5f, 0x7a, 0x65, 0x72, 0x6f,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x67, 0x61, 0x75, 0x67, 0x65, 0x5a, 0x65, 0x72,
+	0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x22, 0xed, 0x02, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x10,
+	0x66, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0f, 0x66, 0x61, 0x76, 0x6f, 0x75, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x40, 0x0a, 0x0d, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x61, 0x64, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x44, 0x0a, 0x0f, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72,
+	0x6f, 0x75, 0x73, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x64, 0x61,
+	0x6e, 0x67, 0x65, 0x72, 0x6f, 0x75, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x3f, 0x0a, 0x0d,
+	0x69, 0x63, 0x65, 0x5f, 0x6a, 0x61, 0x6d, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0b, 0x69, 0x63, 0x65, 0x4a, 0x61, 0x6d, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x47, 0x0a,
+	0x11, 0x69, 0x63, 0x65, 0x5f, 0x6a, 0x61, 0x6d, 0x5f, 0x70, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65,
+	0x6e, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x50, 0x68, 0x65, 0x6e, 0x6f,
+	0x6d, 0x65, 0x6e, 0x6f, 0x6e, 0x52, 0x0f, 0x69, 0x63, 0x65, 0x4a, 0x61, 0x6d, 0x50, 0x68, 0x65,
+	0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x61, 0x22, 0x47, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68,
+	0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x30, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64,
+	0x65, 0x22, 0x36, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x4c, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4a, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x32, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x45, 0x0a, 0x0f, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xe1, 0x03, 0x0a, 0x05, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a,
+	0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x74,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x73, 0x5f, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x6f, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x44, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x6f, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x61,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x64, 0x42, 0x79, 0x22, 0x52, 0x0a, 0x17, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x63, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x42, 0x79, 0x22, 0x48, 0x0a, 0x18, 0x41, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x05, 0x61,
+	0x6c, 0x65, 0x72, 0x74, 0x2a, 0x7a, 0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49,
+	0x54, 0x59, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x56,
+	0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x46, 0x41, 0x56, 0x4f, 0x55, 0x52, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x41,
+	0x44, 0x56, 0x45, 0x52, 0x53, 0x45, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x45, 0x56, 0x45,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x44, 0x41, 0x4e, 0x47, 0x45, 0x52, 0x4f, 0x55, 0x53, 0x10, 0x04,
+	0x2a, 0xa4, 0x0b, 0x0a, 0x0a, 0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x16, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x50,
+	0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x47, 0x52, 0x45, 0x41, 0x53, 0x45,
+	0x5f, 0x49, 0x43, 0x45, 0x10, 0x0b, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d,
+	0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x53, 0x4c, 0x55, 0x53, 0x48, 0x10, 0x0c, 0x12, 0x21, 0x0a, 0x1d,
+	0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49,
+	0x41, 0x4c, 0x5f, 0x42, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x43, 0x45, 0x10, 0x0d, 0x12,
+	0x21, 0x0a, 0x1d, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x44, 0x52,
+	0x49, 0x46, 0x54, 0x45, 0x44, 0x5f, 0x42, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x43, 0x45,
+	0x10, 0x0e, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e,
+	0x5f, 0x42, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x43, 0x45, 0x10, 0x0f, 0x12, 0x19, 0x0a,
+	0x15, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x41, 0x5a,
+	0x49, 0x4c, 0x5f, 0x52, 0x55, 0x4e, 0x10, 0x10, 0x12, 0x28, 0x0a, 0x24, 0x50, 0x48, 0x45, 0x4e,
+	0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x41, 0x5a, 0x49, 0x4c, 0x5f, 0x52, 0x55,
+	0x4e, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x56, 0x45, 0x52,
+	0x10, 0x11, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e,
+	0x5f, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x10, 0x12, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x48,
+	0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x49, 0x43, 0x45, 0x5f, 0x52, 0x55, 0x4e,
+	0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x10,
+	0x13, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f,
+	0x41, 0x4e, 0x43, 0x48, 0x4f, 0x52, 0x5f, 0x49, 0x43, 0x45, 0x10, 0x14, 0x12, 0x27, 0x0a, 0x23,
+	0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x41, 0x4e, 0x43, 0x48, 0x4f,
+	0x52, 0x5f, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x53, 0x10, 0x15, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45,
+	0x4e, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x49, 0x43, 0x45,
+	0x10, 0x16, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e,
+	0x5f, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f,
+	0x50, 0x4f, 0x4c, 0x59, 0x4e, 0x59, 0x41, 0x53, 0x10, 0x17, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x48,
+	0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x41, 0x4c,
+	0x5f, 0x46, 0x52, 0x45, 0x45, 0x5a, 0x45, 0x5f, 0x55, 0x50, 0x10, 0x18, 0x12, 0x1b, 0x0a, 0x17,
+	0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x49, 0x43, 0x45, 0x5f, 0x48,
+	0x55, 0x4d, 0x4d, 0x4f, 0x43, 0x4b, 0x53, 0x10, 0x19, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x48, 0x45,
+	0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x49, 0x43, 0x45, 0x5f, 0x42, 0x52, 0x49, 0x44,
+	0x47, 0x45, 0x10, 0x1a, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e,
+	0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5a, 0x45, 0x5f, 0x55, 0x50, 0x10, 0x1b, 0x12, 0x1b,
+	0x0a, 0x17, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x57, 0x41, 0x54,
+	0x45, 0x52, 0x5f, 0x4f, 0x4e, 0x5f, 0x49, 0x43, 0x45, 0x10, 0x1c, 0x12, 0x1b, 0x0a, 0x17, 0x50,
+	0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x44, 0x41, 0x52, 0x4b, 0x45, 0x4e,
+	0x45, 0x44, 0x5f, 0x49, 0x43, 0x45, 0x10, 0x1d, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x48, 0x45, 0x4e,
+	0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x53, 0x48, 0x4f, 0x52, 0x45, 0x5f, 0x4c, 0x45, 0x41,
+	0x44, 0x53, 0x10, 0x1e, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e,
+	0x4f, 0x4e, 0x5f, 0x49, 0x43, 0x45, 0x5f, 0x4c, 0x49, 0x46, 0x54, 0x45, 0x44, 0x10, 0x1f, 0x12,
+	0x18, 0x0a, 0x14, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x49, 0x43,
+	0x45, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x10, 0x20, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x48, 0x45,
+	0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x4c, 0x45, 0x41,
+	0x44, 0x53, 0x10, 0x21, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e,
+	0x4f, 0x4e, 0x5f, 0x49, 0x43, 0x45, 0x5f, 0x4d, 0x45, 0x4c, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x49,
+	0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x22, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x48, 0x45,
+	0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x55, 0x41, 0x4c,
+	0x5f, 0x42, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x43, 0x45, 0x10, 0x23, 0x12, 0x21, 0x0a,
+	0x1d, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x49, 0x43, 0x45, 0x5f,
+	0x50, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x4e, 0x4b, 0x53, 0x10, 0x24,
+	0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x49,
+	0x43, 0x45, 0x5f, 0x4a, 0x41, 0x4d, 0x5f, 0x55, 0x50, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10,
+	0x25, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f,
+	0x49, 0x43, 0x45, 0x5f, 0x4a, 0x41, 0x4d, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x53, 0x54, 0x52, 0x45,
+	0x41, 0x4d, 0x10, 0x26, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e,
+	0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x41, 0x5a, 0x49, 0x4c, 0x5f, 0x4a, 0x41, 0x4d, 0x5f, 0x55, 0x50,
+	0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0x27, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x48, 0x45, 0x4e,
+	0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x41, 0x5a, 0x49, 0x4c, 0x5f, 0x4a, 0x41,
+	0x4d, 0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x10, 0x28, 0x12, 0x1f,
+	0x0a, 0x1b, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x49, 0x43, 0x45,
+	0x5f, 0x4a, 0x41, 0x4d, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x5f, 0x55, 0x50, 0x10, 0x29, 0x12,
+	0x15, 0x0a, 0x11, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x41, 0x55,
+	0x46, 0x45, 0x49, 0x53, 0x10, 0x2a, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d,
+	0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x41, 0x55, 0x46, 0x45, 0x49, 0x53, 0x5f, 0x57, 0x41, 0x54, 0x45,
+	0x52, 0x10, 0x2b, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f,
+	0x4e, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x5f, 0x54, 0x4f, 0x5f, 0x42, 0x4f, 0x54, 0x54,
+	0x4f, 0x4d, 0x10, 0x2c, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e,
+	0x4f, 0x4e, 0x5f, 0x44, 0x52, 0x49, 0x45, 0x44, 0x5f, 0x55, 0x50, 0x10, 0x2d, 0x12, 0x19, 0x0a,
+	0x15, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x57, 0x49, 0x4e, 0x44,
+	0x5f, 0x53, 0x45, 0x54, 0x55, 0x50, 0x10, 0x2e, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x48, 0x45, 0x4e,
+	0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x54, 0x44,
+	0x4f, 0x57, 0x4e, 0x10, 0x2f, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45,
+	0x4e, 0x4f, 0x4e, 0x5f, 0x57, 0x41, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53,
+	0x45, 0x10, 0x30, 0x12, 0x29, 0x0a, 0x25, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f,
+	0x4e, 0x5f, 0x41, 0x51, 0x55, 0x41, 0x54, 0x49, 0x43, 0x5f, 0x56, 0x45, 0x47, 0x45, 0x54, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x54, 0x5f, 0x42, 0x41, 0x4e, 0x4b, 0x10, 0x31, 0x12, 0x20,
+	0x0a, 0x1c, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x4f, 0x56, 0x45,
+	0x52, 0x47, 0x52, 0x4f, 0x57, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x32,
+	0x12, 0x1c, 0x0a, 0x18, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x44,
+	0x52, 0x49, 0x46, 0x54, 0x57, 0x4f, 0x4f, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x10, 0x33, 0x12, 0x16,
+	0x0a, 0x12, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x47,
+	0x5f, 0x4a, 0x41, 0x4d, 0x10, 0x34, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d,
+	0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x52, 0x41, 0x46, 0x54,
+	0x49, 0x4e, 0x47, 0x10, 0x35, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45,
+	0x4e, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x4e, 0x4b, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x41, 0x50, 0x53,
+	0x45, 0x10, 0x36, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f,
+	0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x44, 0x45, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x37, 0x2a, 0x96, 0x02, 0x0a, 0x12, 0x50, 0x68, 0x65, 0x6e,
+	0x6f, 0x6d, 0x65, 0x6e, 0x6f, 0x6e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x23,
+	0x0a, 0x1f, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x54,
+	0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f,
+	0x4e, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x49, 0x43, 0x45, 0x10, 0x01,
+	0x12, 0x1b, 0x0a, 0x17, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x43,
+	0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4a, 0x41, 0x4d, 0x10, 0x02, 0x12, 0x1d, 0x0a,
+	0x19, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x54, 0x45,
+	0x47, 0x4f, 0x52, 0x59, 0x5f, 0x49, 0x43, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19,
+	0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47,
+	0x4f, 0x52, 0x59, 0x5f, 0x57, 0x41, 0x54, 0x45, 0x52, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x50,
+	0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f,
+	0x52, 0x59, 0x5f, 0x56, 0x45, 0x47, 0x45, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12,
+	0x1e, 0x0a, 0x1a, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x43, 0x41,
+	0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x44, 0x45, 0x42, 0x52, 0x49, 0x53, 0x10, 0x06, 0x12,
+	0x1f, 0x0a, 0x1b, 0x50, 0x48, 0x45, 0x4e, 0x4f, 0x4d, 0x45, 0x4e, 0x4f, 0x4e, 0x5f, 0x43, 0x41,
+	0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x07,
+	0x2a, 0x37, 0x0a, 0x12, 0x49, 0x63, 0x65, 0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x69,
+	0x61, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x52, 0x55, 0x45, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x55, 0x4c,
+	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x03, 0x2a, 0xb3, 0x01, 0x0a, 0x0a, 0x53, 0x6e,
+	0x6f, 0x77, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x55, 0x4c, 0x4c,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x35, 0x10, 0x01, 0x12, 0x10,
+	0x0a, 0x0c, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x35, 0x5f, 0x54, 0x4f, 0x5f, 0x31, 0x30, 0x10, 0x02,
+	0x12, 0x11, 0x0a, 0x0d, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x31, 0x31, 0x5f, 0x54, 0x4f, 0x5f, 0x31,
+	0x35, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x31, 0x36, 0x5f, 0x54,
+	0x4f, 0x5f, 0x32, 0x30, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x32,
+	0x31, 0x5f, 0x54, 0x4f, 0x5f, 0x32, 0x35, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x52, 0x4f,
+	0x4d, 0x5f, 0x32, 0x36, 0x5f, 0x54, 0x4f, 0x5f, 0x33, 0x35, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d,
+	0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x33, 0x36, 0x5f, 0x54, 0x4f, 0x5f, 0x35, 0x30, 0x10, 0x07, 0x12,
+	0x11, 0x0a, 0x0d, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x35, 0x31, 0x5f, 0x54, 0x4f, 0x5f, 0x37, 0x30,
+	0x10, 0x08, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x52, 0x45, 0x5f, 0x37, 0x30, 0x10, 0x09, 0x2a,
+	0x6f, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x50, 0x54,
+	0x59, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x31, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x31, 0x5f, 0x54, 0x4f, 0x5f, 0x33, 0x10, 0x02,
+	0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x33, 0x5f, 0x54, 0x4f, 0x5f, 0x36, 0x10,
+	0x03, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x36, 0x5f, 0x54, 0x4f, 0x5f, 0x31,
+	0x32, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x52, 0x45, 0x5f, 0x31, 0x32, 0x10, 0x05,
+	0x2a, 0x46, 0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x14,
+	0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x41, 0x4e, 0x47, 0x55, 0x41,
+	0x47, 0x45, 0x5f, 0x52, 0x55, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4c, 0x41, 0x4e, 0x47, 0x55,
+	0x41, 0x47, 0x45, 0x5f, 0x45, 0x4e, 0x10, 0x02, 0x32, 0xbd, 0x09, 0x0a, 0x16, 0x48, 0x79, 0x64,
+	0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x1c, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x58, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x23,
 	0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x2c, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65,
-	0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
-	0x42, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x2e, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x2e, 0x68,
+	0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x42, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d,
+	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x42, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f,
+	0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x23, 0x2e, 0x68,
+	0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
 	0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c,
-	0x65, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x23, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67,
-	0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67,
-	0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x68, 0x79, 0x64,
-	0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73,
-	0x12, 0x24, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66,
-	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f,
-	0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65,
-	0x67, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
-	0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x47, 0x65, 0x74, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x54, 0x6f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x28, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f,
+	0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a,
+	0x0d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x61, 0x12, 0x25,
+	0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x68, 0x65, 0x6e, 0x6f, 0x6d, 0x65, 0x6e, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x68, 0x65, 0x6e,
+	0x6f, 0x6d, 0x65, 0x6e, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
+	0x10, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
 	0x6d, 0x12, 0x28, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66,
-	0x66, 0x65, 0x72, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x79,
-	0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x49, 0x41, 0x6d, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x48, 0x6f,
-	0x6b, 0x61, 0x67, 0x65, 0x2f, 0x48, 0x4c, 0x2d, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x79,
+	0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x10, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x69,
+	0x7a, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x28, 0x2e, 0x68, 0x79, 0x64,
+	0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x69, 0x7a, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x65, 0x54,
+	0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x67, 0x0a, 0x10, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x12, 0x28, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e,
+	0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd7, 0x03, 0x0a, 0x16, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x22, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67,
+	0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x68, 0x79, 0x64, 0x72,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x68, 0x79,
+	0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x68, 0x79, 0x64, 0x72,
+	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x68, 0x79, 0x64, 0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x68, 0x79, 0x64,
+	0x72, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x49, 0x41, 0x6d, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x48, 0x6f, 0x6b, 0x61, 0x67, 0x65,
+	0x2f, 0x48, 0x4c, 0x2d, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1459,82 +4130,169 @@ func file_internal_proto_hydrology_buffer_service_proto_rawDescGZIP() []byte {
 	return file_internal_proto_hydrology_buffer_service_proto_rawDescData
 }
 
-var file_internal_proto_hydrology_buffer_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_internal_proto_hydrology_buffer_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_internal_proto_hydrology_buffer_service_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_internal_proto_hydrology_buffer_service_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_internal_proto_hydrology_buffer_service_proto_goTypes = []interface{}{
-	(IcePhenomeniaState)(0),             // 0: hydrologybuffer.IcePhenomeniaState
-	(SnowHeight)(0),                     // 1: hydrologybuffer.SnowHeight
-	(PrecipitationDuration)(0),          // 2: hydrologybuffer.PrecipitationDuration
-	(*PingRequest)(nil),                 // 3: hydrologybuffer.PingRequest
-	(*PingResponse)(nil),                // 4: hydrologybuffer.PingResponse
-	(*Telegram)(nil),                    // 5: hydrologybuffer.Telegram
-	(*IcePhenomenia)(nil),               // 6: hydrologybuffer.IcePhenomenia
-	(*AddTelegramRequest)(nil),          // 7: hydrologybuffer.AddTelegramRequest
-	(*AddTelegramResponse)(nil),         // 8: hydrologybuffer.AddTelegramResponse
-	(*RemoveTelegramsRequest)(nil),      // 9: hydrologybuffer.RemoveTelegramsRequest
-	(*RemoveTelegramsResponse)(nil),     // 10: hydrologybuffer.RemoveTelegramsResponse
-	(*UpdateTelegramByInfoRequest)(nil), // 11: hydrologybuffer.UpdateTelegramByInfoRequest
-	(*UpdateTelegramByCodeRequest)(nil), // 12: hydrologybuffer.UpdateTelegramByCodeRequest
-	(*UpdateTelegramResponse)(nil),      // 13: hydrologybuffer.UpdateTelegramResponse
-	(*GetTelegramRequest)(nil),          // 14: hydrologybuffer.GetTelegramRequest
-	(*GetTelegramResponse)(nil),         // 15: hydrologybuffer.GetTelegramResponse
-	(*GetTelegramsRequest)(nil),         // 16: hydrologybuffer.GetTelegramsRequest
-	(*GetTelegramsResponse)(nil),        // 17: hydrologybuffer.GetTelegramsResponse
-	(*TransferToSystemRequest)(nil),     // 18: hydrologybuffer.TransferToSystemRequest
-	(*TransferToSystemResponse)(nil),    // 19: hydrologybuffer.TransferToSystemResponse
-	(*timestamppb.Timestamp)(nil),       // 20: google.protobuf.Timestamp
-	(*wrapperspb.Int32Value)(nil),       // 21: google.protobuf.Int32Value
-	(*wrapperspb.DoubleValue)(nil),      // 22: google.protobuf.DoubleValue
+	(Severity)(0),                       // 0: hydrologybuffer.Severity
+	(Phenomenon)(0),                     // 1: hydrologybuffer.Phenomenon
+	(PhenomenonCategory)(0),             // 2: hydrologybuffer.PhenomenonCategory
+	(IcePhenomeniaState)(0),             // 3: hydrologybuffer.IcePhenomeniaState
+	(SnowHeight)(0),                     // 4: hydrologybuffer.SnowHeight
+	(PrecipitationDuration)(0),          // 5: hydrologybuffer.PrecipitationDuration
+	(Language)(0),                       // 6: hydrologybuffer.Language
+	(*PingRequest)(nil),                 // 7: hydrologybuffer.PingRequest
+	(*PingResponse)(nil),                // 8: hydrologybuffer.PingResponse
+	(*Telegram)(nil),                    // 9: hydrologybuffer.Telegram
+	(*ValidationWarning)(nil),           // 10: hydrologybuffer.ValidationWarning
+	(*Int32Measurement)(nil),            // 11: hydrologybuffer.Int32Measurement
+	(*DoubleMeasurement)(nil),           // 12: hydrologybuffer.DoubleMeasurement
+	(*IcePhenomenia)(nil),               // 13: hydrologybuffer.IcePhenomenia
+	(*DecodeProblem)(nil),               // 14: hydrologybuffer.DecodeProblem
+	(*AddTelegramRequest)(nil),          // 15: hydrologybuffer.AddTelegramRequest
+	(*TelegramResult)(nil),              // 16: hydrologybuffer.TelegramResult
+	(*AddTelegramResponse)(nil),         // 17: hydrologybuffer.AddTelegramResponse
+	(*RemoveTelegramsRequest)(nil),      // 18: hydrologybuffer.RemoveTelegramsRequest
+	(*RemoveTelegramsResponse)(nil),     // 19: hydrologybuffer.RemoveTelegramsResponse
+	(*UpdateTelegramByInfoRequest)(nil), // 20: hydrologybuffer.UpdateTelegramByInfoRequest
+	(*UpdateTelegramByCodeRequest)(nil), // 21: hydrologybuffer.UpdateTelegramByCodeRequest
+	(*UpdateTelegramResponse)(nil),      // 22: hydrologybuffer.UpdateTelegramResponse
+	(*GetTelegramRequest)(nil),          // 23: hydrologybuffer.GetTelegramRequest
+	(*GetTelegramResponse)(nil),         // 24: hydrologybuffer.GetTelegramResponse
+	(*GetTelegramsRequest)(nil),         // 25: hydrologybuffer.GetTelegramsRequest
+	(*GetTelegramsResponse)(nil),        // 26: hydrologybuffer.GetTelegramsResponse
+	(*TransferToSystemRequest)(nil),     // 27: hydrologybuffer.TransferToSystemRequest
+	(*TransferToSystemResponse)(nil),    // 28: hydrologybuffer.TransferToSystemResponse
+	(*ListPhenomenaRequest)(nil),        // 29: hydrologybuffer.ListPhenomenaRequest
+	(*PhenomenonInfo)(nil),              // 30: hydrologybuffer.PhenomenonInfo
+	(*ListPhenomenaResponse)(nil),       // 31: hydrologybuffer.ListPhenomenaResponse
+	(*DescribeTelegramRequest)(nil),     // 32: hydrologybuffer.DescribeTelegramRequest
+	(*DescribeTelegramResponse)(nil),    // 33: hydrologybuffer.DescribeTelegramResponse
+	(*TokenizeTelegramRequest)(nil),     // 34: hydrologybuffer.TokenizeTelegramRequest
+	(*TelegramToken)(nil),               // 35: hydrologybuffer.TelegramToken
+	(*TokenizeTelegramResponse)(nil),    // 36: hydrologybuffer.TokenizeTelegramResponse
+	(*Station)(nil),                     // 37: hydrologybuffer.Station
+	(*StationThresholds)(nil),           // 38: hydrologybuffer.StationThresholds
+	(*AddStationRequest)(nil),           // 39: hydrologybuffer.AddStationRequest
+	(*GetStationRequest)(nil),           // 40: hydrologybuffer.GetStationRequest
+	(*ListStationsRequest)(nil),         // 41: hydrologybuffer.ListStationsRequest
+	(*ListStationsResponse)(nil),        // 42: hydrologybuffer.ListStationsResponse
+	(*UpdateStationRequest)(nil),        // 43: hydrologybuffer.UpdateStationRequest
+	(*RemoveStationRequest)(nil),        // 44: hydrologybuffer.RemoveStationRequest
+	(*RemoveStationResponse)(nil),       // 45: hydrologybuffer.RemoveStationResponse
+	(*StationResponse)(nil),             // 46: hydrologybuffer.StationResponse
+	(*Alert)(nil),                       // 47: hydrologybuffer.Alert
+	(*AcknowledgeAlertRequest)(nil),     // 48: hydrologybuffer.AcknowledgeAlertRequest
+	(*AcknowledgeAlertResponse)(nil),    // 49: hydrologybuffer.AcknowledgeAlertResponse
+	(*timestamppb.Timestamp)(nil),       // 50: google.protobuf.Timestamp
+	(*wrapperspb.Int32Value)(nil),       // 51: google.protobuf.Int32Value
+	(*wrapperspb.StringValue)(nil),      // 52: google.protobuf.StringValue
 }
 var file_internal_proto_hydrology_buffer_service_proto_depIdxs = []int32{
-	20, // 0: hydrologybuffer.Telegram.datetime:type_name -> google.protobuf.Timestamp
-	21, // 1: hydrologybuffer.Telegram.water_level_on_time:type_name -> google.protobuf.Int32Value
-	21, // 2: hydrologybuffer.Telegram.delta_water_level:type_name -> google.protobuf.Int32Value
-	21, // 3: hydrologybuffer.Telegram.water_level_on20h:type_name -> google.protobuf.Int32Value
-	22, // 4: hydrologybuffer.Telegram.water_temperature:type_name -> google.protobuf.DoubleValue
-	21, // 5: hydrologybuffer.Telegram.air_temperature:type_name -> google.protobuf.Int32Value
-	21, // 6: hydrologybuffer.Telegram.ice_phenomenia_state:type_name -> google.protobuf.Int32Value
-	6,  // 7: hydrologybuffer.Telegram.ice_phenomenias:type_name -> hydrologybuffer.IcePhenomenia
-	21, // 8: hydrologybuffer.Telegram.ice_height:type_name -> google.protobuf.Int32Value
-	21, // 9: hydrologybuffer.Telegram.snow_height:type_name -> google.protobuf.Int32Value
-	22, // 10: hydrologybuffer.Telegram.water_flow:type_name -> google.protobuf.DoubleValue
-	22, // 11: hydrologybuffer.Telegram.precipitation_value:type_name -> google.protobuf.DoubleValue
-	21, // 12: hydrologybuffer.Telegram.precipitation_duration:type_name -> google.protobuf.Int32Value
-	20, // 13: hydrologybuffer.Telegram.reservoir_date:type_name -> google.protobuf.Timestamp
-	21, // 14: hydrologybuffer.Telegram.headwater_level:type_name -> google.protobuf.Int32Value
-	21, // 15: hydrologybuffer.Telegram.average_reservoir_level:type_name -> google.protobuf.Int32Value
-	21, // 16: hydrologybuffer.Telegram.downstream_level:type_name -> google.protobuf.Int32Value
-	22, // 17: hydrologybuffer.Telegram.reservoir_volume:type_name -> google.protobuf.DoubleValue
-	20, // 18: hydrologybuffer.Telegram.reservoir_water_inflow_date:type_name -> google.protobuf.Timestamp
-	22, // 19: hydrologybuffer.Telegram.inflow:type_name -> google.protobuf.DoubleValue
-	22, // 20: hydrologybuffer.Telegram.reset:type_name -> google.protobuf.DoubleValue
-	21, // 21: hydrologybuffer.IcePhenomenia.intensity:type_name -> google.protobuf.Int32Value
-	5,  // 22: hydrologybuffer.AddTelegramResponse.telegrams:type_name -> hydrologybuffer.Telegram
-	5,  // 23: hydrologybuffer.UpdateTelegramByInfoRequest.telegram:type_name -> hydrologybuffer.Telegram
-	5,  // 24: hydrologybuffer.UpdateTelegramResponse.telegram:type_name -> hydrologybuffer.Telegram
-	5,  // 25: hydrologybuffer.GetTelegramResponse.Telegram:type_name -> hydrologybuffer.Telegram
-	5,  // 26: hydrologybuffer.GetTelegramsResponse.telegrams:type_name -> hydrologybuffer.Telegram
-	3,  // 27: hydrologybuffer.HydrologyBufferService.PingService:input_type -> hydrologybuffer.PingRequest
-	7,  // 28: hydrologybuffer.HydrologyBufferService.AddTelegram:input_type -> hydrologybuffer.AddTelegramRequest
-	9,  // 29: hydrologybuffer.HydrologyBufferService.RemoveTelegrams:input_type -> hydrologybuffer.RemoveTelegramsRequest
-	11, // 30: hydrologybuffer.HydrologyBufferService.UpdateTelegramByInfo:input_type -> hydrologybuffer.UpdateTelegramByInfoRequest
-	12, // 31: hydrologybuffer.HydrologyBufferService.UpdateTelegramByCode:input_type -> hydrologybuffer.UpdateTelegramByCodeRequest
-	14, // 32: hydrologybuffer.HydrologyBufferService.GetTelegram:input_type -> hydrologybuffer.GetTelegramRequest
-	16, // 33: hydrologybuffer.HydrologyBufferService.GetTelegrams:input_type -> hydrologybuffer.GetTelegramsRequest
-	18, // 34: hydrologybuffer.HydrologyBufferService.TransferToSystem:input_type -> hydrologybuffer.TransferToSystemRequest
-	4,  // 35: hydrologybuffer.HydrologyBufferService.PingService:output_type -> hydrologybuffer.PingResponse
-	8,  // 36: hydrologybuffer.HydrologyBufferService.AddTelegram:output_type -> hydrologybuffer.AddTelegramResponse
-	10, // 37: hydrologybuffer.HydrologyBufferService.RemoveTelegrams:output_type -> hydrologybuffer.RemoveTelegramsResponse
-	13, // 38: hydrologybuffer.HydrologyBufferService.UpdateTelegramByInfo:output_type -> hydrologybuffer.UpdateTelegramResponse
-	13, // 39: hydrologybuffer.HydrologyBufferService.UpdateTelegramByCode:output_type -> hydrologybuffer.UpdateTelegramResponse
-	15, // 40: hydrologybuffer.HydrologyBufferService.GetTelegram:output_type -> hydrologybuffer.GetTelegramResponse
-	17, // 41: hydrologybuffer.HydrologyBufferService.GetTelegrams:output_type -> hydrologybuffer.GetTelegramsResponse
-	19, // 42: hydrologybuffer.HydrologyBufferService.TransferToSystem:output_type -> hydrologybuffer.TransferToSystemResponse
-	35, // [35:43] is the sub-list for method output_type
-	27, // [27:35] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	50, // 0: hydrologybuffer.Telegram.datetime:type_name -> google.protobuf.Timestamp
+	11, // 1: hydrologybuffer.Telegram.water_level_on_time:type_name -> hydrologybuffer.Int32Measurement
+	11, // 2: hydrologybuffer.Telegram.delta_water_level:type_name -> hydrologybuffer.Int32Measurement
+	11, // 3: hydrologybuffer.Telegram.water_level_on20h:type_name -> hydrologybuffer.Int32Measurement
+	12, // 4: hydrologybuffer.Telegram.water_temperature:type_name -> hydrologybuffer.DoubleMeasurement
+	11, // 5: hydrologybuffer.Telegram.air_temperature:type_name -> hydrologybuffer.Int32Measurement
+	51, // 6: hydrologybuffer.Telegram.ice_phenomenia_state:type_name -> google.protobuf.Int32Value
+	13, // 7: hydrologybuffer.Telegram.ice_phenomenias:type_name -> hydrologybuffer.IcePhenomenia
+	11, // 8: hydrologybuffer.Telegram.ice_height:type_name -> hydrologybuffer.Int32Measurement
+	11, // 9: hydrologybuffer.Telegram.snow_height:type_name -> hydrologybuffer.Int32Measurement
+	12, // 10: hydrologybuffer.Telegram.water_flow:type_name -> hydrologybuffer.DoubleMeasurement
+	12, // 11: hydrologybuffer.Telegram.precipitation_value:type_name -> hydrologybuffer.DoubleMeasurement
+	11, // 12: hydrologybuffer.Telegram.precipitation_duration:type_name -> hydrologybuffer.Int32Measurement
+	50, // 13: hydrologybuffer.Telegram.reservoir_date:type_name -> google.protobuf.Timestamp
+	11, // 14: hydrologybuffer.Telegram.headwater_level:type_name -> hydrologybuffer.Int32Measurement
+	11, // 15: hydrologybuffer.Telegram.average_reservoir_level:type_name -> hydrologybuffer.Int32Measurement
+	11, // 16: hydrologybuffer.Telegram.downstream_level:type_name -> hydrologybuffer.Int32Measurement
+	12, // 17: hydrologybuffer.Telegram.reservoir_volume:type_name -> hydrologybuffer.DoubleMeasurement
+	50, // 18: hydrologybuffer.Telegram.reservoir_water_inflow_date:type_name -> google.protobuf.Timestamp
+	12, // 19: hydrologybuffer.Telegram.inflow:type_name -> hydrologybuffer.DoubleMeasurement
+	12, // 20: hydrologybuffer.Telegram.reset:type_name -> hydrologybuffer.DoubleMeasurement
+	51, // 21: hydrologybuffer.Telegram.measured_discharge_month:type_name -> google.protobuf.Int32Value
+	11, // 22: hydrologybuffer.Telegram.measured_water_level:type_name -> hydrologybuffer.Int32Measurement
+	12, // 23: hydrologybuffer.Telegram.measured_discharge:type_name -> hydrologybuffer.DoubleMeasurement
+	12, // 24: hydrologybuffer.Telegram.cross_section_area:type_name -> hydrologybuffer.DoubleMeasurement
+	11, // 25: hydrologybuffer.Telegram.average_velocity:type_name -> hydrologybuffer.Int32Measurement
+	11, // 26: hydrologybuffer.Telegram.max_depth:type_name -> hydrologybuffer.Int32Measurement
+	50, // 27: hydrologybuffer.Telegram.measurement_time:type_name -> google.protobuf.Timestamp
+	52, // 28: hydrologybuffer.Telegram.sender:type_name -> google.protobuf.StringValue
+	50, // 29: hydrologybuffer.Telegram.bulletin_time:type_name -> google.protobuf.Timestamp
+	10, // 30: hydrologybuffer.Telegram.warnings:type_name -> hydrologybuffer.ValidationWarning
+	0,  // 31: hydrologybuffer.Telegram.severity:type_name -> hydrologybuffer.Severity
+	1,  // 32: hydrologybuffer.IcePhenomenia.phenomen:type_name -> hydrologybuffer.Phenomenon
+	51, // 33: hydrologybuffer.IcePhenomenia.intensity:type_name -> google.protobuf.Int32Value
+	9,  // 34: hydrologybuffer.TelegramResult.telegrams:type_name -> hydrologybuffer.Telegram
+	14, // 35: hydrologybuffer.TelegramResult.errors:type_name -> hydrologybuffer.DecodeProblem
+	14, // 36: hydrologybuffer.TelegramResult.warnings:type_name -> hydrologybuffer.DecodeProblem
+	9,  // 37: hydrologybuffer.AddTelegramResponse.telegrams:type_name -> hydrologybuffer.Telegram
+	14, // 38: hydrologybuffer.AddTelegramResponse.errors:type_name -> hydrologybuffer.DecodeProblem
+	14, // 39: hydrologybuffer.AddTelegramResponse.warnings:type_name -> hydrologybuffer.DecodeProblem
+	16, // 40: hydrologybuffer.AddTelegramResponse.results:type_name -> hydrologybuffer.TelegramResult
+	9,  // 41: hydrologybuffer.UpdateTelegramByInfoRequest.telegram:type_name -> hydrologybuffer.Telegram
+	9,  // 42: hydrologybuffer.UpdateTelegramResponse.telegram:type_name -> hydrologybuffer.Telegram
+	9,  // 43: hydrologybuffer.GetTelegramResponse.Telegram:type_name -> hydrologybuffer.Telegram
+	9,  // 44: hydrologybuffer.GetTelegramsResponse.telegrams:type_name -> hydrologybuffer.Telegram
+	1,  // 45: hydrologybuffer.PhenomenonInfo.code:type_name -> hydrologybuffer.Phenomenon
+	2,  // 46: hydrologybuffer.PhenomenonInfo.category:type_name -> hydrologybuffer.PhenomenonCategory
+	30, // 47: hydrologybuffer.ListPhenomenaResponse.phenomena:type_name -> hydrologybuffer.PhenomenonInfo
+	6,  // 48: hydrologybuffer.DescribeTelegramRequest.language:type_name -> hydrologybuffer.Language
+	14, // 49: hydrologybuffer.TelegramToken.problem:type_name -> hydrologybuffer.DecodeProblem
+	35, // 50: hydrologybuffer.TokenizeTelegramResponse.tokens:type_name -> hydrologybuffer.TelegramToken
+	38, // 51: hydrologybuffer.Station.thresholds:type_name -> hydrologybuffer.StationThresholds
+	51, // 52: hydrologybuffer.StationThresholds.favourable_level:type_name -> google.protobuf.Int32Value
+	51, // 53: hydrologybuffer.StationThresholds.adverse_level:type_name -> google.protobuf.Int32Value
+	51, // 54: hydrologybuffer.StationThresholds.dangerous_level:type_name -> google.protobuf.Int32Value
+	51, // 55: hydrologybuffer.StationThresholds.ice_jam_level:type_name -> google.protobuf.Int32Value
+	1,  // 56: hydrologybuffer.StationThresholds.ice_jam_phenomena:type_name -> hydrologybuffer.Phenomenon
+	37, // 57: hydrologybuffer.AddStationRequest.station:type_name -> hydrologybuffer.Station
+	37, // 58: hydrologybuffer.ListStationsResponse.stations:type_name -> hydrologybuffer.Station
+	37, // 59: hydrologybuffer.UpdateStationRequest.station:type_name -> hydrologybuffer.Station
+	37, // 60: hydrologybuffer.StationResponse.station:type_name -> hydrologybuffer.Station
+	50, // 61: hydrologybuffer.Alert.datetime:type_name -> google.protobuf.Timestamp
+	0,  // 62: hydrologybuffer.Alert.severity:type_name -> hydrologybuffer.Severity
+	50, // 63: hydrologybuffer.Alert.created_at:type_name -> google.protobuf.Timestamp
+	50, // 64: hydrologybuffer.Alert.acknowledged_at:type_name -> google.protobuf.Timestamp
+	47, // 65: hydrologybuffer.AcknowledgeAlertResponse.alert:type_name -> hydrologybuffer.Alert
+	7,  // 66: hydrologybuffer.HydrologyBufferService.PingService:input_type -> hydrologybuffer.PingRequest
+	15, // 67: hydrologybuffer.HydrologyBufferService.AddTelegram:input_type -> hydrologybuffer.AddTelegramRequest
+	18, // 68: hydrologybuffer.HydrologyBufferService.RemoveTelegrams:input_type -> hydrologybuffer.RemoveTelegramsRequest
+	20, // 69: hydrologybuffer.HydrologyBufferService.UpdateTelegramByInfo:input_type -> hydrologybuffer.UpdateTelegramByInfoRequest
+	21, // 70: hydrologybuffer.HydrologyBufferService.UpdateTelegramByCode:input_type -> hydrologybuffer.UpdateTelegramByCodeRequest
+	23, // 71: hydrologybuffer.HydrologyBufferService.GetTelegram:input_type -> hydrologybuffer.GetTelegramRequest
+	25, // 72: hydrologybuffer.HydrologyBufferService.GetTelegrams:input_type -> hydrologybuffer.GetTelegramsRequest
+	27, // 73: hydrologybuffer.HydrologyBufferService.TransferToSystem:input_type -> hydrologybuffer.TransferToSystemRequest
+	29, // 74: hydrologybuffer.HydrologyBufferService.ListPhenomena:input_type -> hydrologybuffer.ListPhenomenaRequest
+	32, // 75: hydrologybuffer.HydrologyBufferService.DescribeTelegram:input_type -> hydrologybuffer.DescribeTelegramRequest
+	34, // 76: hydrologybuffer.HydrologyBufferService.TokenizeTelegram:input_type -> hydrologybuffer.TokenizeTelegramRequest
+	48, // 77: hydrologybuffer.HydrologyBufferService.AcknowledgeAlert:input_type -> hydrologybuffer.AcknowledgeAlertRequest
+	39, // 78: hydrologybuffer.StationRegistryService.AddStation:input_type -> hydrologybuffer.AddStationRequest
+	40, // 79: hydrologybuffer.StationRegistryService.GetStation:input_type -> hydrologybuffer.GetStationRequest
+	41, // 80: hydrologybuffer.StationRegistryService.ListStations:input_type -> hydrologybuffer.ListStationsRequest
+	43, // 81: hydrologybuffer.StationRegistryService.UpdateStation:input_type -> hydrologybuffer.UpdateStationRequest
+	44, // 82: hydrologybuffer.StationRegistryService.RemoveStation:input_type -> hydrologybuffer.RemoveStationRequest
+	8,  // 83: hydrologybuffer.HydrologyBufferService.PingService:output_type -> hydrologybuffer.PingResponse
+	17, // 84: hydrologybuffer.HydrologyBufferService.AddTelegram:output_type -> hydrologybuffer.AddTelegramResponse
+	19, // 85: hydrologybuffer.HydrologyBufferService.RemoveTelegrams:output_type -> hydrologybuffer.RemoveTelegramsResponse
+	22, // 86: hydrologybuffer.HydrologyBufferService.UpdateTelegramByInfo:output_type -> hydrologybuffer.UpdateTelegramResponse
+	22, // 87: hydrologybuffer.HydrologyBufferService.UpdateTelegramByCode:output_type -> hydrologybuffer.UpdateTelegramResponse
+	24, // 88: hydrologybuffer.HydrologyBufferService.GetTelegram:output_type -> hydrologybuffer.GetTelegramResponse
+	26, // 89: hydrologybuffer.HydrologyBufferService.GetTelegrams:output_type -> hydrologybuffer.GetTelegramsResponse
+	28, // 90: hydrologybuffer.HydrologyBufferService.TransferToSystem:output_type -> hydrologybuffer.TransferToSystemResponse
+	31, // 91: hydrologybuffer.HydrologyBufferService.ListPhenomena:output_type -> hydrologybuffer.ListPhenomenaResponse
+	33, // 92: hydrologybuffer.HydrologyBufferService.DescribeTelegram:output_type -> hydrologybuffer.DescribeTelegramResponse
+	36, // 93: hydrologybuffer.HydrologyBufferService.TokenizeTelegram:output_type -> hydrologybuffer.TokenizeTelegramResponse
+	49, // 94: hydrologybuffer.HydrologyBufferService.AcknowledgeAlert:output_type -> hydrologybuffer.AcknowledgeAlertResponse
+	46, // 95: hydrologybuffer.StationRegistryService.AddStation:output_type -> hydrologybuffer.StationResponse
+	46, // 96: hydrologybuffer.StationRegistryService.GetStation:output_type -> hydrologybuffer.StationResponse
+	42, // 97: hydrologybuffer.StationRegistryService.ListStations:output_type -> hydrologybuffer.ListStationsResponse
+	46, // 98: hydrologybuffer.StationRegistryService.UpdateStation:output_type -> hydrologybuffer.StationResponse
+	45, // 99: hydrologybuffer.StationRegistryService.RemoveStation:output_type -> hydrologybuffer.RemoveStationResponse
+	83, // [83:100] is the sub-list for method output_type
+	66, // [66:83] is the sub-list for method input_type
+	66, // [66:66] is the sub-list for extension type_name
+	66, // [66:66] is the sub-list for extension extendee
+	0,  // [0:66] is the sub-list for field type_name
 }
 
 func init() { file_internal_proto_hydrology_buffer_service_proto_init() }
@@ -1555,8 +4313,56 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PingResponse); i {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Telegram); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidationWarning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Int32Measurement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleMeasurement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1567,8 +4373,8 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Telegram); i {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IcePhenomenia); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1579,8 +4385,8 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IcePhenomenia); i {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodeProblem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1591,7 +4397,7 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddTelegramRequest); i {
 			case 0:
 				return &v.state
@@ -1603,7 +4409,19 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelegramResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddTelegramResponse); i {
 			case 0:
 				return &v.state
@@ -1615,7 +4433,7 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveTelegramsRequest); i {
 			case 0:
 				return &v.state
@@ -1627,7 +4445,7 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveTelegramsResponse); i {
 			case 0:
 				return &v.state
@@ -1639,7 +4457,7 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateTelegramByInfoRequest); i {
 			case 0:
 				return &v.state
@@ -1651,7 +4469,7 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateTelegramByCodeRequest); i {
 			case 0:
 				return &v.state
@@ -1663,7 +4481,7 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateTelegramResponse); i {
 			case 0:
 				return &v.state
@@ -1675,7 +4493,7 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTelegramRequest); i {
 			case 0:
 				return &v.state
@@ -1687,7 +4505,7 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTelegramResponse); i {
 			case 0:
 				return &v.state
@@ -1699,7 +4517,7 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTelegramsRequest); i {
 			case 0:
 				return &v.state
@@ -1711,7 +4529,7 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetTelegramsResponse); i {
 			case 0:
 				return &v.state
@@ -1723,7 +4541,7 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransferToSystemRequest); i {
 			case 0:
 				return &v.state
@@ -1735,7 +4553,7 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
-		file_internal_proto_hydrology_buffer_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransferToSystemResponse); i {
 			case 0:
 				return &v.state
@@ -1747,16 +4565,268 @@ func file_internal_proto_hydrology_buffer_service_proto_init() {
 				return nil
 			}
 		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPhenomenaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhenomenonInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPhenomenaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeTelegramRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeTelegramResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenizeTelegramRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelegramToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenizeTelegramResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Station); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationThresholds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddStationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateStationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveStationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveStationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Alert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcknowledgeAlertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_hydrology_buffer_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcknowledgeAlertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_proto_hydrology_buffer_service_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   17,
+			NumEnums:      7,
+			NumMessages:   43,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_internal_proto_hydrology_buffer_service_proto_goTypes,
 		DependencyIndexes: file_internal_proto_hydrology_buffer_service_proto_depIdxs,
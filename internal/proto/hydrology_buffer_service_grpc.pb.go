@@ -27,6 +27,10 @@ const (
 	HydrologyBufferService_GetTelegram_FullMethodName          = "/hydrologybuffer.HydrologyBufferService/GetTelegram"
 	HydrologyBufferService_GetTelegrams_FullMethodName         = "/hydrologybuffer.HydrologyBufferService/GetTelegrams"
 	HydrologyBufferService_TransferToSystem_FullMethodName     = "/hydrologybuffer.HydrologyBufferService/TransferToSystem"
+	HydrologyBufferService_ListPhenomena_FullMethodName        = "/hydrologybuffer.HydrologyBufferService/ListPhenomena"
+	HydrologyBufferService_DescribeTelegram_FullMethodName     = "/hydrologybuffer.HydrologyBufferService/DescribeTelegram"
+	HydrologyBufferService_TokenizeTelegram_FullMethodName     = "/hydrologybuffer.HydrologyBufferService/TokenizeTelegram"
+	HydrologyBufferService_AcknowledgeAlert_FullMethodName     = "/hydrologybuffer.HydrologyBufferService/AcknowledgeAlert"
 )
 
 // HydrologyBufferServiceClient is the client API for HydrologyBufferService service.
@@ -41,6 +45,10 @@ type HydrologyBufferServiceClient interface {
 	GetTelegram(ctx context.Context, in *GetTelegramRequest, opts ...grpc.CallOption) (*GetTelegramResponse, error)
 	GetTelegrams(ctx context.Context, in *GetTelegramsRequest, opts ...grpc.CallOption) (*GetTelegramsResponse, error)
 	TransferToSystem(ctx context.Context, in *TransferToSystemRequest, opts ...grpc.CallOption) (*TransferToSystemResponse, error)
+	ListPhenomena(ctx context.Context, in *ListPhenomenaRequest, opts ...grpc.CallOption) (*ListPhenomenaResponse, error)
+	DescribeTelegram(ctx context.Context, in *DescribeTelegramRequest, opts ...grpc.CallOption) (*DescribeTelegramResponse, error)
+	TokenizeTelegram(ctx context.Context, in *TokenizeTelegramRequest, opts ...grpc.CallOption) (*TokenizeTelegramResponse, error)
+	AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error)
 }
 
 type hydrologyBufferServiceClient struct {
@@ -123,6 +131,42 @@ func (c *hydrologyBufferServiceClient) TransferToSystem(ctx context.Context, in
 	return out, nil
 }
 
+func (c *hydrologyBufferServiceClient) ListPhenomena(ctx context.Context, in *ListPhenomenaRequest, opts ...grpc.CallOption) (*ListPhenomenaResponse, error) {
+	out := new(ListPhenomenaResponse)
+	err := c.cc.Invoke(ctx, HydrologyBufferService_ListPhenomena_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hydrologyBufferServiceClient) DescribeTelegram(ctx context.Context, in *DescribeTelegramRequest, opts ...grpc.CallOption) (*DescribeTelegramResponse, error) {
+	out := new(DescribeTelegramResponse)
+	err := c.cc.Invoke(ctx, HydrologyBufferService_DescribeTelegram_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hydrologyBufferServiceClient) TokenizeTelegram(ctx context.Context, in *TokenizeTelegramRequest, opts ...grpc.CallOption) (*TokenizeTelegramResponse, error) {
+	out := new(TokenizeTelegramResponse)
+	err := c.cc.Invoke(ctx, HydrologyBufferService_TokenizeTelegram_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hydrologyBufferServiceClient) AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error) {
+	out := new(AcknowledgeAlertResponse)
+	err := c.cc.Invoke(ctx, HydrologyBufferService_AcknowledgeAlert_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HydrologyBufferServiceServer is the server API for HydrologyBufferService service.
 // All implementations must embed UnimplementedHydrologyBufferServiceServer
 // for forward compatibility
@@ -135,6 +179,10 @@ type HydrologyBufferServiceServer interface {
 	GetTelegram(context.Context, *GetTelegramRequest) (*GetTelegramResponse, error)
 	GetTelegrams(context.Context, *GetTelegramsRequest) (*GetTelegramsResponse, error)
 	TransferToSystem(context.Context, *TransferToSystemRequest) (*TransferToSystemResponse, error)
+	ListPhenomena(context.Context, *ListPhenomenaRequest) (*ListPhenomenaResponse, error)
+	DescribeTelegram(context.Context, *DescribeTelegramRequest) (*DescribeTelegramResponse, error)
+	TokenizeTelegram(context.Context, *TokenizeTelegramRequest) (*TokenizeTelegramResponse, error)
+	AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error)
 	mustEmbedUnimplementedHydrologyBufferServiceServer()
 }
 
@@ -166,6 +214,18 @@ func (UnimplementedHydrologyBufferServiceServer) GetTelegrams(context.Context, *
 func (UnimplementedHydrologyBufferServiceServer) TransferToSystem(context.Context, *TransferToSystemRequest) (*TransferToSystemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferToSystem not implemented")
 }
+func (UnimplementedHydrologyBufferServiceServer) ListPhenomena(context.Context, *ListPhenomenaRequest) (*ListPhenomenaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPhenomena not implemented")
+}
+func (UnimplementedHydrologyBufferServiceServer) DescribeTelegram(context.Context, *DescribeTelegramRequest) (*DescribeTelegramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTelegram not implemented")
+}
+func (UnimplementedHydrologyBufferServiceServer) TokenizeTelegram(context.Context, *TokenizeTelegramRequest) (*TokenizeTelegramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeTelegram not implemented")
+}
+func (UnimplementedHydrologyBufferServiceServer) AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeAlert not implemented")
+}
 func (UnimplementedHydrologyBufferServiceServer) mustEmbedUnimplementedHydrologyBufferServiceServer() {
 }
 
@@ -324,6 +384,78 @@ func _HydrologyBufferService_TransferToSystem_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HydrologyBufferService_ListPhenomena_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPhenomenaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HydrologyBufferServiceServer).ListPhenomena(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HydrologyBufferService_ListPhenomena_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HydrologyBufferServiceServer).ListPhenomena(ctx, req.(*ListPhenomenaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HydrologyBufferService_DescribeTelegram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTelegramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HydrologyBufferServiceServer).DescribeTelegram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HydrologyBufferService_DescribeTelegram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HydrologyBufferServiceServer).DescribeTelegram(ctx, req.(*DescribeTelegramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HydrologyBufferService_TokenizeTelegram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenizeTelegramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HydrologyBufferServiceServer).TokenizeTelegram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HydrologyBufferService_TokenizeTelegram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HydrologyBufferServiceServer).TokenizeTelegram(ctx, req.(*TokenizeTelegramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HydrologyBufferService_AcknowledgeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HydrologyBufferServiceServer).AcknowledgeAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HydrologyBufferService_AcknowledgeAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HydrologyBufferServiceServer).AcknowledgeAlert(ctx, req.(*AcknowledgeAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HydrologyBufferService_ServiceDesc is the grpc.ServiceDesc for HydrologyBufferService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -363,6 +495,261 @@ var HydrologyBufferService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TransferToSystem",
 			Handler:    _HydrologyBufferService_TransferToSystem_Handler,
 		},
+		{
+			MethodName: "ListPhenomena",
+			Handler:    _HydrologyBufferService_ListPhenomena_Handler,
+		},
+		{
+			MethodName: "DescribeTelegram",
+			Handler:    _HydrologyBufferService_DescribeTelegram_Handler,
+		},
+		{
+			MethodName: "TokenizeTelegram",
+			Handler:    _HydrologyBufferService_TokenizeTelegram_Handler,
+		},
+		{
+			MethodName: "AcknowledgeAlert",
+			Handler:    _HydrologyBufferService_AcknowledgeAlert_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/proto/hydrology_buffer_service.proto",
+}
+
+const (
+	StationRegistryService_AddStation_FullMethodName    = "/hydrologybuffer.StationRegistryService/AddStation"
+	StationRegistryService_GetStation_FullMethodName    = "/hydrologybuffer.StationRegistryService/GetStation"
+	StationRegistryService_ListStations_FullMethodName  = "/hydrologybuffer.StationRegistryService/ListStations"
+	StationRegistryService_UpdateStation_FullMethodName = "/hydrologybuffer.StationRegistryService/UpdateStation"
+	StationRegistryService_RemoveStation_FullMethodName = "/hydrologybuffer.StationRegistryService/RemoveStation"
+)
+
+// StationRegistryServiceClient is the client API for StationRegistryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StationRegistryServiceClient interface {
+	AddStation(ctx context.Context, in *AddStationRequest, opts ...grpc.CallOption) (*StationResponse, error)
+	GetStation(ctx context.Context, in *GetStationRequest, opts ...grpc.CallOption) (*StationResponse, error)
+	ListStations(ctx context.Context, in *ListStationsRequest, opts ...grpc.CallOption) (*ListStationsResponse, error)
+	UpdateStation(ctx context.Context, in *UpdateStationRequest, opts ...grpc.CallOption) (*StationResponse, error)
+	RemoveStation(ctx context.Context, in *RemoveStationRequest, opts ...grpc.CallOption) (*RemoveStationResponse, error)
+}
+
+type stationRegistryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStationRegistryServiceClient(cc grpc.ClientConnInterface) StationRegistryServiceClient {
+	return &stationRegistryServiceClient{cc}
+}
+
+func (c *stationRegistryServiceClient) AddStation(ctx context.Context, in *AddStationRequest, opts ...grpc.CallOption) (*StationResponse, error) {
+	out := new(StationResponse)
+	err := c.cc.Invoke(ctx, StationRegistryService_AddStation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationRegistryServiceClient) GetStation(ctx context.Context, in *GetStationRequest, opts ...grpc.CallOption) (*StationResponse, error) {
+	out := new(StationResponse)
+	err := c.cc.Invoke(ctx, StationRegistryService_GetStation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationRegistryServiceClient) ListStations(ctx context.Context, in *ListStationsRequest, opts ...grpc.CallOption) (*ListStationsResponse, error) {
+	out := new(ListStationsResponse)
+	err := c.cc.Invoke(ctx, StationRegistryService_ListStations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationRegistryServiceClient) UpdateStation(ctx context.Context, in *UpdateStationRequest, opts ...grpc.CallOption) (*StationResponse, error) {
+	out := new(StationResponse)
+	err := c.cc.Invoke(ctx, StationRegistryService_UpdateStation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stationRegistryServiceClient) RemoveStation(ctx context.Context, in *RemoveStationRequest, opts ...grpc.CallOption) (*RemoveStationResponse, error) {
+	out := new(RemoveStationResponse)
+	err := c.cc.Invoke(ctx, StationRegistryService_RemoveStation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StationRegistryServiceServer is the server API for StationRegistryService service.
+// All implementations must embed UnimplementedStationRegistryServiceServer
+// for forward compatibility
+type StationRegistryServiceServer interface {
+	AddStation(context.Context, *AddStationRequest) (*StationResponse, error)
+	GetStation(context.Context, *GetStationRequest) (*StationResponse, error)
+	ListStations(context.Context, *ListStationsRequest) (*ListStationsResponse, error)
+	UpdateStation(context.Context, *UpdateStationRequest) (*StationResponse, error)
+	RemoveStation(context.Context, *RemoveStationRequest) (*RemoveStationResponse, error)
+	mustEmbedUnimplementedStationRegistryServiceServer()
+}
+
+// UnimplementedStationRegistryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStationRegistryServiceServer struct {
+}
+
+func (UnimplementedStationRegistryServiceServer) AddStation(context.Context, *AddStationRequest) (*StationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStation not implemented")
+}
+func (UnimplementedStationRegistryServiceServer) GetStation(context.Context, *GetStationRequest) (*StationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStation not implemented")
+}
+func (UnimplementedStationRegistryServiceServer) ListStations(context.Context, *ListStationsRequest) (*ListStationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStations not implemented")
+}
+func (UnimplementedStationRegistryServiceServer) UpdateStation(context.Context, *UpdateStationRequest) (*StationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStation not implemented")
+}
+func (UnimplementedStationRegistryServiceServer) RemoveStation(context.Context, *RemoveStationRequest) (*RemoveStationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStation not implemented")
+}
+func (UnimplementedStationRegistryServiceServer) mustEmbedUnimplementedStationRegistryServiceServer() {
+}
+
+// UnsafeStationRegistryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StationRegistryServiceServer will
+// result in compilation errors.
+type UnsafeStationRegistryServiceServer interface {
+	mustEmbedUnimplementedStationRegistryServiceServer()
+}
+
+func RegisterStationRegistryServiceServer(s grpc.ServiceRegistrar, srv StationRegistryServiceServer) {
+	s.RegisterService(&StationRegistryService_ServiceDesc, srv)
+}
+
+func _StationRegistryService_AddStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServiceServer).AddStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistryService_AddStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServiceServer).AddStation(ctx, req.(*AddStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StationRegistryService_GetStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServiceServer).GetStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistryService_GetStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServiceServer).GetStation(ctx, req.(*GetStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StationRegistryService_ListStations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServiceServer).ListStations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistryService_ListStations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServiceServer).ListStations(ctx, req.(*ListStationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StationRegistryService_UpdateStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServiceServer).UpdateStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistryService_UpdateStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServiceServer).UpdateStation(ctx, req.(*UpdateStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StationRegistryService_RemoveStation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StationRegistryServiceServer).RemoveStation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StationRegistryService_RemoveStation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StationRegistryServiceServer).RemoveStation(ctx, req.(*RemoveStationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StationRegistryService_ServiceDesc is the grpc.ServiceDesc for StationRegistryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StationRegistryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hydrologybuffer.StationRegistryService",
+	HandlerType: (*StationRegistryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddStation",
+			Handler:    _StationRegistryService_AddStation_Handler,
+		},
+		{
+			MethodName: "GetStation",
+			Handler:    _StationRegistryService_GetStation_Handler,
+		},
+		{
+			MethodName: "ListStations",
+			Handler:    _StationRegistryService_ListStations_Handler,
+		},
+		{
+			MethodName: "UpdateStation",
+			Handler:    _StationRegistryService_UpdateStation_Handler,
+		},
+		{
+			MethodName: "RemoveStation",
+			Handler:    _StationRegistryService_RemoveStation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/proto/hydrology_buffer_service.proto",
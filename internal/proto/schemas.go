@@ -0,0 +1,24 @@
+package HL_BufferService
+
+import (
+	"embed"
+)
+
+// RecordsSchema is the source of records.proto, registered with the schema
+// registry for protobuf payloads.
+//
+//go:embed records.proto
+var RecordsSchema string
+
+//go:embed avro/*.avsc
+var avroSchemas embed.FS
+
+// AvroSchema returns the Avro schema of the records of a parameter family,
+// or an empty string if there is none.
+func AvroSchema(family string) string {
+	schema, err := avroSchemas.ReadFile("avro/" + family + ".avsc")
+	if err != nil {
+		return ""
+	}
+	return string(schema)
+}
@@ -0,0 +1,306 @@
+// Package alert notifies duty officers about dangerous telegrams. Alerts
+// are stored so that repeated reports of the same situation are not sent
+// twice, alerts the sinks did not accept are sent again and alerts nobody
+// acknowledges are escalated to further sinks.
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrNotFound = errors.New("alert not found")
+
+// Alert is a notification about a telegram. Key identifies the situation:
+// alerts with the same key are duplicates while one of them is open.
+type Alert struct {
+	Id             uuid.UUID  `json:"id"`
+	Key            string     `json:"key"`
+	TelegramId     uuid.UUID  `json:"telegram_id"`
+	PostCode       string     `json:"post_code"`
+	StationName    string     `json:"station_name,omitempty"`
+	DateTime       time.Time  `json:"datetime"`
+	Severity       string     `json:"severity,omitempty"`
+	IsDangerous    bool       `json:"is_dangerous"`
+	Reason         string     `json:"reason"`
+	Level          int        `json:"level"`
+	CreatedAt      time.Time  `json:"created_at"`
+	NotifiedAt     *time.Time `json:"notified_at,omitempty"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	AcknowledgedBy string     `json:"acknowledged_by,omitempty"`
+}
+
+// Serialize encodes the alert as JSON.
+func (a *Alert) Serialize() ([]byte, error) {
+	return json.Marshal(a)
+}
+
+// Subject is a one-line summary of the alert.
+func (a *Alert) Subject() string {
+
+	station := a.PostCode
+	if a.StationName != "" {
+		station += " " + a.StationName
+	}
+
+	subject := fmt.Sprintf("%s at %s, %s", a.Reason, station, a.DateTime.Format(time.RFC3339))
+	if a.Level > 0 {
+		subject = fmt.Sprintf("[escalation %d] %s", a.Level, subject)
+	}
+
+	return subject
+}
+
+// Store keeps the alerts.
+type Store interface {
+	// Open stores a unless an unacknowledged alert with the same key was
+	// created at or after since. It reports whether a was stored.
+	Open(ctx context.Context, a *Alert, since time.Time) (bool, error)
+	// Acknowledge marks the alert acknowledged unless it already is and
+	// returns it.
+	Acknowledge(ctx context.Context, id uuid.UUID, by string, at time.Time) (*Alert, error)
+	// Undelivered returns the unacknowledged alerts not yet notified at
+	// their level, oldest first.
+	Undelivered(ctx context.Context) ([]Alert, error)
+	// Due returns the unacknowledged alerts below maxLevel last notified
+	// before notifiedBefore, or never notified and created before it.
+	Due(ctx context.Context, notifiedBefore time.Time, maxLevel int) ([]Alert, error)
+	// Escalate records that the alert was notified at level.
+	Escalate(ctx context.Context, id uuid.UUID, level int, at time.Time) error
+}
+
+// Sink delivers alerts.
+type Sink interface {
+	Send(ctx context.Context, a *Alert) error
+}
+
+type Config struct {
+	// DedupWindow is how long an open alert suppresses alerts with the
+	// same key.
+	DedupWindow time.Duration `mapstructure:"dedup_window"`
+	// EscalateAfter is how long an alert may stay unacknowledged before it
+	// is sent to the sinks of the next level. Zero disables escalation.
+	EscalateAfter time.Duration `mapstructure:"escalate_after"`
+	// CheckInterval is how often Run looks for alerts to send again or to
+	// escalate.
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		DedupWindow:   6 * time.Hour,
+		EscalateAfter: 30 * time.Minute,
+		CheckInterval: time.Minute,
+	}
+}
+
+// Dispatcher sends alerts to the sinks of their escalation level.
+type Dispatcher struct {
+	store  Store
+	config Config
+	now    func() time.Time
+
+	// wake tells Run that an alert was opened.
+	wake chan struct{}
+
+	mu    sync.RWMutex
+	sinks map[int][]Sink
+}
+
+func NewDispatcher(store Store, config Config) *Dispatcher {
+	return &Dispatcher{
+		store:  store,
+		config: config,
+		now:    time.Now,
+		wake:   make(chan struct{}, 1),
+		sinks:  map[int][]Sink{},
+	}
+}
+
+// AddSink makes sink receive the alerts escalated to level. Level 0 sinks
+// receive every new alert.
+func (d *Dispatcher) AddSink(level int, sink Sink) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.sinks[level] = append(d.sinks[level], sink)
+}
+
+// Notify stores a for Run to send to the level 0 sinks, so a slow sink
+// does not hold the caller. A duplicate of an open alert is dropped; Notify
+// reports whether a was stored.
+func (d *Dispatcher) Notify(ctx context.Context, a Alert) (bool, error) {
+
+	now := d.now()
+
+	a.Id = uuid.New()
+	a.Level = 0
+	a.CreatedAt = now
+	a.NotifiedAt = nil
+	a.AcknowledgedAt = nil
+	a.AcknowledgedBy = ""
+
+	opened, err := d.store.Open(ctx, &a, now.Add(-d.config.DedupWindow))
+	if err != nil || !opened {
+		return false, err
+	}
+
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+
+	return true, nil
+}
+
+func (d *Dispatcher) Acknowledge(ctx context.Context, id uuid.UUID, by string) (*Alert, error) {
+	return d.store.Acknowledge(ctx, id, by, d.now())
+}
+
+// Deliver sends the undelivered alerts to the sinks of their level. An
+// alert is recorded as notified once every sink accepted it, so a failed
+// delivery is tried again by the next call; sinks that accepted it the
+// first time receive it again.
+func (d *Dispatcher) Deliver(ctx context.Context) error {
+
+	undelivered, err := d.store.Undelivered(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range undelivered {
+		a := &undelivered[i]
+		now := d.now()
+		a.NotifiedAt = &now
+		if err := d.send(ctx, a); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := d.store.Escalate(ctx, a.Id, a.Level, now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Escalate sends the alerts unacknowledged for Config.EscalateAfter to the
+// sinks of their next level, skipping levels without sinks so nobody misses
+// an escalation recorded for them. An alert is recorded at that level once they
+// accepted it, so a failed escalation is tried again by the next call. An
+// alert its level 0 sinks never accepted is escalated the same way, counting
+// from its creation.
+func (d *Dispatcher) Escalate(ctx context.Context) error {
+
+	maxLevel := d.maxLevel()
+	if d.config.EscalateAfter <= 0 || maxLevel == 0 {
+		return nil
+	}
+
+	now := d.now()
+
+	due, err := d.store.Due(ctx, now.Add(-d.config.EscalateAfter), maxLevel)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range due {
+		a := &due[i]
+		a.Level = d.nextLevel(a.Level)
+		a.NotifiedAt = &now
+		if err := d.send(ctx, a); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := d.store.Escalate(ctx, a.Id, a.Level, now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Run delivers new alerts as they are opened and, every
+// Config.CheckInterval, sends undelivered alerts again and escalates the
+// unacknowledged ones until ctx is done. Errors are passed to report.
+func (d *Dispatcher) Run(ctx context.Context, report func(error)) {
+
+	interval := d.config.CheckInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.wake:
+			if err := d.Deliver(ctx); err != nil && report != nil {
+				report(err)
+			}
+		case <-ticker.C:
+			if err := errors.Join(d.Deliver(ctx), d.Escalate(ctx)); err != nil && report != nil {
+				report(err)
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) send(ctx context.Context, a *Alert) error {
+
+	d.mu.RLock()
+	sinks := d.sinks[a.Level]
+	d.mu.RUnlock()
+
+	var errs []error
+	for _, sink := range sinks {
+		if err := sink.Send(ctx, a); err != nil {
+			errs = append(errs, fmt.Errorf("alert %s level %d: %w", a.Id, a.Level, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// nextLevel returns the lowest level above level that has sinks, or
+// level+1 when none has.
+func (d *Dispatcher) nextLevel(level int) int {
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	next := level + 1
+	found := false
+	for l, sinks := range d.sinks {
+		if l > level && len(sinks) != 0 && (!found || l < next) {
+			next, found = l, true
+		}
+	}
+
+	return next
+}
+
+func (d *Dispatcher) maxLevel() int {
+
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	max := 0
+	for level := range d.sinks {
+		if level > max {
+			max = level
+		}
+	}
+
+	return max
+}
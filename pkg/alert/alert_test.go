@@ -0,0 +1,411 @@
+package alert
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"mime"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDispatcher(t *testing.T) {
+
+	var mu sync.Mutex
+	var posted []Alert
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var a Alert
+		if err := json.NewDecoder(r.Body).Decode(&a); err != nil {
+			t.Errorf("webhook body: %v", err)
+		}
+		mu.Lock()
+		posted = append(posted, a)
+		mu.Unlock()
+	}))
+	defer webhook.Close()
+
+	mailServer := newSMTPServer(t)
+
+	now := time.Date(2024, time.April, 12, 8, 0, 0, 0, time.UTC)
+	dispatcher := NewDispatcher(NewMemoryStore(), Config{DedupWindow: 6 * time.Hour, EscalateAfter: 30 * time.Minute})
+	dispatcher.now = func() time.Time { return now }
+	dispatcher.AddSink(0, NewWebhookSink(WebhookConfig{URL: webhook.URL}))
+	dispatcher.AddSink(1, NewSMTPSink(SMTPConfig{Addr: mailServer.addr, From: "buffer@example.org", To: []string{"duty@example.org"}}))
+
+	ctx := context.Background()
+	alert := Alert{Key: "10950/dangerous", PostCode: "10950", StationName: "Река - Пост", DateTime: now, Reason: "dangerous telegram"}
+
+	sent, err := dispatcher.Notify(ctx, alert)
+	if err != nil || !sent {
+		t.Fatalf("Notify() = %v, %v, want true", sent, err)
+	}
+	if sent, err := dispatcher.Notify(ctx, alert); err != nil || sent {
+		t.Fatalf("Notify() of a duplicate = %v, %v, want false", sent, err)
+	}
+	if len(posted) != 0 {
+		t.Fatalf("webhook got %+v before Deliver", posted)
+	}
+	if err := dispatcher.Deliver(ctx); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	if len(posted) != 1 || posted[0].PostCode != "10950" || posted[0].Level != 0 {
+		t.Fatalf("webhook got %+v, want one level 0 alert", posted)
+	}
+
+	now = now.Add(20 * time.Minute)
+	if err := dispatcher.Escalate(ctx); err != nil || len(mailServer.messages()) != 0 {
+		t.Fatalf("Escalate() before the deadline = %v, mailed %d", err, len(mailServer.messages()))
+	}
+
+	now = now.Add(20 * time.Minute)
+	if err := dispatcher.Escalate(ctx); err != nil {
+		t.Fatalf("Escalate() error = %v", err)
+	}
+	messages := mailServer.messages()
+	if len(messages) != 1 {
+		t.Fatalf("mailed %q, want one escalation", messages)
+	}
+	message, err := mail.ReadMessage(strings.NewReader(messages[0]))
+	if err != nil {
+		t.Fatalf("mailed %q: %v", messages[0], err)
+	}
+	encodedSubject := message.Header.Get("Subject")
+	if !strings.HasPrefix(encodedSubject, "=?utf-8?q?") {
+		t.Errorf("Subject %q is not encoded", encodedSubject)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(encodedSubject)
+	if err != nil || !strings.HasPrefix(subject, "[escalation 1] dangerous telegram at 10950 Река - Пост") {
+		t.Fatalf("Subject = %q, %v, want the escalation", subject, err)
+	}
+
+	now = now.Add(time.Hour)
+	if err := dispatcher.Escalate(ctx); err != nil || len(mailServer.messages()) != 1 {
+		t.Fatalf("Escalate() beyond the last level = %v, mailed %d", err, len(mailServer.messages()))
+	}
+
+	acknowledged, err := dispatcher.Acknowledge(ctx, posted[0].Id, "officer")
+	if err != nil || acknowledged.AcknowledgedBy != "officer" || acknowledged.AcknowledgedAt == nil {
+		t.Fatalf("Acknowledge() = %+v, %v", acknowledged, err)
+	}
+	if sent, err := dispatcher.Notify(ctx, alert); err != nil || !sent {
+		t.Fatalf("Notify() after acknowledgement = %v, %v, want true", sent, err)
+	}
+	if _, err := dispatcher.Acknowledge(ctx, acknowledged.TelegramId, "officer"); err != ErrNotFound {
+		t.Errorf("Acknowledge() of an unknown alert error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestEscalateRetry(t *testing.T) {
+
+	now := time.Date(2024, time.April, 12, 8, 0, 0, 0, time.UTC)
+	dispatcher := NewDispatcher(NewMemoryStore(), Config{DedupWindow: 6 * time.Hour, EscalateAfter: 30 * time.Minute})
+	dispatcher.now = func() time.Time { return now }
+
+	escalations := &flakySink{err: errors.New("mail server unavailable")}
+	dispatcher.AddSink(1, escalations)
+
+	ctx := context.Background()
+	if _, err := dispatcher.Notify(ctx, Alert{Key: "10950/dangerous", PostCode: "10950", DateTime: now}); err != nil {
+		t.Fatal(err)
+	}
+
+	now = now.Add(time.Hour)
+	if err := dispatcher.Escalate(ctx); err == nil {
+		t.Fatal("Escalate() with a failing sink succeeded")
+	}
+
+	// The alert was not recorded as escalated, so it is sent again.
+	escalations.err = nil
+	if err := dispatcher.Escalate(ctx); err != nil {
+		t.Fatalf("Escalate() error = %v", err)
+	}
+	if escalations.sent != 1 {
+		t.Fatalf("escalation sent %d times, want 1", escalations.sent)
+	}
+
+	if err := dispatcher.Escalate(ctx); err != nil || escalations.sent != 1 {
+		t.Fatalf("Escalate() after the retry = %v, sent %d", err, escalations.sent)
+	}
+}
+
+func TestEscalateSkipsEmptyLevels(t *testing.T) {
+
+	now := time.Date(2024, time.April, 12, 8, 0, 0, 0, time.UTC)
+	dispatcher := NewDispatcher(NewMemoryStore(), Config{DedupWindow: 6 * time.Hour, EscalateAfter: 30 * time.Minute})
+	dispatcher.now = func() time.Time { return now }
+
+	// Level 1 has no sinks.
+	dispatcher.AddSink(0, &flakySink{})
+	escalations := &flakySink{}
+	dispatcher.AddSink(2, escalations)
+
+	ctx := context.Background()
+	if _, err := dispatcher.Notify(ctx, Alert{Key: "10950/dangerous", PostCode: "10950", DateTime: now}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dispatcher.Deliver(ctx); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+
+	now = now.Add(time.Hour)
+	if err := dispatcher.Escalate(ctx); err != nil {
+		t.Fatalf("Escalate() error = %v", err)
+	}
+	if escalations.sent != 1 {
+		t.Fatalf("level 2 got %d escalations after the first deadline, want 1", escalations.sent)
+	}
+
+	now = now.Add(time.Hour)
+	if err := dispatcher.Escalate(ctx); err != nil || escalations.sent != 1 {
+		t.Fatalf("Escalate() beyond the last level = %v, sent %d", err, escalations.sent)
+	}
+}
+
+func TestDeliverRetry(t *testing.T) {
+
+	now := time.Date(2024, time.April, 12, 8, 0, 0, 0, time.UTC)
+	dispatcher := NewDispatcher(NewMemoryStore(), Config{DedupWindow: 6 * time.Hour, EscalateAfter: 30 * time.Minute})
+	dispatcher.now = func() time.Time { return now }
+
+	// Only level 0 is configured, so escalation never resends the alert.
+	sink := &flakySink{err: errors.New("webhook unavailable")}
+	dispatcher.AddSink(0, sink)
+
+	ctx := context.Background()
+	alert := Alert{Key: "10950/dangerous", PostCode: "10950", DateTime: now}
+	if sent, err := dispatcher.Notify(ctx, alert); err != nil || !sent {
+		t.Fatalf("Notify() = %v, %v, want true", sent, err)
+	}
+	if err := dispatcher.Deliver(ctx); err == nil {
+		t.Fatal("Deliver() with a failing sink succeeded")
+	}
+
+	// The undelivered alert still suppresses duplicates.
+	now = now.Add(time.Minute)
+	if sent, err := dispatcher.Notify(ctx, alert); err != nil || sent {
+		t.Fatalf("Notify() of a duplicate = %v, %v, want false", sent, err)
+	}
+	if err := dispatcher.Escalate(ctx); err != nil {
+		t.Fatalf("Escalate() error = %v", err)
+	}
+
+	sink.err = nil
+	if err := dispatcher.Deliver(ctx); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	if sink.sent != 1 {
+		t.Fatalf("alert sent %d times, want 1", sink.sent)
+	}
+
+	if err := dispatcher.Deliver(ctx); err != nil || sink.sent != 1 {
+		t.Fatalf("Deliver() after the retry = %v, sent %d", err, sink.sent)
+	}
+}
+
+func TestRunDelivers(t *testing.T) {
+
+	dispatcher := NewDispatcher(NewMemoryStore(), Config{CheckInterval: time.Hour})
+	sink := &flakySink{}
+	dispatcher.AddSink(0, sink)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		dispatcher.Run(ctx, func(err error) { t.Errorf("Run() error = %v", err) })
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	if _, err := dispatcher.Notify(ctx, Alert{Key: "10950/dangerous", PostCode: "10950"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Run is woken by Notify rather than waiting for the next check.
+	deadline := time.Now().Add(5 * time.Second)
+	for sink.count() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("Run() did not deliver the alert")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// flakySink fails with err while it is set.
+type flakySink struct {
+	mu   sync.Mutex
+	err  error
+	sent int
+}
+
+func (s *flakySink) Send(ctx context.Context, a *Alert) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.sent++
+	return nil
+}
+
+func (s *flakySink) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sent
+}
+
+func TestWebhookSinkStatus(t *testing.T) {
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		wantErr bool
+	}{
+		{name: "Accepted", headers: map[string]string{"Authorization": "Bearer token"}},
+		{name: "Rejected", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := NewWebhookSink(WebhookConfig{URL: server.URL, Headers: tt.headers})
+			if err := sink.Send(context.Background(), &Alert{}); (err != nil) != tt.wantErr {
+				t.Errorf("Send() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSMTPSinkTimeout(t *testing.T) {
+
+	// The server accepts the connection but never greets.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	sink := NewSMTPSink(SMTPConfig{Addr: listener.Addr().String(), From: "buffer@example.org", To: []string{"duty@example.org"}, Timeout: 100 * time.Millisecond})
+
+	start := time.Now()
+	if err := sink.Send(context.Background(), &Alert{}); err == nil {
+		t.Fatal("Send() to a silent server succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Send() returned after %s, want the timeout", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sink = NewSMTPSink(SMTPConfig{Addr: listener.Addr().String(), From: "buffer@example.org", To: []string{"duty@example.org"}, Timeout: time.Hour})
+	time.AfterFunc(100*time.Millisecond, cancel)
+	if err := sink.Send(ctx, &Alert{}); err == nil {
+		t.Fatal("Send() with a cancelled context succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("Send() returned after %s, want the cancellation", elapsed)
+	}
+}
+
+// smtpServer is a local stand-in accepting every message.
+type smtpServer struct {
+	addr string
+
+	mu       sync.Mutex
+	received []string
+}
+
+func newSMTPServer(t *testing.T) *smtpServer {
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	server := &smtpServer{addr: listener.Addr().String()}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+
+	return server
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 localhost")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		switch command := strings.ToUpper(strings.Fields(line + " ")[0]); command {
+		case "EHLO", "HELO", "MAIL", "RCPT", "RSET", "NOOP":
+			reply("250 OK")
+		case "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(line)
+			}
+			s.mu.Lock()
+			s.received = append(s.received, data.String())
+			s.mu.Unlock()
+			reply("250 OK")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func (s *smtpServer) messages() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.received...)
+}
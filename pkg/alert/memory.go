@@ -0,0 +1,111 @@
+package alert
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MemoryStore is a Store for tests and single instance deployments without
+// a database.
+type MemoryStore struct {
+	mu     sync.Mutex
+	alerts []Alert
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+func (m *MemoryStore) Open(ctx context.Context, a *Alert, since time.Time) (bool, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, stored := range m.alerts {
+		if stored.Key == a.Key && stored.AcknowledgedAt == nil && !stored.CreatedAt.Before(since) {
+			return false, nil
+		}
+	}
+
+	m.alerts = append(m.alerts, *a)
+
+	return true, nil
+}
+
+func (m *MemoryStore) Acknowledge(ctx context.Context, id uuid.UUID, by string, at time.Time) (*Alert, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.alerts {
+		if m.alerts[i].Id != id {
+			continue
+		}
+		if m.alerts[i].AcknowledgedAt == nil {
+			m.alerts[i].AcknowledgedAt = &at
+			m.alerts[i].AcknowledgedBy = by
+		}
+		a := m.alerts[i]
+		return &a, nil
+	}
+
+	return nil, ErrNotFound
+}
+
+func (m *MemoryStore) Undelivered(ctx context.Context) ([]Alert, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var undelivered []Alert
+	for _, a := range m.alerts {
+		if a.AcknowledgedAt == nil && a.NotifiedAt == nil {
+			undelivered = append(undelivered, a)
+		}
+	}
+
+	sort.Slice(undelivered, func(i, j int) bool { return undelivered[i].CreatedAt.Before(undelivered[j].CreatedAt) })
+
+	return undelivered, nil
+}
+
+func (m *MemoryStore) Due(ctx context.Context, notifiedBefore time.Time, maxLevel int) ([]Alert, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var due []Alert
+	for _, a := range m.alerts {
+		notifiedAt := a.CreatedAt
+		if a.NotifiedAt != nil {
+			notifiedAt = *a.NotifiedAt
+		}
+		if a.AcknowledgedAt == nil && a.Level < maxLevel && notifiedAt.Before(notifiedBefore) {
+			due = append(due, a)
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool { return due[i].CreatedAt.Before(due[j].CreatedAt) })
+
+	return due, nil
+}
+
+func (m *MemoryStore) Escalate(ctx context.Context, id uuid.UUID, level int, at time.Time) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range m.alerts {
+		if m.alerts[i].Id == id {
+			m.alerts[i].Level = level
+			m.alerts[i].NotifiedAt = &at
+			return nil
+		}
+	}
+
+	return ErrNotFound
+}
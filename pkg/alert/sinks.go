@@ -0,0 +1,221 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/publisher"
+)
+
+// PublisherSink publishes alerts as JSON to a dedicated topic, keyed by
+// post code so the alerts of a post stay in order.
+type PublisherSink struct {
+	publisher publisher.Publisher
+	topic     string
+}
+
+func NewPublisherSink(publisher publisher.Publisher, topic string) *PublisherSink {
+	return &PublisherSink{publisher: publisher, topic: topic}
+}
+
+func (p *PublisherSink) Send(ctx context.Context, a *Alert) error {
+
+	value, err := a.Serialize()
+	if err != nil {
+		return err
+	}
+
+	return p.publisher.Publish(ctx, publisher.Message{
+		Topic: p.topic,
+		Key:   a.PostCode,
+		Headers: map[string]string{
+			kafka.HeaderContentType: kafka.ContentTypeJSON,
+			kafka.HeaderTelegramId:  a.TelegramId.String(),
+		},
+		Value: value,
+	})
+}
+
+type WebhookConfig struct {
+	URL     string            `mapstructure:"url"`
+	Headers map[string]string `mapstructure:"headers"`
+	Timeout time.Duration     `mapstructure:"timeout"`
+	Level   int               `mapstructure:"level"`
+}
+
+// WebhookSink posts alerts as JSON. Any status other than 2xx is an error.
+type WebhookSink struct {
+	config WebhookConfig
+	client *http.Client
+}
+
+func NewWebhookSink(config WebhookConfig) *WebhookSink {
+
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &WebhookSink{config: config, client: &http.Client{Timeout: timeout}}
+}
+
+func (w *WebhookSink) Send(ctx context.Context, a *Alert) error {
+
+	body, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range w.config.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook %s: %s", w.config.URL, resp.Status)
+	}
+
+	return nil
+}
+
+type SMTPConfig struct {
+	// Addr is host:port of the mail server.
+	Addr     string   `mapstructure:"addr"`
+	Username string   `mapstructure:"username"`
+	Password string   `mapstructure:"password"`
+	From     string   `mapstructure:"from"`
+	To       []string `mapstructure:"to"`
+	// Timeout bounds the whole SMTP session, 10 seconds by default.
+	Timeout time.Duration `mapstructure:"timeout"`
+	Level   int           `mapstructure:"level"`
+}
+
+// SMTPSink mails alerts as plain text. It upgrades to TLS when the server
+// offers STARTTLS and authenticates only when a username is configured.
+type SMTPSink struct {
+	config SMTPConfig
+}
+
+func NewSMTPSink(config SMTPConfig) *SMTPSink {
+
+	if config.Timeout <= 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	return &SMTPSink{config: config}
+}
+
+// Send drives the SMTP session itself rather than through smtp.SendMail,
+// which has no deadline: the session is aborted when ctx is done or the
+// timeout passes.
+func (m *SMTPSink) Send(ctx context.Context, a *Alert) error {
+
+	ctx, cancel := context.WithTimeout(ctx, m.config.Timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.config.Addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	host, _, err := net.SplitHostPort(m.config.Addr)
+	if err != nil {
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if m.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", m.config.Username, m.config.Password, host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(m.config.From); err != nil {
+		return err
+	}
+	for _, to := range m.config.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(m.message(a)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (m *SMTPSink) message(a *Alert) []byte {
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "From: %s\r\n", m.config.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(m.config.To, ", "))
+	// Station names are Cyrillic, which headers may only carry encoded.
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", a.Subject()))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+
+	fmt.Fprintf(&b, "Alert: %s\r\n", a.Id)
+	fmt.Fprintf(&b, "Post: %s %s\r\n", a.PostCode, a.StationName)
+	fmt.Fprintf(&b, "Observed: %s\r\n", a.DateTime.Format(time.RFC3339))
+	fmt.Fprintf(&b, "Reason: %s\r\n", a.Reason)
+	if a.Severity != "" {
+		fmt.Fprintf(&b, "Severity: %s\r\n", a.Severity)
+	}
+	fmt.Fprintf(&b, "Telegram: %s\r\n", a.TelegramId)
+	b.WriteString("\r\nAcknowledge the alert with the AcknowledgeAlert call to stop escalation.\r\n")
+
+	return []byte(b.String())
+}
@@ -0,0 +1,157 @@
+package decoder
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// Baselines measured with go test -run xxx -bench . -benchmem on one core
+// of an Intel Xeon virtual machine. Throughput depends on the machine, so
+// compare runs on the same one, with benchstat, rather than against these
+// numbers:
+//
+//	BenchmarkDecode          30 MB/s, 4 allocs/op
+//	BenchmarkDecode922       15 MB/s, 19 allocs/op
+//	BenchmarkDecodeBulletin  17 MB/s, 70 MB and 183k allocs on a 4 MB bulletin
+//
+// Allocation counts do not depend on the machine and are enforced instead.
+// Decoding a group must not allocate; TestDecodeAllocations checks that the
+// allocations of a telegram do not depend on the number of its groups and
+// TestDecodeBulletinAllocations that a bulletin costs no more than its
+// decoded telegrams.
+
+const (
+	benchTelegram = "10950 31081 97701 10245 20011 30250 40410 51206 51803 70353 81225 00032 " +
+		"94414 11234 21200 41100 71250 95514 42235 71200 96604 10245 21230 35670 40087 50310 61408"
+	bench922Telegram = "10950 31081 10245 20011 40410 70353 " +
+		"92230 10240 20022 40411 " +
+		"92229 10262 20011 40412 " +
+		"92228 10251 20052 40413 " +
+		"92227 10306 20021 40414 " +
+		"92226 10285 20032 40415 " +
+		"92225 10253 20011 40416"
+)
+
+var benchReference = time.Date(2024, time.March, 31, 12, 0, 0, 0, time.UTC)
+
+func TestDecodeAllocations(t *testing.T) {
+
+	allocs := func(s string) float64 {
+		return testing.AllocsPerRun(100, func() {
+			if _, _, err := DecodeSlice(s, ReferenceTime(benchReference)); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+
+	short := allocs("10950 31081 10245")
+	if long := allocs(benchTelegram); long != short {
+		t.Errorf("decoding %d groups made %v allocations, 3 groups made %v", len(strings.Fields(benchTelegram)), long, short)
+	}
+
+	// Every 922 section is a telegram of its own and may cost one
+	// allocation, but its groups must not allocate either.
+	previousDay := allocs("10950 31081 10245 92230 10240")
+	if long := allocs("10950 31081 10245 92230 10240 20022 40411 51206 51803 70353 81225"); long != previousDay {
+		t.Errorf("922 section with 7 groups made %v allocations, with 1 group %v", long, previousDay)
+	}
+	if twice := allocs("10950 31081 10245 92230 10240 92229 10262"); twice > previousDay+1 {
+		t.Errorf("second 922 section made %v allocations, want at most 1", twice-previousDay)
+	}
+}
+
+func TestDecodeBulletinAllocations(t *testing.T) {
+
+	// Every telegram of the bulletin costs its entry, report and list of
+	// decoded telegrams, and every decoded telegram one block. The rewritten
+	// 922 date groups of a telegram share one string. The group buffers are
+	// reused, so they only grow with the longest telegram.
+	const pairs = 64
+	bulletin := strings.Repeat(benchTelegram+"=\n"+bench922Telegram+"=\n", pairs)
+	perPair := 2*3 + // entries, reports and lists of decoded telegrams
+		1 + // the plain telegram
+		1 + strings.Count(bench922Telegram, " 922") + // the 922 telegram and its sections
+		1 // the rewritten date groups
+
+	allocs := testing.AllocsPerRun(10, func() {
+		if _, err := DecodeBulletin(bulletin, ReferenceTime(benchReference)); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// The bulletin itself may cost a few allocations more, for options and
+	// the growing list of its telegrams.
+	if budget := float64(pairs*perPair + 32); allocs > budget {
+		t.Errorf("decoding %d telegrams made %v allocations, want at most %v", 2*pairs, allocs, budget)
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+
+	b.SetBytes(int64(len(benchTelegram)))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if _, _, err := Decode(benchTelegram, ReferenceTime(benchReference)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecode922(b *testing.B) {
+
+	b.SetBytes(int64(len(bench922Telegram)))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if _, _, err := DecodeSlice(bench922Telegram, ReferenceTime(benchReference)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeBulletin(b *testing.B) {
+
+	bulletin := benchBulletin(4 << 20)
+
+	b.SetBytes(int64(len(bulletin)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := DecodeBulletin(bulletin, ReferenceTime(benchReference)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCheckCodeBlock(b *testing.B) {
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if err := checkCodeBlock("1////"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// benchBulletin returns a bulletin of at least size bytes alternating plain
+// and 922 telegrams.
+func benchBulletin(size int) string {
+
+	var builder strings.Builder
+	builder.Grow(size + len(bench922Telegram))
+
+	for i := 0; builder.Len() < size; i++ {
+		if i%2 == 0 {
+			builder.WriteString(benchTelegram)
+		} else {
+			builder.WriteString(bench922Telegram)
+		}
+		builder.WriteString("=\n")
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,83 @@
+package decoder
+
+import (
+	"strings"
+)
+
+// BulletinTelegram is a single '='-terminated telegram of a bulletin.
+// Offset is the position of Text in the bulletin; group offsets in the
+// Report are relative to the whole bulletin as well. Err is set when the
+// telegram could not be decoded at all.
+type BulletinTelegram struct {
+	Index     int
+	Offset    int
+	Text      string
+	Telegrams []*Telegram
+	Report    *Report
+	Err       error
+}
+
+// DecodeBulletin decodes every telegram of a bulletin independently, so a
+// bad telegram does not prevent the others from being decoded.
+func DecodeBulletin(s string, opts ...Option) ([]*BulletinTelegram, error) {
+
+	o := newOptions(opts)
+
+	segments := splitBulletin(s)
+	bulletin := make([]*BulletinTelegram, 0, len(segments))
+	var buffers groupBuffers
+
+	for _, segment := range segments {
+		entry := &BulletinTelegram{
+			Index:  len(bulletin),
+			Offset: segment.offset,
+			Text:   segment.text,
+			Report: &Report{},
+		}
+
+		entry.Telegrams, entry.Err = decodeSequences(buffers.parseGroups(segment.text, segment.offset), &buffers, o, entry.Report)
+
+		bulletin = append(bulletin, entry)
+	}
+
+	if len(bulletin) == 0 {
+		return nil, newDecodeError(CodeEmptyTelegram, "bulletin contains no telegrams")
+	}
+
+	return bulletin, nil
+}
+
+type segment struct {
+	text   string
+	offset int
+}
+
+// splitBulletin cuts s at every '=' and drops empty telegrams. The last
+// telegram is kept even if its terminator is missing.
+func splitBulletin(s string) []segment {
+
+	var segments []segment
+
+	start := 0
+	for start <= len(s) {
+		end := strings.IndexByte(s[start:], '=')
+		if end < 0 {
+			end = len(s)
+		} else {
+			end += start
+		}
+
+		text := s[start:end]
+		trimmed := strings.TrimLeft(text, " \t\n\r\v\f")
+		offset := start + len(text) - len(trimmed)
+		trimmed = strings.TrimRight(trimmed, " \t\n\r\v\f")
+
+		if trimmed != "" {
+			segments = append(segments, segment{text: trimmed, offset: offset})
+		}
+
+		start = end + 1
+	}
+
+	return segments
+}
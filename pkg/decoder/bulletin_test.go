@@ -0,0 +1,91 @@
+package decoder
+
+import (
+	"testing"
+)
+
+func TestDecodeBulletin(t *testing.T) {
+
+	input := "10950 31081 10245 20122=\n10951 31081 1a245=\n10952 31081 10300 92230 10290 = \n"
+
+	bulletin, err := DecodeBulletin(input)
+	if err != nil {
+		t.Fatalf("DecodeBulletin() error = %v", err)
+	}
+
+	if len(bulletin) != 3 {
+		t.Fatalf("DecodeBulletin() returned %d telegrams, want 3", len(bulletin))
+	}
+
+	tests := []struct {
+		name          string
+		entry         *BulletinTelegram
+		wantOffset    int
+		wantText      string
+		wantTelegrams int
+		wantErr       bool
+	}{
+		{
+			name:          "First telegram",
+			entry:         bulletin[0],
+			wantOffset:    0,
+			wantText:      "10950 31081 10245 20122",
+			wantTelegrams: 1,
+		},
+		{
+			name:       "Bad telegram does not stop the bulletin",
+			entry:      bulletin[1],
+			wantOffset: 25,
+			wantText:   "10951 31081 1a245",
+			wantErr:    true,
+		},
+		{
+			name:          "Telegram with 922 section",
+			entry:         bulletin[2],
+			wantOffset:    44,
+			wantText:      "10952 31081 10300 92230 10290",
+			wantTelegrams: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.entry.Offset != tt.wantOffset || tt.entry.Text != tt.wantText {
+				t.Errorf("entry = %d %q, want %d %q", tt.entry.Offset, tt.entry.Text, tt.wantOffset, tt.wantText)
+			}
+			if (tt.entry.Err != nil) != tt.wantErr {
+				t.Errorf("entry error = %v, wantErr %v", tt.entry.Err, tt.wantErr)
+			}
+			if len(tt.entry.Telegrams) != tt.wantTelegrams {
+				t.Errorf("entry has %d telegrams, want %d", len(tt.entry.Telegrams), tt.wantTelegrams)
+			}
+		})
+	}
+
+	decodeErr, ok := bulletin[1].Err.(*DecodeError)
+	if !ok || decodeErr.Offset != 37 || decodeErr.Block != 2 {
+		t.Errorf("bad telegram error = %+v, want block 2 at offset 37", bulletin[1].Err)
+	}
+}
+
+func TestNewtelegramsSliceBulletin(t *testing.T) {
+
+	telegrams, err := NewtelegramsSlice("10950 31081 10245= 10951 31081 10300 92230 10290=")
+	if err != nil {
+		t.Fatalf("NewtelegramsSlice() error = %v", err)
+	}
+
+	var postCodes []string
+	for _, telegram := range telegrams {
+		postCodes = append(postCodes, string(telegram.PostCode))
+	}
+
+	want := []string{"10950", "10951", "10951"}
+	if len(postCodes) != len(want) || postCodes[0] != want[0] || postCodes[1] != want[1] || postCodes[2] != want[2] {
+		t.Errorf("NewtelegramsSlice() post codes = %v, want %v", postCodes, want)
+	}
+
+	if _, err := NewtelegramsSlice(" = \n="); err == nil {
+		t.Errorf("NewtelegramsSlice() on empty bulletin expected error")
+	}
+}
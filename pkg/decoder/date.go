@@ -0,0 +1,90 @@
+package decoder
+
+import "time"
+
+// maxResolveMonths bounds the search for a month that has the requested
+// day. The reference month and the one before it may both be skipped, as
+// for day 31 in mid-March, but two months in a row never lack a day, so
+// the third month back always has it.
+const maxResolveMonths = 3
+
+// ResolveDate places a KN-15 day of month and hour in time. It returns the
+// latest date not after the date of reference that falls on day, skipping
+// months without such a day, at the given hour in reference's location.
+// Only dates are compared, so an observation made later on the reference
+// day still resolves to that day.
+func ResolveDate(reference time.Time, day, hour byte) (time.Time, error) {
+
+	if err := checkDayAndHour(day, hour); err != nil {
+		return time.Time{}, err
+	}
+
+	year, month, _ := reference.Date()
+	referenceDate := truncateToDate(reference)
+
+	for i := 0; i < maxResolveMonths; i++ {
+		date, ok := dateOf(year, month-time.Month(i), day, hour, reference.Location())
+		if !ok || truncateToDate(date).After(referenceDate) {
+			continue
+		}
+
+		return date, nil
+	}
+
+	return time.Time{}, newDecodeError(CodeInvalidDay, "no month has day %d", day)
+}
+
+// ResolveMonthDate places a KN-15 month, day of month and hour in time, as
+// the 966 measurement time is given. It returns the date in the latest year
+// whose month and day are not after the date of reference, and fails when
+// that month has no such day.
+func ResolveMonthDate(reference time.Time, month, day, hour byte) (time.Time, error) {
+
+	if month < 1 || month > 12 {
+		return time.Time{}, newDecodeError(CodeInvalidMonth, "month must be from 1 to 12")
+	}
+
+	if err := checkDayAndHour(day, hour); err != nil {
+		return time.Time{}, err
+	}
+
+	year, referenceMonth, referenceDay := reference.Date()
+	if time.Month(month) > referenceMonth || (time.Month(month) == referenceMonth && int(day) > referenceDay) {
+		year--
+	}
+
+	date, ok := dateOf(year, time.Month(month), day, hour, reference.Location())
+	if !ok {
+		return time.Time{}, newDecodeError(CodeInvalidDay, "%s %d has no day %d", time.Month(month), year, day)
+	}
+
+	return date, nil
+}
+
+func checkDayAndHour(day, hour byte) error {
+
+	if day < 1 || day > 31 {
+		return newDecodeError(CodeInvalidDay, "day must be from 1 to 31")
+	}
+
+	if hour > 23 {
+		return newDecodeError(CodeInvalidHour, "hour must be from 0 to 23")
+	}
+
+	return nil
+}
+
+// dateOf returns day of month at hour and reports whether month has that
+// day. month may be out of range and is normalized as by time.Date.
+func dateOf(year int, month time.Month, day, hour byte, location *time.Location) (time.Time, bool) {
+
+	first := time.Date(year, month, 1, int(hour), 0, 0, 0, location)
+	date := first.AddDate(0, 0, int(day)-1)
+
+	return date, date.Month() == first.Month()
+}
+
+func truncateToDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,156 @@
+package decoder
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResolveDate(t *testing.T) {
+	reference := time.Date(2024, time.March, 1, 7, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		day     byte
+		hour    byte
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name: "Reference day",
+			day:  1,
+			hour: 8,
+			want: time.Date(2024, time.March, 1, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Previous month",
+			day:  28,
+			hour: 8,
+			want: time.Date(2024, time.February, 28, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Leap day",
+			day:  29,
+			hour: 20,
+			want: time.Date(2024, time.February, 29, 20, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Day missing in previous month",
+			day:  31,
+			hour: 8,
+			want: time.Date(2024, time.January, 31, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "Invalid day",
+			day:     0,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid hour",
+			day:     1,
+			hour:    24,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveDate(reference, tt.day, tt.hour)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ResolveDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveMonthDate(t *testing.T) {
+	reference := time.Date(2024, time.January, 5, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		month   byte
+		day     byte
+		hour    byte
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:  "Reference month",
+			month: 1,
+			day:   5,
+			hour:  10,
+			want:  time.Date(2024, time.January, 5, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Previous year",
+			month: 12,
+			day:   28,
+			hour:  10,
+			want:  time.Date(2023, time.December, 28, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Later day of reference month",
+			month: 1,
+			day:   6,
+			hour:  10,
+			want:  time.Date(2023, time.January, 6, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "Day missing in month",
+			month:   2,
+			day:     31,
+			wantErr: true,
+		},
+		{
+			name:    "Leap day in common year",
+			month:   2,
+			day:     29,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid month",
+			month:   13,
+			day:     1,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveMonthDate(reference, tt.month, tt.day, tt.hour)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveMonthDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ResolveMonthDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodePreviousDayDates(t *testing.T) {
+	reference := time.Date(2024, time.March, 1, 21, 0, 0, 0, time.UTC)
+
+	telegrams, _, err := DecodeSlice("10950 01201 10245 92229 10240 92228 10235=", ReferenceTime(reference))
+	if err != nil {
+		t.Fatalf("DecodeSlice() error = %v", err)
+	}
+
+	want := []time.Time{
+		time.Date(2024, time.March, 1, 20, 0, 0, 0, time.UTC),
+		time.Date(2024, time.February, 29, 20, 0, 0, 0, time.UTC),
+		time.Date(2024, time.February, 28, 20, 0, 0, 0, time.UTC),
+	}
+
+	if len(telegrams) != len(want) {
+		t.Fatalf("DecodeSlice() returned %d telegrams, want %d", len(telegrams), len(want))
+	}
+
+	for i, telegram := range telegrams {
+		if !telegram.DateTime.Equal(want[i]) {
+			t.Errorf("telegram %d DateTime = %v, want %v", i, telegram.DateTime, want[i])
+		}
+	}
+}
@@ -1,12 +1,11 @@
 package decoder
 
 import (
-	"fmt"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
-
-	"regexp"
+	"time"
 
 	types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
 )
@@ -14,214 +13,343 @@ import (
 type Telegram struct {
 	PostCode types.PostCode
 	types.DateAndTime
+	// DateTime is the observation time resolved from DateAndTime with
+	// ResolveDate.
+	DateTime                   time.Time
 	IsDangerous                types.IsDangerous
-	WaterLevelOnTime           *types.WaterLevelOnTime
-	DeltaWaterLevel            *types.DeltaWaterLevel
-	WaterLevelOn20h            *types.WaterLevelOn20h
+	WaterLevelOnTime           types.Measurement[types.WaterLevelOnTime]
+	DeltaWaterLevel            types.Measurement[types.DeltaWaterLevel]
+	WaterLevelOn20h            types.Measurement[types.WaterLevelOn20h]
 	Temperature                *types.Temperature
 	IcePhenomeniaState         *types.IcePhenomeniaState
 	IcePhenomenia              []*types.Phenomenia
 	IceInfo                    *types.IceInfo
-	Waterflow                  *types.Waterflow
+	Waterflow                  types.Measurement[types.Waterflow]
 	Precipitation              *types.Precipitation
 	IsReservoirDate            *types.IsReservoirDate
 	Reservoir                  *types.Reservoir
 	IsReservoirWaterInflowDate *types.IsReservoirWaterInflowDate
 	ReservoirWaterInflow       *types.ReservoirWaterInflow
+	IsMeasuredDischargeMonth   *types.IsMeasuredDischargeMonth
+	MeasuredDischarge          *types.MeasuredDischarge
+	IgnoredGroups              []IgnoredGroup
+
+	values *telegramValues
+}
+
+// inlinePhenomena is the number of phenomena a decoded telegram holds
+// without further allocations; KN-15 telegrams rarely carry more than two
+// 5 groups.
+const inlinePhenomena = 4
+
+// telegramValues holds what the pointer fields of a decoded Telegram point
+// to. It is allocated together with the telegram, so decoding a group does
+// not allocate.
+type telegramValues struct {
+	temperature        types.Temperature
+	icePhenomeniaState types.IcePhenomeniaState
+	phenomena          [inlinePhenomena]types.Phenomenia
+	intensities        [inlinePhenomena]byte
+	phenomenaPointers  [inlinePhenomena]*types.Phenomenia
+	phenomenaCount     int
+	iceInfo            types.IceInfo
+	precipitation      types.Precipitation
+	reservoirDate      types.IsReservoirDate
+	reservoir          types.Reservoir
+	inflowDate         types.IsReservoirWaterInflowDate
+	inflow             types.ReservoirWaterInflow
+	dischargeMonth     types.IsMeasuredDischargeMonth
+	discharge          types.MeasuredDischarge
+	measurementTime    types.MeasurementTime
+}
+
+type telegramBlock struct {
+	telegram Telegram
+	values   telegramValues
+}
+
+// newTelegram returns an empty telegram allocated together with its values.
+func newTelegram() *Telegram {
+
+	block := &telegramBlock{}
+	block.telegram.values = &block.values
+
+	return &block.telegram
+}
+
+// storage returns the values of t, allocating them for telegrams that were
+// not created by newTelegram.
+func (t *Telegram) storage() *telegramValues {
+
+	if t.values == nil {
+		t.values = &telegramValues{}
+	}
+
+	return t.values
+}
+
+// IgnoredGroup is a group that was skipped because it is not recognized in
+// its section. It is not carried over when the telegram is encoded again.
+type IgnoredGroup struct {
+	Group   string
+	Section Section
+	Block   int
+	Offset  int
 }
 
 func NewTelegram(s string) (*Telegram, error) {
+	telegram, _, err := Decode(s)
+	return telegram, err
+}
+
+func NewtelegramsSlice(s string) ([]*Telegram, error) {
+	telegrams, _, err := DecodeSlice(s)
+	return telegrams, err
+}
+
+// Decode decodes a single telegram. Without options it fails on the first
+// bad group; with Lenient it returns the partially decoded telegram and
+// reports every bad group in the Report instead.
+func Decode(s string, opts ...Option) (*Telegram, *Report, error) {
 
-	codeBlocks, err := parseString(s)
+	report := &Report{}
+
+	o := newOptions(opts)
+
+	telegram, err := decodeSequence(parseString(s), SectionMain, o.referenceTime(), o, report)
 	if err != nil {
-		return nil, err
+		return nil, report, err
 	}
-	telegram := &Telegram{}
 
-	var isReservoir, isResevoirInflow = false, false
+	return telegram, report, nil
+}
 
-	for i, block := range codeBlocks {
+// DecodeSlice decodes every telegram of a bulletin together with their 922
+// sections, one Telegram per station and observation day. Problems of all
+// telegrams are collected in a single Report.
+func DecodeSlice(s string, opts ...Option) ([]*Telegram, *Report, error) {
 
-		if i == 0 {
-			err := telegram.postCodeInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
-		}
-		if i == 1 {
-			err := telegram.dateAndTimeInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
-		}
-		if i == 2 && block[:3] == "977" {
-			err := telegram.isDangerousInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
-		}
-		if block[0] == '1' && !isReservoir && !isResevoirInflow {
-			err := telegram.waterLevelOnTimeInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
-		}
-		if block[0] == '2' && !isReservoir && !isResevoirInflow {
-			err := telegram.deltaWaterLevelInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
+	report := &Report{}
 
-		}
-		if block[0] == '3' && !isReservoir && !isResevoirInflow {
-			err := telegram.waterLevelOn20hInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
-		}
+	bulletin, err := DecodeBulletin(s, opts...)
+	if err != nil {
+		return nil, report, err
+	}
 
-		if block[0] == '4' && !isReservoir && !isResevoirInflow {
-			err := telegram.temperatureInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
-		}
+	var decodedTelegrams []*Telegram
 
-		if block[0] == '5' && !isReservoir && !isResevoirInflow {
-			err := telegram.phenomeniaAppend(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
-		}
+	for _, entry := range bulletin {
+		report.Errors = append(report.Errors, entry.Report.Errors...)
+		report.Warnings = append(report.Warnings, entry.Report.Warnings...)
 
-		if block[0] == '6' && !isReservoir && !isResevoirInflow {
-			err := telegram.icePhenomeniaStateInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
+		if entry.Err != nil {
+			return nil, report, entry.Err
 		}
+		decodedTelegrams = append(decodedTelegrams, entry.Telegrams...)
+	}
 
-		if block[0] == '7' && !isReservoir && !isResevoirInflow {
-			err := telegram.iceInfoInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
-		}
-		if block[0] == '8' && !isReservoir && !isResevoirInflow {
-			err := telegram.waterflowInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
-		}
-		if block[0] == '0' && !isReservoir && !isResevoirInflow {
-			err := telegram.precipitationInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
-		}
-		if block[:3] == "944" && !isReservoir && !isResevoirInflow {
-			err := telegram.isReservoirInit(block)
-			if err != nil {
-				return nil, err
-			}
-			isReservoir = true
-			continue
-		}
-		if block[0] == '1' && isReservoir && !isResevoirInflow {
-			err := telegram.headwaterLevelInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
-		}
-		if block[0] == '2' && isReservoir && !isResevoirInflow {
-			err := telegram.averageReservoirLevelInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
+	return decodedTelegrams, report, nil
+}
+
+func decodeSequences(groups []group, buffers *groupBuffers, o *options, report *Report) ([]*Telegram, error) {
+
+	var sequences = buffers.splitSequence(groups)
+	decodedTelegrams := make([]*Telegram, 0, len(sequences))
+
+	// 922 sections report days before the main telegram, so their dates
+	// are resolved against its date rather than the reference time.
+	reference := o.referenceTime()
+
+	for _, sequence := range sequences {
+		decoded, err := decodeSequence(sequence.groups, sequence.section, reference, o, report)
+		if err != nil {
+			return nil, err
 		}
-		if block[0] == '4' && isReservoir && !isResevoirInflow {
-			err := telegram.downstreamLevelInit(block)
-			if err != nil {
-				return nil, err
-			}
-			continue
+		if sequence.section == SectionMain && !decoded.DateTime.IsZero() {
+			reference = decoded.DateTime
 		}
-		if block[0] == '7' && isReservoir && !isResevoirInflow {
-			err := telegram.reservoirVolumeInit(block)
-			if err != nil {
-				return nil, err
+		decodedTelegrams = append(decodedTelegrams, decoded)
+	}
+	if len(decodedTelegrams) == 0 {
+		return nil, newDecodeError(CodeEmptyTelegram, "telegram must contain at least a post code and a date group")
+	}
+	return decodedTelegrams, nil
+}
+
+func decodeSequence(groups []group, section Section, reference time.Time, o *options, report *Report) (*Telegram, error) {
+
+	telegram := newTelegram()
+	var seen seenGroups
+
+	for i, g := range groups {
+
+		kind, next, err := telegram.decodeGroup(i, g.value, section, reference)
+		section = next
+
+		if kind == KindUnknown {
+			unknown := locate(newDecodeError(CodeUnknownGroup, "group is not recognized in this section"), g, section)
+			if o.strict && !o.lenient {
+				return nil, unknown
 			}
-			continue
-		}
-		if block[:3] == "955" && !isResevoirInflow {
-			err := telegram.reservoirWaterInflowInit(block)
-			if err != nil {
-				return nil, err
+			if o.strict {
+				report.addError(unknown)
+			} else {
+				report.addWarning(unknown)
 			}
-			isResevoirInflow = true
+			telegram.IgnoredGroups = append(telegram.IgnoredGroups, IgnoredGroup{
+				Group:   g.source,
+				Section: section,
+				Block:   g.index,
+				Offset:  g.offset,
+			})
 			continue
 		}
-		if block[0] == '4' && isResevoirInflow {
-			err := telegram.inflowInit(block)
-			if err != nil {
-				return nil, err
+
+		if err != nil {
+			if !o.lenient {
+				return nil, locate(err, g, section)
 			}
+			report.addError(locate(err, g, section))
 			continue
 		}
-		if block[0] == '7' && isResevoirInflow {
-			err := telegram.resetInit(block)
-			if err != nil {
-				return nil, err
+
+		// Phenomenon groups of the main sections repeat, every other
+		// identifier is set once per section.
+		if i > 1 && (g.value[0] != '5' || (section != SectionMain && section != SectionPreviousDay)) {
+			if seen.add(section, g.value[0]) {
+				report.addWarning(locate(newDecodeError(CodeDuplicateGroup, "group overrides a value already set in this section"), g, section))
 			}
-			continue
 		}
 	}
 
 	return telegram, nil
 }
 
-func NewtelegramsSlice(s string) ([]*Telegram, error) {
+// seenGroups records which group identifiers were decoded in each section
+// of a sequence.
+type seenGroups [5]uint16
+
+// add marks identifier as seen in section and reports whether it already
+// was.
+func (s *seenGroups) add(section Section, identifier byte) bool {
+
+	var i int
+	switch section {
+	case SectionMain:
+		i = 0
+	case SectionPreviousDay:
+		i = 1
+	case SectionReservoir:
+		i = 2
+	case SectionReservoirInflow:
+		i = 3
+	case SectionMeasuredDischarge:
+		i = 4
+	}
+
+	bit := uint16(1) << (identifier - '0')
+	seen := s[i]&bit != 0
+	s[i] |= bit
+
+	return seen
+}
 
-	var telegrams = splitSequence(s)
-	var decodedTelegrams []*Telegram
+// decodeGroup recognizes block, the i-th group of a sequence in section,
+// and decodes it into t. It returns the kind of the group and the section
+// the following groups belong to. A group that is not recognized is left
+// untouched and reported as KindUnknown.
+func (t *Telegram) decodeGroup(i int, block string, section Section, reference time.Time) (GroupKind, Section, error) {
 
-	for _, telegramStr := range telegrams {
-		decoded, err := NewTelegram(telegramStr)
-		if err != nil {
-			return nil, err
-		}
-		decodedTelegrams = append(decodedTelegrams, decoded)
-	}
-	if len(decodedTelegrams) == 0 {
-		return nil, fmt.Errorf("Incorrect data")
+	if err := checkCodeBlock(block); err != nil {
+		return KindInvalid, section, err
 	}
-	return decodedTelegrams, nil
+
+	isMainSection := section == SectionMain || section == SectionPreviousDay
+
+	switch {
+	case i == 0:
+		return KindPostCode, section, t.postCodeInit(block)
+	case i == 1:
+		kind := KindDate
+		if section == SectionPreviousDay {
+			kind = KindPreviousDay
+		}
+		err := t.dateAndTimeInit(block)
+		if err == nil {
+			t.DateTime, err = ResolveDate(reference, t.Date, t.Time)
+		}
+		return kind, section, err
+	case i == 2 && block[:3] == "977":
+		return KindDangerous, section, t.isDangerousInit(block)
+	case block[0] == '1' && isMainSection:
+		return KindWaterLevel, section, t.waterLevelOnTimeInit(block)
+	case block[0] == '2' && isMainSection:
+		return KindDeltaWaterLevel, section, t.deltaWaterLevelInit(block)
+	case block[0] == '3' && isMainSection:
+		return KindWaterLevel20h, section, t.waterLevelOn20hInit(block)
+	case block[0] == '4' && isMainSection:
+		return KindTemperature, section, t.temperatureInit(block)
+	case block[0] == '5' && isMainSection:
+		return KindPhenomenon, section, t.phenomeniaAppend(block)
+	case block[0] == '6' && isMainSection:
+		return KindIcePhenomeniaState, section, t.icePhenomeniaStateInit(block)
+	case block[0] == '7' && isMainSection:
+		return KindIceInfo, section, t.iceInfoInit(block)
+	case block[0] == '8' && isMainSection:
+		return KindWaterflow, section, t.waterflowInit(block)
+	case block[0] == '0' && isMainSection:
+		return KindPrecipitation, section, t.precipitationInit(block)
+	case block[:3] == "944" && isMainSection:
+		return KindReservoirMarker, SectionReservoir, t.isReservoirInit(block)
+	case block[0] == '1' && section == SectionReservoir:
+		return KindHeadwaterLevel, section, t.headwaterLevelInit(block)
+	case block[0] == '2' && section == SectionReservoir:
+		return KindAverageReservoirLevel, section, t.averageReservoirLevelInit(block)
+	case block[0] == '4' && section == SectionReservoir:
+		return KindDownstreamLevel, section, t.downstreamLevelInit(block)
+	case block[0] == '7' && section == SectionReservoir:
+		return KindReservoirVolume, section, t.reservoirVolumeInit(block)
+	case block[:3] == "955" && (isMainSection || section == SectionReservoir):
+		return KindInflowMarker, SectionReservoirInflow, t.reservoirWaterInflowInit(block)
+	case block[0] == '4' && section == SectionReservoirInflow:
+		return KindInflow, section, t.inflowInit(block)
+	case block[0] == '7' && section == SectionReservoirInflow:
+		return KindReset, section, t.resetInit(block)
+	case block[:3] == "966" && section != SectionMeasuredDischarge:
+		return KindDischargeMarker, SectionMeasuredDischarge, t.measuredDischargeInit(block)
+	case block[0] == '1' && section == SectionMeasuredDischarge:
+		return KindMeasuredWaterLevel, section, t.measuredWaterLevelInit(block)
+	case block[0] == '2' && section == SectionMeasuredDischarge:
+		return KindDischarge, section, t.dischargeInit(block)
+	case block[0] == '3' && section == SectionMeasuredDischarge:
+		return KindCrossSectionArea, section, t.crossSectionAreaInit(block)
+	case block[0] == '4' && section == SectionMeasuredDischarge:
+		return KindAverageVelocity, section, t.averageVelocityInit(block)
+	case block[0] == '5' && section == SectionMeasuredDischarge:
+		return KindMaxDepth, section, t.maxDepthInit(block)
+	case block[0] == '6' && section == SectionMeasuredDischarge:
+		return KindMeasurementTime, section, t.measurementTimeInit(block)
+	}
+
+	return KindUnknown, section, nil
 }
 
+// errInvalidGroup is shared by every malformed group so that checking a
+// group never allocates; locate copies it before a position is attached.
+var errInvalidGroup = newDecodeError(CodeInvalidGroup, "group must be exactly 5 characters long and consist of digits or '/'")
+
 func checkCodeBlock(s string) error {
 
-	matched, err := regexp.MatchString(`^[0-9/]{5}$`, s)
-	if err != nil {
-		return fmt.Errorf("error while matching regex: %v", err)
+	if len(s) != 5 {
+		return errInvalidGroup
 	}
 
-	if !matched {
-		return fmt.Errorf("the string must be exactly 5 characters long and consist of a digit followed by either four digits or four slashes")
+	for i := 0; i < len(s); i++ {
+		if (s[i] < '0' || s[i] > '9') && s[i] != '/' {
+			return errInvalidGroup
+		}
 	}
+
 	return nil
 }
 
@@ -244,18 +372,18 @@ func (t *Telegram) dateAndTimeInit(s string) error {
 	}
 
 	day, err := strconv.Atoi(s[:2])
-	if err != nil || day > 31 {
-		return fmt.Errorf("invalid day value")
+	if err != nil || day < 1 || day > 31 {
+		return newDecodeError(CodeInvalidDay, "invalid day value")
 	}
 
 	hour, err := strconv.Atoi(s[2:4])
 	if err != nil || hour > 23 {
-		return fmt.Errorf("invalid hour value")
+		return newDecodeError(CodeInvalidHour, "invalid hour value")
 	}
 
 	endBlockNum, err := strconv.Atoi(s[4:])
 	if err != nil || endBlockNum < 0 || endBlockNum > 7 {
-		return fmt.Errorf("invalid endBlockNum value")
+		return newDecodeError(CodeInvalidEndBlockNum, "invalid end block number")
 	}
 
 	t.DateAndTime = types.DateAndTime{
@@ -274,7 +402,7 @@ func (t *Telegram) isDangerousInit(s string) error {
 		return err
 	}
 	if s != "97701" {
-		return fmt.Errorf("invalid 977 data")
+		return newDecodeError(CodeInvalidDangerGroup, "977 group must be 97701")
 	}
 
 	t.IsDangerous = types.IsDangerous(true)
@@ -289,26 +417,24 @@ func (t *Telegram) waterLevelOnTimeInit(s string) error {
 	}
 
 	if s[0] != '1' {
-		return fmt.Errorf("first character must be '1'")
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '1'")
 	}
 
 	if s[1:] == "////" {
-		waterlevelOnT := types.WaterLevelOnTime(types.CouldNotMeasure)
-		t.WaterLevelOnTime = &waterlevelOnT
+		t.WaterLevelOnTime = types.NewNotMeasured[types.WaterLevelOnTime]()
 		return nil
 	}
 
 	waterlevel, err := strconv.Atoi(s[1:])
 	if err != nil {
-		return fmt.Errorf("invalid waterlavel value")
+		return newDecodeError(CodeInvalidValue, "invalid water level value")
 	}
 
 	if waterlevel > 5000 && waterlevel < 6000 {
 		waterlevel = 0 - waterlevel + 5000
 	}
 
-	waterlevelOnT := types.WaterLevelOnTime(waterlevel)
-	t.WaterLevelOnTime = &waterlevelOnT
+	t.WaterLevelOnTime = types.NewMeasured(types.WaterLevelOnTime(waterlevel))
 
 	return nil
 }
@@ -321,22 +447,21 @@ func (t *Telegram) deltaWaterLevelInit(s string) error {
 	}
 
 	if s[0] != '2' {
-		return fmt.Errorf("first character must be '2'")
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '2'")
 	}
 
 	if s[1:] == "////" {
-		deltaWl := types.DeltaWaterLevel(types.CouldNotMeasure)
-		t.DeltaWaterLevel = &deltaWl
+		t.DeltaWaterLevel = types.NewNotMeasured[types.DeltaWaterLevel]()
 		return nil
 	}
 
 	if s[4] != '1' && s[4] != '2' {
-		return fmt.Errorf("last character must be '1' or '2'")
+		return newDecodeError(CodeInvalidSign, "last character must be '1' or '2'")
 	}
 
 	delta, err := strconv.Atoi(s[1:4])
 	if err != nil {
-		return fmt.Errorf("invalid waterlavel value")
+		return newDecodeError(CodeInvalidValue, "invalid water level value")
 	}
 
 	if s[4] == '1' {
@@ -345,8 +470,7 @@ func (t *Telegram) deltaWaterLevelInit(s string) error {
 		delta = 0 - delta
 	}
 
-	deltaWL := types.DeltaWaterLevel(delta)
-	t.DeltaWaterLevel = &deltaWL
+	t.DeltaWaterLevel = types.NewMeasured(types.DeltaWaterLevel(delta))
 
 	return nil
 }
@@ -359,26 +483,24 @@ func (t *Telegram) waterLevelOn20hInit(s string) error {
 	}
 
 	if s[0] != '3' {
-		return fmt.Errorf("first character must be '3'")
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '3'")
 	}
 
 	if s[1:] == "////" {
-		waterlevel20H := types.WaterLevelOn20h(types.CouldNotMeasure)
-		t.WaterLevelOn20h = &waterlevel20H
+		t.WaterLevelOn20h = types.NewNotMeasured[types.WaterLevelOn20h]()
 		return nil
 	}
 
 	waterlevel, err := strconv.Atoi(s[1:])
 	if err != nil {
-		return fmt.Errorf("invalid waterlavel value")
+		return newDecodeError(CodeInvalidValue, "invalid water level value")
 	}
 
 	if waterlevel > 5000 && waterlevel < 6000 {
 		waterlevel = 0 - waterlevel + 5000
 	}
 
-	waterlevel20H := types.WaterLevelOn20h(waterlevel)
-	t.WaterLevelOn20h = &waterlevel20H
+	t.WaterLevelOn20h = types.NewMeasured(types.WaterLevelOn20h(waterlevel))
 
 	return nil
 }
@@ -392,44 +514,37 @@ func (t *Telegram) temperatureInit(s string) error {
 	}
 
 	if s[0] != '4' {
-		return fmt.Errorf("first character must be '4'")
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '4'")
 	}
 
-	var waterTempPtr *float64
+	var temperature types.Temperature
 
 	if s[1:3] == "//" {
-		waterTemp := float64(types.CouldNotMeasure)
-		waterTempPtr = &waterTemp
+		temperature.WaterTemperature = types.NewNotMeasured[float64]()
 	} else {
 		waterTemp, err := strconv.Atoi(s[1:3])
 		if err != nil {
-			return fmt.Errorf("Invalid water temperature value")
+			return newDecodeError(CodeInvalidValue, "invalid water temperature value")
 		}
-		waterTempFloat := float64(waterTemp) / 10.0
-		waterTempPtr = &waterTempFloat
+		temperature.WaterTemperature = types.NewMeasured(float64(waterTemp) / 10.0)
 	}
 
-	var airTempPtr *int32
-
 	if s[3:] == "//" {
-		airTemp := int32(types.CouldNotMeasure)
-		airTempPtr = &airTemp
+		temperature.AirTemperature = types.NewNotMeasured[int32]()
 	} else {
 		airTemp, err := strconv.Atoi(s[3:])
 		if err != nil {
-			return fmt.Errorf("Invalid air temperature value")
+			return newDecodeError(CodeInvalidValue, "invalid air temperature value")
 		}
 		if airTemp > 50 {
 			airTemp = 0 - airTemp + 50
 		}
-		airTempInt := int32(airTemp)
-		airTempPtr = &airTempInt
+		temperature.AirTemperature = types.NewMeasured(int32(airTemp))
 	}
 
-	t.Temperature = &types.Temperature{
-		WaterTemperature: waterTempPtr,
-		AirTemperature:   airTempPtr,
-	}
+	values := t.storage()
+	values.temperature = temperature
+	t.Temperature = &values.temperature
 
 	return nil
 }
@@ -442,66 +557,103 @@ func (t *Telegram) phenomeniaAppend(s string) error {
 	}
 
 	if s[0] != '5' {
-		return fmt.Errorf("first character must be '5'")
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '5'")
 	}
 
 	state := types.IcePhenomeniaState(0)
 
 	if s[1:] == "////" {
-		t.IcePhenomeniaState = &state
+		t.setIcePhenomeniaState(state)
 		return nil
 	}
 
 	firstPhenomenia, err := strconv.Atoi(s[1:3])
 	if err != nil {
-		return fmt.Errorf("Ivalid phenomenia value")
+		return newDecodeError(CodeInvalidValue, "invalid phenomenon value")
 	}
 
 	secondPhenomenia, err := strconv.Atoi(s[3:])
 	if err != nil {
-		return fmt.Errorf("Ivalid phenomenia value")
+		return newDecodeError(CodeInvalidValue, "invalid phenomenon value")
 	}
 
-	t.IcePhenomeniaState = &state
+	if err := checkPhenomenon(firstPhenomenia); err != nil {
+		return err
+	}
 
-	if firstPhenomenia == secondPhenomenia {
+	t.setIcePhenomeniaState(state)
 
-		phenomen := types.Phenomenia{
-			Phenomen:    byte(firstPhenomenia),
-			IsUntensity: false,
-			Intensity:   nil,
-		}
+	if firstPhenomenia == secondPhenomenia {
 
-		t.IcePhenomenia = append(t.IcePhenomenia, &phenomen)
+		t.appendPhenomenon(firstPhenomenia, 0)
 		return nil
 	}
 
 	if secondPhenomenia < 11 {
 
-		secondPhenomeniaByte := byte(secondPhenomenia)
+		if info, _ := types.LookupPhenomenon(types.PhenomenonCode(firstPhenomenia)); !info.IntensityAllowed {
+			return newDecodeError(CodeInvalidIntensity, "phenomenon %02d is given without intensity", firstPhenomenia)
+		}
 
-		phenomen := types.Phenomenia{
-			Phenomen:    byte(firstPhenomenia),
-			IsUntensity: true,
-			Intensity:   &secondPhenomeniaByte,
+		if secondPhenomenia < 1 {
+			return newDecodeError(CodeInvalidIntensity, "intensity must be from 1 to %d", types.MaxPhenomenonIntensity)
 		}
 
-		t.IcePhenomenia = append(t.IcePhenomenia, &phenomen)
+		t.appendPhenomenon(firstPhenomenia, secondPhenomenia)
 		return nil
 	}
 
-	phenomens := []*types.Phenomenia{
-		{
-			Phenomen:    byte(firstPhenomenia),
-			IsUntensity: false,
-		},
-		{
-			Phenomen:    byte(secondPhenomenia),
-			IsUntensity: false,
-		},
+	if err := checkPhenomenon(secondPhenomenia); err != nil {
+		return err
+	}
+
+	t.appendPhenomenon(firstPhenomenia, 0)
+	t.appendPhenomenon(secondPhenomenia, 0)
+
+	return nil
+}
+
+// appendPhenomenon adds phenomenon code to t, with intensity unless it is 0.
+func (t *Telegram) appendPhenomenon(code int, intensity int) {
+
+	values := t.storage()
+
+	var phenomenon *types.Phenomenia
+	var intensityValue *byte
+	if n := values.phenomenaCount; n < len(values.phenomena) {
+		phenomenon, intensityValue = &values.phenomena[n], &values.intensities[n]
+		values.phenomenaCount++
+	} else {
+		phenomenon, intensityValue = new(types.Phenomenia), new(byte)
+	}
+
+	*phenomenon = types.Phenomenia{Phenomen: types.PhenomenonCode(code)}
+
+	if intensity != 0 {
+		*intensityValue = byte(intensity)
+		phenomenon.IsUntensity = true
+		phenomenon.Intensity = intensityValue
+	}
+
+	if t.IcePhenomenia == nil {
+		t.IcePhenomenia = values.phenomenaPointers[:0]
 	}
+	t.IcePhenomenia = append(t.IcePhenomenia, phenomenon)
+}
+
+func (t *Telegram) setIcePhenomeniaState(state types.IcePhenomeniaState) {
 
-	t.IcePhenomenia = append(t.IcePhenomenia, phenomens...)
+	values := t.storage()
+	values.icePhenomeniaState = state
+	t.IcePhenomeniaState = &values.icePhenomeniaState
+}
+
+// checkPhenomenon reports codes missing from the KN-15 phenomena table.
+func checkPhenomenon(code int) error {
+
+	if _, ok := types.LookupPhenomenon(types.PhenomenonCode(code)); !ok {
+		return newDecodeError(CodeInvalidPhenomenon, "unknown phenomenon code %02d", code)
+	}
 
 	return nil
 }
@@ -509,11 +661,10 @@ func (t *Telegram) phenomeniaAppend(s string) error {
 func (t *Telegram) icePhenomeniaStateInit(s string) error {
 
 	if s != "60000" {
-		return fmt.Errorf("Ivalid 6 group")
+		return newDecodeError(CodeInvalidIceState, "6 group must be 60000")
 	}
 
-	icePhenomeniaState := types.IcePhenomeniaState(1)
-	t.IcePhenomeniaState = &icePhenomeniaState
+	t.setIcePhenomeniaState(types.IcePhenomeniaState(1))
 	return nil
 }
 
@@ -525,39 +676,34 @@ func (t *Telegram) iceInfoInit(s string) error {
 	}
 
 	if s[0] != '7' {
-		return fmt.Errorf("first character must be '7'")
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '7'")
 	}
 
-	var iceHeightPtr *int32
+	var iceInfo types.IceInfo
+
 	if s[1:4] != "///" {
 		iceHeight, err := strconv.Atoi(s[1:4])
 		if err != nil {
-			return fmt.Errorf("Invalid ice height value")
+			return newDecodeError(CodeInvalidValue, "invalid ice height value")
 		}
-		iceHeightUint := int32(iceHeight)
-		iceHeightPtr = &iceHeightUint
+		iceInfo.Ice = types.NewMeasured(int32(iceHeight))
 	} else {
-		iceHeight := int32(types.CouldNotMeasure)
-		iceHeightPtr = &iceHeight
+		iceInfo.Ice = types.NewNotMeasured[int32]()
 	}
 
-	var snowHeightPtr *types.SnowHeight
 	if s[4] != '/' {
 		snowHeight, err := strconv.Atoi(s[4:])
 		if err != nil {
-			return fmt.Errorf("Invalid snow height value")
+			return newDecodeError(CodeInvalidValue, "invalid snow height value")
 		}
-		snowHeightbyte := types.SnowHeight(byte(snowHeight))
-		snowHeightPtr = &snowHeightbyte
+		iceInfo.Snow = types.NewMeasured(types.SnowHeight(byte(snowHeight)))
 	} else {
-		snowHeight := types.SnowHeight(types.CouldNotMeasureByte)
-		snowHeightPtr = &snowHeight
+		iceInfo.Snow = types.NewNotMeasured[types.SnowHeight]()
 	}
 
-	t.IceInfo = &types.IceInfo{
-		Ice:  iceHeightPtr,
-		Snow: snowHeightPtr,
-	}
+	values := t.storage()
+	values.iceInfo = iceInfo
+	t.IceInfo = &values.iceInfo
 
 	return nil
 }
@@ -570,23 +716,22 @@ func (t *Telegram) waterflowInit(s string) error {
 	}
 
 	if s[0] != '8' {
-		return fmt.Errorf("first characters must be '8'")
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '8'")
 	}
 
 	if s[1:] == "////" {
-		waterflow := types.Waterflow(types.CouldNotMeasure)
-		t.Waterflow = &waterflow
+		t.Waterflow = types.NewNotMeasured[types.Waterflow]()
 		return nil
 	}
 
 	factor, err := strconv.Atoi(s[1:2])
 	if err != nil || factor < 1 || factor > 5 {
-		return fmt.Errorf("Ivalid factor waterflow value")
+		return newDecodeError(CodeInvalidFactor, "waterflow factor must be from 1 to 5")
 	}
 
 	flow, err := strconv.Atoi(s[2:])
 	if err != nil {
-		return fmt.Errorf("Ivalid volume value")
+		return newDecodeError(CodeInvalidValue, "invalid waterflow value")
 	}
 
 	floatFlow := float64(flow)
@@ -595,8 +740,7 @@ func (t *Telegram) waterflowInit(s string) error {
 	}
 	floatFlow = math.Round(floatFlow) / 1000.0
 
-	waterflow := types.Waterflow(floatFlow)
-	t.Waterflow = &waterflow
+	t.Waterflow = types.NewMeasured(types.Waterflow(floatFlow))
 
 	return nil
 }
@@ -609,46 +753,40 @@ func (t *Telegram) precipitationInit(s string) error {
 	}
 
 	if s[0] != '0' {
-		return fmt.Errorf("first character must be '0'")
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '0'")
 	}
 
-	var valuePtr *float64
+	var precipitation types.Precipitation
+
 	if s[1:4] != "///" {
 		value, err := strconv.ParseFloat(s[1:4], 32)
 		if err != nil {
-			return fmt.Errorf("Invalid precipitation value")
+			return newDecodeError(CodeInvalidValue, "invalid precipitation value")
 		}
 
 		if value >= 990 {
 			value = math.Round((value - 990)) / 10
 		}
 
-		valueFloat := float64(value)
-		valuePtr = &valueFloat
+		precipitation.Value = types.NewMeasured(value)
 	} else {
-		valueFloat := float64(types.CouldNotMeasure)
-		valuePtr = &valueFloat
+		precipitation.Value = types.NewNotMeasured[float64]()
 	}
 
-	var durationPtr *types.PrecipitationDuration
-
 	if s[4:] != "/" {
 		duration, err := strconv.Atoi(s[4:])
 		if err != nil || duration < 0 || duration > 4 {
-			return fmt.Errorf("Invalid duration value")
+			return newDecodeError(CodeInvalidDuration, "precipitation duration must be from 0 to 4")
 		}
 
-		durationPrecip := types.PrecipitationDuration(duration)
-		durationPtr = &durationPrecip
+		precipitation.Duration = types.NewMeasured(types.PrecipitationDuration(duration))
 	} else {
-		durationPrecip := types.PrecipitationDuration(types.CouldNotMeasureByte)
-		durationPtr = &durationPrecip
+		precipitation.Duration = types.NewNotMeasured[types.PrecipitationDuration]()
 	}
 
-	t.Precipitation = &types.Precipitation{
-		Value:    valuePtr,
-		Duration: durationPtr,
-	}
+	values := t.storage()
+	values.precipitation = precipitation
+	t.Precipitation = &values.precipitation
 
 	return nil
 }
@@ -660,17 +798,19 @@ func (t *Telegram) isReservoirInit(s string) error {
 	}
 
 	if s[:3] != "944" {
-		return fmt.Errorf("Ivalid reservoir data")
+		return newDecodeError(CodeUnexpectedIdentifier, "reservoir group must start with 944")
 	}
 
 	date, err := strconv.Atoi(s[3:])
-	if err != nil || date > 31 {
-		return fmt.Errorf("invalid day value")
+	if err != nil || date < 1 || date > 31 {
+		return newDecodeError(CodeInvalidDay, "invalid day value")
 	}
 
-	reservoirDate := types.IsReservoirDate(date)
-	t.IsReservoirDate = &reservoirDate
-	t.Reservoir = &types.Reservoir{}
+	values := t.storage()
+	values.reservoirDate = types.IsReservoirDate(date)
+	values.reservoir = types.Reservoir{}
+	t.IsReservoirDate = &values.reservoirDate
+	t.Reservoir = &values.reservoir
 
 	return nil
 }
@@ -678,7 +818,7 @@ func (t *Telegram) isReservoirInit(s string) error {
 func (t *Telegram) headwaterLevelInit(s string) error {
 
 	if t.Reservoir == nil {
-		return fmt.Errorf("Reservoir data not init")
+		return newDecodeError(CodeSectionNotInitialized, "944 section is not initialized")
 	}
 
 	err := checkCodeBlock(s)
@@ -687,23 +827,21 @@ func (t *Telegram) headwaterLevelInit(s string) error {
 	}
 
 	if s[0] != '1' {
-		return fmt.Errorf("first character must be '1'")
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '1'")
 	}
 
 	if s[1:] == "////" {
-		headwaterLevel := types.HeadwaterLevel(types.CouldNotMeasure)
-		t.Reservoir.HeadwaterLevel = &headwaterLevel
+		t.Reservoir.HeadwaterLevel = types.NewNotMeasured[types.HeadwaterLevel]()
 
 		return nil
 	}
 
 	headwaterlevel, err := strconv.Atoi(s[1:])
 	if err != nil {
-		return fmt.Errorf("Ivalid headwater level value")
+		return newDecodeError(CodeInvalidValue, "invalid headwater level value")
 	}
 
-	headwaterLevel := types.HeadwaterLevel(headwaterlevel)
-	t.Reservoir.HeadwaterLevel = &headwaterLevel
+	t.Reservoir.HeadwaterLevel = types.NewMeasured(types.HeadwaterLevel(headwaterlevel))
 
 	return nil
 }
@@ -711,7 +849,7 @@ func (t *Telegram) headwaterLevelInit(s string) error {
 func (t *Telegram) averageReservoirLevelInit(s string) error {
 
 	if t.Reservoir == nil {
-		return fmt.Errorf("Reservoir data not init")
+		return newDecodeError(CodeSectionNotInitialized, "944 section is not initialized")
 	}
 
 	err := checkCodeBlock(s)
@@ -720,23 +858,21 @@ func (t *Telegram) averageReservoirLevelInit(s string) error {
 	}
 
 	if s[0] != '2' {
-		return fmt.Errorf("first character must be '2'")
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '2'")
 	}
 
 	if s[1:] == "////" {
-		averageReservoirLevel := types.AverageReservoirLevel(types.CouldNotMeasure)
-		t.Reservoir.AverageReservoirLevel = &averageReservoirLevel
+		t.Reservoir.AverageReservoirLevel = types.NewNotMeasured[types.AverageReservoirLevel]()
 
 		return nil
 	}
 
 	waterlevel, err := strconv.Atoi(s[1:])
 	if err != nil {
-		return fmt.Errorf("Ivalid avarage waterlevel value")
+		return newDecodeError(CodeInvalidValue, "invalid average reservoir level value")
 	}
 
-	averageReservoirLevel := types.AverageReservoirLevel(waterlevel)
-	t.Reservoir.AverageReservoirLevel = &averageReservoirLevel
+	t.Reservoir.AverageReservoirLevel = types.NewMeasured(types.AverageReservoirLevel(waterlevel))
 
 	return nil
 }
@@ -744,7 +880,7 @@ func (t *Telegram) averageReservoirLevelInit(s string) error {
 func (t *Telegram) downstreamLevelInit(s string) error {
 
 	if t.Reservoir == nil {
-		return fmt.Errorf("Reservoir data not init")
+		return newDecodeError(CodeSectionNotInitialized, "944 section is not initialized")
 	}
 
 	err := checkCodeBlock(s)
@@ -753,23 +889,21 @@ func (t *Telegram) downstreamLevelInit(s string) error {
 	}
 
 	if s[0] != '4' {
-		return fmt.Errorf("first characters must be '4'")
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '4'")
 	}
 
 	if s[1:] == "////" {
-		downstreamLevel := types.DownstreamLevel(types.CouldNotMeasure)
-		t.Reservoir.DownstreamLevel = &downstreamLevel
+		t.Reservoir.DownstreamLevel = types.NewNotMeasured[types.DownstreamLevel]()
 
 		return nil
 	}
 
 	waterlevel, err := strconv.Atoi(s[1:])
 	if err != nil {
-		return fmt.Errorf("Ivalid downstream level value")
+		return newDecodeError(CodeInvalidValue, "invalid downstream level value")
 	}
 
-	downstreamLevel := types.DownstreamLevel(waterlevel)
-	t.Reservoir.DownstreamLevel = &downstreamLevel
+	t.Reservoir.DownstreamLevel = types.NewMeasured(types.DownstreamLevel(waterlevel))
 
 	return nil
 }
@@ -777,7 +911,7 @@ func (t *Telegram) downstreamLevelInit(s string) error {
 func (t *Telegram) reservoirVolumeInit(s string) error {
 
 	if t.Reservoir == nil {
-		return fmt.Errorf("Reservoir data not init")
+		return newDecodeError(CodeSectionNotInitialized, "944 section is not initialized")
 	}
 
 	err := checkCodeBlock(s)
@@ -786,24 +920,23 @@ func (t *Telegram) reservoirVolumeInit(s string) error {
 	}
 
 	if s[0] != '7' {
-		return fmt.Errorf("first characters must be '7'")
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '7'")
 	}
 
 	if s[1:] == "////" {
-		reservoirVolume := types.ReservoirVolume(types.CouldNotMeasure)
-		t.Reservoir.ReservoirVolume = &reservoirVolume
+		t.Reservoir.ReservoirVolume = types.NewNotMeasured[types.ReservoirVolume]()
 
 		return nil
 	}
 
 	factor, err := strconv.Atoi(s[1:2])
 	if err != nil || factor < 1 || factor > 5 {
-		return fmt.Errorf("Ivalid factor volume value")
+		return newDecodeError(CodeInvalidFactor, "reservoir volume factor must be from 1 to 5")
 	}
 
 	volume, err := strconv.Atoi(s[2:])
 	if err != nil {
-		return fmt.Errorf("Ivalid volume value")
+		return newDecodeError(CodeInvalidValue, "invalid reservoir volume value")
 	}
 
 	floatVolume := float64(volume)
@@ -812,8 +945,7 @@ func (t *Telegram) reservoirVolumeInit(s string) error {
 	}
 	floatVolume = math.Round(floatVolume) / 1000
 
-	reservoirVolume := types.ReservoirVolume(floatVolume)
-	t.Reservoir.ReservoirVolume = &reservoirVolume
+	t.Reservoir.ReservoirVolume = types.NewMeasured(types.ReservoirVolume(floatVolume))
 
 	return nil
 }
@@ -825,17 +957,19 @@ func (t *Telegram) reservoirWaterInflowInit(s string) error {
 	}
 
 	if s[:3] != "955" {
-		return fmt.Errorf("Ivalid ReservoirWaterInflow data")
+		return newDecodeError(CodeUnexpectedIdentifier, "reservoir inflow group must start with 955")
 	}
 
 	date, err := strconv.Atoi(s[3:])
-	if err != nil || date > 31 {
-		return fmt.Errorf("invalid day value")
+	if err != nil || date < 1 || date > 31 {
+		return newDecodeError(CodeInvalidDay, "invalid day value")
 	}
 
-	reservoirWaterInflow := types.IsReservoirWaterInflowDate(date)
-	t.IsReservoirWaterInflowDate = &reservoirWaterInflow
-	t.ReservoirWaterInflow = &types.ReservoirWaterInflow{}
+	values := t.storage()
+	values.inflowDate = types.IsReservoirWaterInflowDate(date)
+	values.inflow = types.ReservoirWaterInflow{}
+	t.IsReservoirWaterInflowDate = &values.inflowDate
+	t.ReservoirWaterInflow = &values.inflow
 
 	return nil
 }
@@ -843,7 +977,7 @@ func (t *Telegram) reservoirWaterInflowInit(s string) error {
 func (t *Telegram) inflowInit(s string) error {
 
 	if t.ReservoirWaterInflow == nil {
-		return fmt.Errorf("Reservoir Water Inflow data not init")
+		return newDecodeError(CodeSectionNotInitialized, "955 section is not initialized")
 	}
 
 	err := checkCodeBlock(s)
@@ -852,23 +986,22 @@ func (t *Telegram) inflowInit(s string) error {
 	}
 
 	if s[0] != '4' {
-		return fmt.Errorf("first characters must be '4'")
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '4'")
 	}
 
 	if s[1:] == "////" {
-		inflowResp := types.Inflow(types.CouldNotMeasure)
-		t.ReservoirWaterInflow.Inflow = &inflowResp
+		t.ReservoirWaterInflow.Inflow = types.NewNotMeasured[types.Inflow]()
 		return nil
 	}
 
 	factor, err := strconv.Atoi(s[1:2])
 	if err != nil || factor < 1 || factor > 5 {
-		return fmt.Errorf("Ivalid factor inflow value")
+		return newDecodeError(CodeInvalidFactor, "inflow factor must be from 1 to 5")
 	}
 
 	inflow, err := strconv.Atoi(s[2:])
 	if err != nil {
-		return fmt.Errorf("Ivalid oflow value")
+		return newDecodeError(CodeInvalidValue, "invalid inflow value")
 	}
 
 	floatInflow := float64(inflow)
@@ -878,8 +1011,7 @@ func (t *Telegram) inflowInit(s string) error {
 
 	floatInflow = math.Round(floatInflow) / 1000
 
-	inflowResp := types.Inflow(floatInflow)
-	t.ReservoirWaterInflow.Inflow = &inflowResp
+	t.ReservoirWaterInflow.Inflow = types.NewMeasured(types.Inflow(floatInflow))
 
 	return nil
 }
@@ -887,7 +1019,7 @@ func (t *Telegram) inflowInit(s string) error {
 func (t *Telegram) resetInit(s string) error {
 
 	if t.ReservoirWaterInflow == nil {
-		return fmt.Errorf("955-блок не инициализирован")
+		return newDecodeError(CodeSectionNotInitialized, "955 section is not initialized")
 	}
 
 	err := checkCodeBlock(s)
@@ -896,24 +1028,23 @@ func (t *Telegram) resetInit(s string) error {
 	}
 
 	if s[0] != '7' {
-		return fmt.Errorf("Ошибка в блоке %s, блок должен начинаться с 7", s)
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '7'")
 	}
 
 	if s[1:] == "////" {
-		resetResp := types.Reset(types.CouldNotMeasure)
-		t.ReservoirWaterInflow.Reset = &resetResp
+		t.ReservoirWaterInflow.Reset = types.NewNotMeasured[types.Reset]()
 
 		return nil
 	}
 
 	factor, err := strconv.Atoi(s[1:2])
 	if err != nil || factor < 1 || factor > 5 {
-		return fmt.Errorf("Ошибка в блоке %s, Фактор должен быть от 1 до 5", s)
+		return newDecodeError(CodeInvalidFactor, "reset factor must be from 1 to 5")
 	}
 
 	reset, err := strconv.Atoi(s[2:])
 	if err != nil {
-		return fmt.Errorf("Ivalid reset value")
+		return newDecodeError(CodeInvalidValue, "invalid reset value")
 	}
 
 	floatReset := float64(reset)
@@ -922,54 +1053,395 @@ func (t *Telegram) resetInit(s string) error {
 	}
 	floatReset = math.Round(floatReset) / 1000
 
-	resetResp := types.Reset(floatReset)
-	t.ReservoirWaterInflow.Reset = &resetResp
+	t.ReservoirWaterInflow.Reset = types.NewMeasured(types.Reset(floatReset))
 
 	return nil
 }
 
-func parseString(input string) ([]string, error) {
+func (t *Telegram) measuredDischargeInit(s string) error {
+	err := checkCodeBlock(s)
+	if err != nil {
+		return err
+	}
+
+	if s[:3] != "966" {
+		return newDecodeError(CodeUnexpectedIdentifier, "measured discharge group must start with 966")
+	}
+
+	month, err := strconv.Atoi(s[3:])
+	if err != nil || month < 1 || month > 12 {
+		return newDecodeError(CodeInvalidMonth, "invalid month value")
+	}
 
-	input = strings.Split(input, "=")[0]
+	values := t.storage()
+	values.dischargeMonth = types.IsMeasuredDischargeMonth(month)
+	values.discharge = types.MeasuredDischarge{}
+	t.IsMeasuredDischargeMonth = &values.dischargeMonth
+	t.MeasuredDischarge = &values.discharge
 
-	substrings := strings.Fields(input)
+	return nil
+}
+
+func (t *Telegram) measuredWaterLevelInit(s string) error {
+
+	if t.MeasuredDischarge == nil {
+		return newDecodeError(CodeSectionNotInitialized, "966 section is not initialized")
+	}
 
-	for _, s := range substrings {
-		if len(s) != 5 {
-			return nil, fmt.Errorf("Неверный формат: подстрока '%s' должна иметь 5 символов", s)
+	err := checkCodeBlock(s)
+	if err != nil {
+		return err
+	}
+
+	if s[0] != '1' {
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '1'")
+	}
+
+	if s[1:] == "////" {
+		t.MeasuredDischarge.WaterLevel = types.NewNotMeasured[types.MeasuredWaterLevel]()
+		return nil
+	}
+
+	waterlevel, err := strconv.Atoi(s[1:])
+	if err != nil {
+		return newDecodeError(CodeInvalidValue, "invalid measured water level value")
+	}
+
+	if waterlevel > 5000 && waterlevel < 6000 {
+		waterlevel = 0 - waterlevel + 5000
+	}
+
+	t.MeasuredDischarge.WaterLevel = types.NewMeasured(types.MeasuredWaterLevel(waterlevel))
+
+	return nil
+}
+
+func (t *Telegram) dischargeInit(s string) error {
+
+	if t.MeasuredDischarge == nil {
+		return newDecodeError(CodeSectionNotInitialized, "966 section is not initialized")
+	}
+
+	err := checkCodeBlock(s)
+	if err != nil {
+		return err
+	}
+
+	if s[0] != '2' {
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '2'")
+	}
+
+	if s[1:] == "////" {
+		t.MeasuredDischarge.Discharge = types.NewNotMeasured[types.Discharge]()
+		return nil
+	}
+
+	factor, err := strconv.Atoi(s[1:2])
+	if err != nil || factor < 1 || factor > 5 {
+		return newDecodeError(CodeInvalidFactor, "discharge factor must be from 1 to 5")
+	}
+
+	flow, err := strconv.Atoi(s[2:])
+	if err != nil {
+		return newDecodeError(CodeInvalidValue, "invalid discharge value")
+	}
+
+	floatFlow := float64(flow)
+	for i := 0; i < factor; i++ {
+		floatFlow *= 10
+	}
+	floatFlow = math.Round(floatFlow) / 1000
+
+	t.MeasuredDischarge.Discharge = types.NewMeasured(types.Discharge(floatFlow))
+
+	return nil
+}
+
+func (t *Telegram) crossSectionAreaInit(s string) error {
+
+	if t.MeasuredDischarge == nil {
+		return newDecodeError(CodeSectionNotInitialized, "966 section is not initialized")
+	}
+
+	err := checkCodeBlock(s)
+	if err != nil {
+		return err
+	}
+
+	if s[0] != '3' {
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '3'")
+	}
+
+	if s[1:] == "////" {
+		t.MeasuredDischarge.CrossSectionArea = types.NewNotMeasured[types.CrossSectionArea]()
+		return nil
+	}
+
+	factor, err := strconv.Atoi(s[1:2])
+	if err != nil || factor < 1 || factor > 5 {
+		return newDecodeError(CodeInvalidFactor, "cross-section area factor must be from 1 to 5")
+	}
+
+	value, err := strconv.Atoi(s[2:])
+	if err != nil {
+		return newDecodeError(CodeInvalidValue, "invalid cross-section area value")
+	}
+
+	floatArea := float64(value)
+	for i := 0; i < factor; i++ {
+		floatArea *= 10
+	}
+	floatArea = math.Round(floatArea) / 1000
+
+	t.MeasuredDischarge.CrossSectionArea = types.NewMeasured(types.CrossSectionArea(floatArea))
+
+	return nil
+}
+
+func (t *Telegram) averageVelocityInit(s string) error {
+
+	if t.MeasuredDischarge == nil {
+		return newDecodeError(CodeSectionNotInitialized, "966 section is not initialized")
+	}
+
+	err := checkCodeBlock(s)
+	if err != nil {
+		return err
+	}
+
+	if s[0] != '4' {
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '4'")
+	}
+
+	if s[1:] == "////" {
+		t.MeasuredDischarge.AverageVelocity = types.NewNotMeasured[types.AverageVelocity]()
+		return nil
+	}
+
+	value, err := strconv.Atoi(s[1:])
+	if err != nil {
+		return newDecodeError(CodeInvalidValue, "invalid average velocity value")
+	}
+
+	t.MeasuredDischarge.AverageVelocity = types.NewMeasured(types.AverageVelocity(value))
+
+	return nil
+}
+
+func (t *Telegram) maxDepthInit(s string) error {
+
+	if t.MeasuredDischarge == nil {
+		return newDecodeError(CodeSectionNotInitialized, "966 section is not initialized")
+	}
+
+	err := checkCodeBlock(s)
+	if err != nil {
+		return err
+	}
+
+	if s[0] != '5' {
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '5'")
+	}
+
+	if s[1:] == "////" {
+		t.MeasuredDischarge.MaxDepth = types.NewNotMeasured[types.MaxDepth]()
+		return nil
+	}
+
+	value, err := strconv.Atoi(s[1:])
+	if err != nil {
+		return newDecodeError(CodeInvalidValue, "invalid max depth value")
+	}
+
+	t.MeasuredDischarge.MaxDepth = types.NewMeasured(types.MaxDepth(value))
+
+	return nil
+}
+
+func (t *Telegram) measurementTimeInit(s string) error {
+
+	if t.MeasuredDischarge == nil {
+		return newDecodeError(CodeSectionNotInitialized, "966 section is not initialized")
+	}
+
+	err := checkCodeBlock(s)
+	if err != nil {
+		return err
+	}
+
+	if s[0] != '6' {
+		return newDecodeError(CodeUnexpectedIdentifier, "first character must be '6'")
+	}
+
+	day, err := strconv.Atoi(s[1:3])
+	if err != nil || day < 1 || day > 31 {
+		return newDecodeError(CodeInvalidDay, "invalid day value")
+	}
+
+	// The year is not known here, so days are counted in the leap year 2024
+	// and February 29 is left to the date resolution.
+	if month := time.Month(*t.IsMeasuredDischargeMonth); day > time.Date(2024, month+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+		return newDecodeError(CodeInvalidDay, "%s has no day %d", month, day)
+	}
+
+	hour, err := strconv.Atoi(s[3:])
+	if err != nil || hour > 23 {
+		return newDecodeError(CodeInvalidHour, "invalid hour value")
+	}
+
+	values := t.storage()
+	values.measurementTime = types.MeasurementTime{
+		Day:  byte(day),
+		Hour: byte(hour),
+	}
+	t.MeasuredDischarge.MeasurementTime = &values.measurementTime
+
+	return nil
+}
+
+// group is a single 5-character code group together with its position
+// in the original input. value is the text interpreted by the decoder and
+// may differ from source when the group was rewritten, as 922 groups are.
+type group struct {
+	value  string
+	source string
+	index  int
+	offset int
+}
+
+type sequence struct {
+	groups  []group
+	section Section
+}
+
+// groupBuffers are reused for the telegrams of a bulletin or a stream, so
+// that their groups and sequences do not cost new buffers every telegram.
+// Decoded telegrams keep no reference to them.
+type groupBuffers struct {
+	groups    []group
+	split     []group
+	sequences []sequence
+}
+
+func parseString(input string) []group {
+
+	if end := strings.IndexByte(input, '='); end >= 0 {
+		input = input[:end]
+	}
+
+	return parseGroups(input, 0)
+}
+
+// parseGroups splits input into whitespace separated groups. base is the
+// offset of input in the original text.
+func parseGroups(input string, base int) []group {
+	return new(groupBuffers).parseGroups(input, base)
+}
+
+// parseGroups is like the function of the same name but returns groups in
+// the buffer of b, valid until its next call.
+func (b *groupBuffers) parseGroups(input string, base int) []group {
+
+	// Well-formed groups take 6 bytes with their separator.
+	groups := slices.Grow(b.groups[:0], len(input)/6+1)
+	defer func() { b.groups = groups[:0] }()
+
+	start := -1
+	for i := 0; i <= len(input); i++ {
+		if i < len(input) && !isSpace(input[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			groups = append(groups, group{
+				value:  input[start:i],
+				source: input[start:i],
+				index:  len(groups),
+				offset: base + start,
+			})
+			start = -1
 		}
 	}
 
-	return substrings, nil
+	return groups
 }
 
-func splitSequence(s string) []string {
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
+
+// splitSequence splits a telegram at its 922 groups. A 922DD group becomes
+// the date group of its section, with day DD and the hour and end block
+// number of the main telegram.
+func splitSequence(groups []group) []sequence {
+	return new(groupBuffers).splitSequence(groups)
+}
 
-	blocks := strings.Fields(s)
+// splitSequence is like the function of the same name but cuts the
+// sequences from the buffers of b, valid until its next call.
+func (b *groupBuffers) splitSequence(groups []group) []sequence {
 
-	if len(blocks) < 2 {
+	if len(groups) < 2 {
 		return nil
 	}
 
-	var sequences []string
-	firstBlock := blocks[0]
-	endBluckNum := blocks[1][4:]
+	firstGroup := groups[0]
+	hourAndEndBlockNum := ""
+	if len(groups[1].value) == 5 {
+		hourAndEndBlockNum = groups[1].value[2:]
+	}
+
+	// The rewritten date groups are built in one string and every sequence
+	// is cut from one buffer, so splitting does not allocate per group.
+	splits := 0
+	for _, g := range groups[1:] {
+		if isPreviousDayGroup(g) {
+			splits++
+		}
+	}
+
+	dateLen := 2 + len(hourAndEndBlockNum)
+	dateBuffer := make([]byte, 0, splits*dateLen)
+	for _, g := range groups[1:] {
+		if isPreviousDayGroup(g) {
+			dateBuffer = append(dateBuffer, g.value[3:5]...)
+			dateBuffer = append(dateBuffer, hourAndEndBlockNum...)
+		}
+	}
+	dates := string(dateBuffer)
+
+	buffer := slices.Grow(b.split[:0], len(groups)+splits)
+	sequences := slices.Grow(b.sequences[:0], splits+1)
+	defer func() { b.split, b.sequences = buffer[:0], sequences[:0] }()
 
-	currentSequence := []string{firstBlock}
+	buffer = append(buffer, firstGroup)
+	start := 0
+	section := SectionMain
 
-	for _, block := range blocks[1:] {
-		if strings.HasPrefix(block, "922") {
-			if len(currentSequence) > 1 {
-				sequences = append(sequences, strings.Join(currentSequence, " "))
+	for _, g := range groups[1:] {
+		if isPreviousDayGroup(g) {
+			if len(buffer)-start > 1 {
+				sequences = append(sequences, sequence{groups: buffer[start:len(buffer):len(buffer)], section: section})
+			} else {
+				buffer = buffer[:start]
 			}
-			modifiedSecondBlock := block[3:5] + "08" + endBluckNum
-			currentSequence = []string{firstBlock, modifiedSecondBlock}
+			dateGroup := g
+			dateGroup.value, dates = dates[:dateLen], dates[dateLen:]
+			start = len(buffer)
+			buffer = append(buffer, firstGroup, dateGroup)
+			section = SectionPreviousDay
 		} else {
-			currentSequence = append(currentSequence, block)
+			buffer = append(buffer, g)
 		}
 	}
 
-	sequences = append(sequences, strings.Join(currentSequence, " "))
+	sequences = append(sequences, sequence{groups: buffer[start:], section: section})
 
 	return sequences
 }
+
+func isPreviousDayGroup(g group) bool {
+	return len(g.value) == 5 && strings.HasPrefix(g.value, "922")
+}
@@ -0,0 +1,234 @@
+package encoder
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	decoder_types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+)
+
+// Difference is a value that the canonical form does not carry over from
+// the telegram as written. Field is the path of the value in the decoded
+// telegram, prefixed with the telegram index when the code decodes to
+// several telegrams. Input or Output is empty when the value is absent on
+// that side.
+type Difference struct {
+	Field  string
+	Input  string
+	Output string
+}
+
+// Canonicalize decodes code, encodes it back and decodes the result again.
+// It returns the canonical form of code together with every value that
+// differs between the two decodings; groups the decoder ignored are
+// reported as dropped. Each telegram of a bulletin is canonicalized on its
+// own and keeps its '=' terminator.
+func Canonicalize(code string) (string, []Difference, error) {
+
+	entries, err := types.DecodeBulletin(code)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var count int
+	for _, entry := range entries {
+		if entry.Err != nil {
+			return "", nil, entry.Err
+		}
+		count += len(entry.Telegrams)
+	}
+
+	canonicalStrings := make([]string, 0, len(entries))
+	var diff []Difference
+	var index int
+
+	for _, entry := range entries {
+		canonical, decoded, err := canonicalTelegram(entry.Telegrams)
+		if err != nil {
+			return "", nil, err
+		}
+
+		canonicalStrings = append(canonicalStrings, canonical)
+
+		for i := range entry.Telegrams {
+			var path string
+			if count > 1 {
+				path = fmt.Sprintf("[%d]", index)
+			}
+			index++
+
+			for _, ignored := range entry.Telegrams[i].IgnoredGroups {
+				diff = append(diff, Difference{
+					Field: joinPath(path, "IgnoredGroups"),
+					Input: ignored.Group,
+				})
+			}
+
+			diff = diffValues(path, reflect.ValueOf(entry.Telegrams[i]), reflect.ValueOf(decoded[i]), diff)
+		}
+	}
+
+	return strings.Join(canonicalStrings, " "), diff, nil
+}
+
+// canonicalTelegram encodes the telegrams decoded from one '='-terminated
+// telegram, the first one as the main section and the rest as 922
+// sections, and decodes the result back.
+func canonicalTelegram(hltels []*types.Telegram) (string, []*types.Telegram, error) {
+
+	encodedStrings := make([]string, 0, len(hltels))
+
+	for i, tel := range hltels {
+		var encoded string
+		var err error
+
+		if i == 0 {
+			encoded, err = Encoder(tel)
+		} else {
+			encoded, err = previousDayEncoder(tel)
+		}
+		if err != nil {
+			return "", nil, err
+		}
+
+		encodedStrings = append(encodedStrings, encoded)
+	}
+
+	canonical := strings.Join(encodedStrings, " ") + "="
+
+	decoded, err := types.NewtelegramsSlice(canonical)
+	if err != nil {
+		return "", nil, fmt.Errorf("canonical form %q does not decode: %w", canonical, err)
+	}
+
+	if len(decoded) != len(hltels) {
+		return "", nil, fmt.Errorf("canonical form %q decodes to %d telegrams, want %d", canonical, len(decoded), len(hltels))
+	}
+
+	return canonical, decoded, nil
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// measurement is implemented by every decoder_types.Measurement; its state
+// and value are unexported, so it is compared as a whole.
+type measurement interface {
+	State() decoder_types.MeasurementState
+	String() string
+}
+
+var measurementType = reflect.TypeOf((*measurement)(nil)).Elem()
+
+func diffValues(path string, in, out reflect.Value, diff []Difference) []Difference {
+
+	if in.Type() == timeType {
+		if !in.Interface().(time.Time).Equal(out.Interface().(time.Time)) {
+			diff = append(diff, Difference{Field: path, Input: formatValue(in), Output: formatValue(out)})
+		}
+		return diff
+	}
+
+	if in.Type().Implements(measurementType) {
+		if in.Interface() != out.Interface() {
+			diff = append(diff, Difference{Field: path, Input: formatValue(in), Output: formatValue(out)})
+		}
+		return diff
+	}
+
+	switch in.Kind() {
+	case reflect.Pointer:
+		if in.IsNil() || out.IsNil() {
+			if in.IsNil() != out.IsNil() {
+				diff = append(diff, Difference{Field: path, Input: formatValue(in), Output: formatValue(out)})
+			}
+			return diff
+		}
+		return diffValues(path, in.Elem(), out.Elem(), diff)
+
+	case reflect.Struct:
+		for i := 0; i < in.NumField(); i++ {
+			field := in.Type().Field(i)
+			if !field.IsExported() || field.Name == "IgnoredGroups" {
+				continue
+			}
+			diff = diffValues(joinPath(path, field.Name), in.Field(i), out.Field(i), diff)
+		}
+		return diff
+
+	case reflect.Slice:
+		for i := 0; i < in.Len() || i < out.Len(); i++ {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+
+			if i >= in.Len() || i >= out.Len() {
+				var inItem, outItem reflect.Value
+				if i < in.Len() {
+					inItem = in.Index(i)
+				}
+				if i < out.Len() {
+					outItem = out.Index(i)
+				}
+				diff = append(diff, Difference{Field: itemPath, Input: formatValue(inItem), Output: formatValue(outItem)})
+				continue
+			}
+
+			diff = diffValues(itemPath, in.Index(i), out.Index(i), diff)
+		}
+		return diff
+	}
+
+	if in.Interface() != out.Interface() {
+		diff = append(diff, Difference{Field: path, Input: formatValue(in), Output: formatValue(out)})
+	}
+
+	return diff
+}
+
+func formatValue(v reflect.Value) string {
+
+	if v.IsValid() && v.Type().Implements(measurementType) {
+		m := v.Interface().(measurement)
+		if m.State() == decoder_types.Absent {
+			return ""
+		}
+		return m.String()
+	}
+
+	switch v.Kind() {
+	case reflect.Invalid:
+		return ""
+
+	case reflect.Pointer:
+		if v.IsNil() {
+			return ""
+		}
+		return formatValue(v.Elem())
+
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface().(time.Time).Format(time.RFC3339)
+		}
+
+		parts := make([]string, 0, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if field.IsExported() {
+				parts = append(parts, field.Name+":"+formatValue(v.Field(i)))
+			}
+		}
+		return "{" + strings.Join(parts, " ") + "}"
+	}
+
+	return fmt.Sprint(v.Interface())
+}
+
+func joinPath(path, name string) string {
+
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
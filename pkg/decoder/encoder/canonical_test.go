@@ -0,0 +1,94 @@
+package encoder
+
+import (
+	"reflect"
+	"testing"
+
+	decoder_types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+)
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		want     string
+		wantDiff []Difference
+		wantErr  bool
+	}{
+		{
+			name:  "Already canonical",
+			input: "10950 31081 10245 20122 30250=",
+			want:  "10950 31081 10245 20122 30250=",
+		},
+		{
+			name:  "Same values written differently",
+			input: "10950 31081 20002 00000 51212 51313",
+			want:  "10950 31081 20001 51213 09900=",
+		},
+		{
+			name:  "922 sections",
+			input: "10950 01201 10245 92230 10240",
+			want:  "10950 01201 10245 92230 10240=",
+		},
+		{
+			name:  "Bulletin",
+			input: "10950 31081 10245= 10951 31081 10246=",
+			want:  "10950 31081 10245= 10951 31081 10246=",
+		},
+		{
+			name:  "Ignored group",
+			input: "10950 31081 10245 91234 20122=",
+			want:  "10950 31081 10245 20122=",
+			wantDiff: []Difference{
+				{Field: "IgnoredGroups", Input: "91234"},
+			},
+		},
+		{
+			name:    "Invalid telegram",
+			input:   "10950 31081 1a245=",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diff, err := Canonicalize(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Canonicalize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Canonicalize() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(diff, tt.wantDiff) {
+				t.Errorf("Canonicalize() diff = %+v, want %+v", diff, tt.wantDiff)
+			}
+		})
+	}
+}
+
+func TestDiffValues(t *testing.T) {
+	type inner struct {
+		Value decoder_types.Measurement[int32]
+		Depth decoder_types.Measurement[int32]
+	}
+	type value struct {
+		Name  string
+		Inner *inner
+		Items []int
+	}
+
+	in := value{Name: "a", Inner: &inner{Value: decoder_types.NewMeasured[int32](245)}, Items: []int{1, 2}}
+	out := value{Name: "b", Inner: &inner{Value: decoder_types.NewNotMeasured[int32](), Depth: decoder_types.NewMeasured[int32](310)}, Items: []int{1}}
+
+	want := []Difference{
+		{Field: "Name", Input: "a", Output: "b"},
+		{Field: "Inner.Value", Input: "245", Output: "not measured"},
+		{Field: "Inner.Depth", Output: "310"},
+		{Field: "Items[1]", Input: "2"},
+	}
+
+	got := diffValues("", reflect.ValueOf(in), reflect.ValueOf(out), nil)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diffValues() = %+v, want %+v", got, want)
+	}
+}
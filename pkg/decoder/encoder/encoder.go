@@ -28,26 +28,41 @@ func FullEncoder(hltels []*types.Telegram) (string, error) {
 	var encodedStrings []string
 
 	for i, tel := range hltels {
-		encoded, err := Encoder(tel)
-		if err != nil {
-			return "", err
-		}
-
-		parts := strings.Fields(encoded)
-		if len(parts) < 2 {
-			return "", errors.New("invalid encoded telegram format")
-		}
+		var encoded string
+		var err error
 
 		if i == 0 {
-			encodedStrings = append(encodedStrings, encoded)
+			encoded, err = Encoder(tel)
 		} else {
-			encodedStrings = append(encodedStrings, "922"+parts[1][:2]+" "+strings.Join(parts[2:], " "))
+			encoded, err = previousDayEncoder(tel)
 		}
+		if err != nil {
+			return "", err
+		}
+
+		encodedStrings = append(encodedStrings, encoded)
 	}
 
 	return strings.Join(encodedStrings, " ") + "=", nil
 }
 
+// previousDayEncoder encodes hltel as a 922 section: the postcode and date
+// groups are replaced with 922DD.
+func previousDayEncoder(hltel *types.Telegram) (string, error) {
+
+	encoded, err := Encoder(hltel)
+	if err != nil {
+		return "", err
+	}
+
+	parts := strings.Fields(encoded)
+	if len(parts) < 2 {
+		return "", errors.New("invalid encoded telegram format")
+	}
+
+	return strings.Join(append([]string{"922" + parts[1][:2]}, parts[2:]...), " "), nil
+}
+
 func Encoder(hltel *types.Telegram) (string, error) {
 
 	var builder strings.Builder
@@ -77,7 +92,7 @@ func Encoder(hltel *types.Telegram) (string, error) {
 		builder.WriteString(isDangerous)
 	}
 
-	if hltel.WaterLevelOnTime != nil {
+	if !hltel.WaterLevelOnTime.IsAbsent() {
 		waterLevelOnT, err := WaterLevelOnTimeEncoder(hltel.WaterLevelOnTime)
 		if err != nil {
 			return "", err
@@ -87,7 +102,7 @@ func Encoder(hltel *types.Telegram) (string, error) {
 		builder.WriteString(waterLevelOnT)
 	}
 
-	if hltel.DeltaWaterLevel != nil {
+	if !hltel.DeltaWaterLevel.IsAbsent() {
 		delta, err := DeltaWaterLevelEncoder(hltel.DeltaWaterLevel)
 		if err != nil {
 			return "", err
@@ -97,7 +112,7 @@ func Encoder(hltel *types.Telegram) (string, error) {
 		builder.WriteString(delta)
 	}
 
-	if hltel.WaterLevelOn20h != nil {
+	if !hltel.WaterLevelOn20h.IsAbsent() {
 		waterLevelOn20h, err := WaterLevelOn20hEncoder(hltel.WaterLevelOn20h)
 		if err != nil {
 			return "", err
@@ -117,7 +132,7 @@ func Encoder(hltel *types.Telegram) (string, error) {
 		builder.WriteString(temperature)
 	}
 
-	if len(hltel.IcePhenomenia) != 0 && hltel.IcePhenomeniaState != nil {
+	if hltel.IcePhenomeniaState != nil && (len(hltel.IcePhenomenia) != 0 || *hltel.IcePhenomeniaState == 0) {
 		phenomenias, err := IcePhenomeniaEncoder(hltel.IcePhenomeniaState, hltel.IcePhenomenia)
 		if err != nil {
 			return "", err
@@ -149,7 +164,7 @@ func Encoder(hltel *types.Telegram) (string, error) {
 		builder.WriteString(iceInfo)
 	}
 
-	if hltel.Waterflow != nil {
+	if !hltel.Waterflow.IsAbsent() {
 		waterflow, err := WaterflowEncoder(hltel.Waterflow)
 		if err != nil {
 			return "", err
@@ -178,7 +193,7 @@ func Encoder(hltel *types.Telegram) (string, error) {
 		builder.WriteRune(' ')
 		builder.WriteString(isReservoir)
 
-		if hltel.Reservoir.HeadwaterLevel != nil {
+		if !hltel.Reservoir.HeadwaterLevel.IsAbsent() {
 			headwater, err := HeadwaterLevelEncoder(hltel.Reservoir.HeadwaterLevel)
 			if err != nil {
 				return "", err
@@ -188,7 +203,7 @@ func Encoder(hltel *types.Telegram) (string, error) {
 			builder.WriteString(headwater)
 		}
 
-		if hltel.Reservoir.AverageReservoirLevel != nil {
+		if !hltel.Reservoir.AverageReservoirLevel.IsAbsent() {
 			avarage, err := AverageReservoirLevelEncoder(hltel.Reservoir.AverageReservoirLevel)
 			if err != nil {
 				return "", err
@@ -198,7 +213,7 @@ func Encoder(hltel *types.Telegram) (string, error) {
 			builder.WriteString(avarage)
 		}
 
-		if hltel.Reservoir.DownstreamLevel != nil {
+		if !hltel.Reservoir.DownstreamLevel.IsAbsent() {
 			downstreamLevel, err := DownstreamLevelEncoder(hltel.Reservoir.DownstreamLevel)
 			if err != nil {
 				return "", err
@@ -208,7 +223,7 @@ func Encoder(hltel *types.Telegram) (string, error) {
 			builder.WriteString(downstreamLevel)
 		}
 
-		if hltel.Reservoir.ReservoirVolume != nil {
+		if !hltel.Reservoir.ReservoirVolume.IsAbsent() {
 			volume, err := ReservoirVolumeEncoder(hltel.Reservoir.ReservoirVolume)
 			if err != nil {
 				return "", err
@@ -228,7 +243,7 @@ func Encoder(hltel *types.Telegram) (string, error) {
 		builder.WriteRune(' ')
 		builder.WriteString(state)
 
-		if hltel.ReservoirWaterInflow.Inflow != nil {
+		if !hltel.ReservoirWaterInflow.Inflow.IsAbsent() {
 			inflow, err := InflowEncoder(hltel.ReservoirWaterInflow.Inflow)
 			if err != nil {
 				return "", err
@@ -238,7 +253,7 @@ func Encoder(hltel *types.Telegram) (string, error) {
 			builder.WriteString(inflow)
 		}
 
-		if hltel.ReservoirWaterInflow.Reset != nil {
+		if !hltel.ReservoirWaterInflow.Reset.IsAbsent() {
 			reset, err := ResetEncoder(hltel.ReservoirWaterInflow.Reset)
 			if err != nil {
 				return "", err
@@ -249,5 +264,75 @@ func Encoder(hltel *types.Telegram) (string, error) {
 		}
 	}
 
+	if hltel.IsMeasuredDischargeMonth != nil {
+		month, err := IsMeasuredDischargeEncoder(hltel.IsMeasuredDischargeMonth)
+		if err != nil {
+			return "", err
+		}
+
+		builder.WriteRune(' ')
+		builder.WriteString(month)
+
+		if !hltel.MeasuredDischarge.WaterLevel.IsAbsent() {
+			waterLevel, err := MeasuredWaterLevelEncoder(hltel.MeasuredDischarge.WaterLevel)
+			if err != nil {
+				return "", err
+			}
+
+			builder.WriteRune(' ')
+			builder.WriteString(waterLevel)
+		}
+
+		if !hltel.MeasuredDischarge.Discharge.IsAbsent() {
+			discharge, err := DischargeEncoder(hltel.MeasuredDischarge.Discharge)
+			if err != nil {
+				return "", err
+			}
+
+			builder.WriteRune(' ')
+			builder.WriteString(discharge)
+		}
+
+		if !hltel.MeasuredDischarge.CrossSectionArea.IsAbsent() {
+			area, err := CrossSectionAreaEncoder(hltel.MeasuredDischarge.CrossSectionArea)
+			if err != nil {
+				return "", err
+			}
+
+			builder.WriteRune(' ')
+			builder.WriteString(area)
+		}
+
+		if !hltel.MeasuredDischarge.AverageVelocity.IsAbsent() {
+			velocity, err := AverageVelocityEncoder(hltel.MeasuredDischarge.AverageVelocity)
+			if err != nil {
+				return "", err
+			}
+
+			builder.WriteRune(' ')
+			builder.WriteString(velocity)
+		}
+
+		if !hltel.MeasuredDischarge.MaxDepth.IsAbsent() {
+			depth, err := MaxDepthEncoder(hltel.MeasuredDischarge.MaxDepth)
+			if err != nil {
+				return "", err
+			}
+
+			builder.WriteRune(' ')
+			builder.WriteString(depth)
+		}
+
+		if hltel.MeasuredDischarge.MeasurementTime != nil {
+			measurementTime, err := MeasurementTimeEncoder(hltel.MeasuredDischarge.MeasurementTime)
+			if err != nil {
+				return "", err
+			}
+
+			builder.WriteRune(' ')
+			builder.WriteString(measurementTime)
+		}
+	}
+
 	return builder.String(), nil
 }
@@ -0,0 +1,47 @@
+package encoder
+
+import (
+	"testing"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+)
+
+func TestEncoderRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "Main section",
+			input: "10950 31081 10245 20122 30250=",
+			want:  "10950 31081 10245 20122 30250",
+		},
+		{
+			name:  "966 section",
+			input: "10950 31081 10245 96604 10240 24123 32567 40087 50310 61409=",
+			want:  "10950 31081 10245 96604 10240 24123 32567 40087 50310 61409",
+		},
+		{
+			name:  "955 and 966 sections",
+			input: "10950 31081 95531 43123 96604 24123 61409",
+			want:  "10950 31081 95531 43123 96604 24123 61409",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			telegram, err := decoder.NewTelegram(tt.input)
+			if err != nil {
+				t.Fatalf("NewTelegram() error = %v", err)
+			}
+			got, err := Encoder(telegram)
+			if err != nil {
+				t.Fatalf("Encoder() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Encoder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
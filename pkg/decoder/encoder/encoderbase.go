@@ -3,6 +3,7 @@ package encoder
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 
 	types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
@@ -51,18 +52,18 @@ func IsDangerousEncoder(d *types.IsDangerous) (string, error) {
 	return "", nil
 }
 
-func WaterLevelOnTimeEncoder(w *types.WaterLevelOnTime) (string, error) {
+func WaterLevelOnTimeEncoder(w types.Measurement[types.WaterLevelOnTime]) (string, error) {
 
-	if w == nil {
+	switch w.State() {
+	case types.Absent:
 		return "", nil
-	}
-
-	waterlevel := int(*w)
-
-	if waterlevel == types.CouldNotMeasure {
+	case types.NotMeasured:
 		return "1////", nil
 	}
 
+	measured, _ := w.Get()
+	waterlevel := int(measured)
+
 	if waterlevel < 0 {
 		waterlevel = 5000 - waterlevel
 	}
@@ -70,18 +71,18 @@ func WaterLevelOnTimeEncoder(w *types.WaterLevelOnTime) (string, error) {
 	return fmt.Sprintf("1%04d", waterlevel), nil
 }
 
-func DeltaWaterLevelEncoder(d *types.DeltaWaterLevel) (string, error) {
+func DeltaWaterLevelEncoder(d types.Measurement[types.DeltaWaterLevel]) (string, error) {
 
-	if d == nil {
+	switch d.State() {
+	case types.Absent:
 		return "", nil
-	}
-
-	delta := int(*d)
-
-	if delta == types.CouldNotMeasure {
+	case types.NotMeasured:
 		return "2////", nil
 	}
 
+	measured, _ := d.Get()
+	delta := int(measured)
+
 	sign := '1'
 
 	if delta < 0 {
@@ -92,18 +93,18 @@ func DeltaWaterLevelEncoder(d *types.DeltaWaterLevel) (string, error) {
 	return fmt.Sprintf("2%03d%c", delta, sign), nil
 }
 
-func WaterLevelOn20hEncoder(w *types.WaterLevelOn20h) (string, error) {
+func WaterLevelOn20hEncoder(w types.Measurement[types.WaterLevelOn20h]) (string, error) {
 
-	if w == nil {
+	switch w.State() {
+	case types.Absent:
 		return "", nil
-	}
-
-	waterlevel := int(*w)
-
-	if waterlevel == types.CouldNotMeasure {
+	case types.NotMeasured:
 		return "3////", nil
 	}
 
+	measured, _ := w.Get()
+	waterlevel := int(measured)
+
 	if waterlevel < 0 {
 		waterlevel = 5000 - waterlevel
 	}
@@ -119,13 +120,13 @@ func TemperatureEncoder(t *types.Temperature) (string, error) {
 
 	var waterTempStr, airTempStr = "//", "//"
 
-	if *t.WaterTemperature != float64(types.CouldNotMeasure) {
-		waterTemp := int(*t.WaterTemperature * 10)
+	if waterTemperature, ok := t.WaterTemperature.Get(); ok {
+		waterTemp := int(math.Round(waterTemperature * 10))
 		waterTempStr = fmt.Sprintf("%02d", waterTemp)
 	}
 
-	if *t.AirTemperature != types.CouldNotMeasure {
-		airTemp := int(*t.AirTemperature)
+	if airTemperature, ok := t.AirTemperature.Get(); ok {
+		airTemp := int(airTemperature)
 		if airTemp < 0 {
 			airTemp = 50 - airTemp
 		}
@@ -145,10 +146,6 @@ func IcePhenomeniaEncoder(state *types.IcePhenomeniaState, phenomenias []*types.
 		return "5////", nil
 	}
 
-	if *state == 1 {
-		return "", nil
-	}
-
 	var encodedStrings []string
 
 	for i := 0; i < len(phenomenias); {
@@ -163,8 +160,11 @@ func IcePhenomeniaEncoder(state *types.IcePhenomeniaState, phenomenias []*types.
 			encodedString += intensityStr
 			i++
 		} else {
+			// A second code below 11 reads back as an intensity and an equal
+			// one as a single phenomenon, so such phenomena are not paired.
 			nextPhenomenon := current.Phenomen
-			if i+1 < len(phenomenias) && !phenomenias[i+1].IsUntensity {
+			if i+1 < len(phenomenias) && !phenomenias[i+1].IsUntensity &&
+				phenomenias[i+1].Phenomen >= 11 && phenomenias[i+1].Phenomen != current.Phenomen {
 				nextPhenomenon = phenomenias[i+1].Phenomen
 				i++
 			}
@@ -195,43 +195,35 @@ func IceInfoEncoder(iceInfo *types.IceInfo) (string, error) {
 
 	var iceHeightStr, snowHeightStr = "///", "/"
 
-	if *iceInfo.Ice != types.CouldNotMeasure {
-		iceHeightStr = fmt.Sprintf("%03d", *iceInfo.Ice)
+	if ice, ok := iceInfo.Ice.Get(); ok {
+		iceHeightStr = fmt.Sprintf("%03d", ice)
 	}
 
-	if *iceInfo.Snow != types.SnowHeight(types.CouldNotMeasureByte) {
-		snowHeightStr = fmt.Sprintf("%d", *iceInfo.Snow)
+	if snow, ok := iceInfo.Snow.Get(); ok {
+		snowHeightStr = fmt.Sprintf("%d", snow)
 	}
 
 	return fmt.Sprintf("7%s%s", iceHeightStr, snowHeightStr), nil
 }
 
-func WaterflowEncoder(waterflow *types.Waterflow) (string, error) {
+func WaterflowEncoder(waterflow types.Measurement[types.Waterflow]) (string, error) {
 
-	if waterflow == nil {
+	switch waterflow.State() {
+	case types.Absent:
 		return "", nil
-	}
-
-	flow := float64(*waterflow)
-
-	if flow == float64(types.CouldNotMeasure) {
+	case types.NotMeasured:
 		return "8////", nil
 	}
 
-	var factor int
+	measured, _ := waterflow.Get()
+	flow := float64(measured)
 
-	for flow > 1 {
-		flow /= 10
-		factor++
+	factor, scaled, ok := scaleFactor(flow)
+	if !ok {
+		return "", fmt.Errorf("invalid waterflow value for encoding: %v", measured)
 	}
 
-	scaledFlow := int(flow * 1000)
-
-	if factor < 1 || factor > 5 {
-		return "", fmt.Errorf("invalid waterflow value for encoding: %v", *waterflow)
-	}
-
-	return fmt.Sprintf("8%d%03d", factor, scaledFlow), nil
+	return fmt.Sprintf("8%d%03d", factor, scaled), nil
 }
 
 func PrecipitationEncoder(precip *types.Precipitation) (string, error) {
@@ -242,16 +234,15 @@ func PrecipitationEncoder(precip *types.Precipitation) (string, error) {
 
 	var valueStr, durationStr = "///", "/"
 
-	if *precip.Value != float64(types.CouldNotMeasure) {
-		value := float64(*precip.Value)
+	if value, ok := precip.Value.Get(); ok {
 		if value < 1 {
 			value = (value * 10) + 990
 		}
-		valueStr = fmt.Sprintf("%03d", int(value))
+		valueStr = fmt.Sprintf("%03d", int(math.Round(value)))
 	}
 
-	if *precip.Duration != types.PrecipitationDuration(types.CouldNotMeasureByte) {
-		durationStr = fmt.Sprintf("%d", *precip.Duration)
+	if duration, ok := precip.Duration.Get(); ok {
+		durationStr = fmt.Sprintf("%d", duration)
 	}
 
 	return fmt.Sprintf("0%s%s", valueStr, durationStr), nil
@@ -270,141 +261,277 @@ func IsReservoirEncoder(reservoirDate *types.IsReservoirDate) (string, error) {
 	return fmt.Sprintf("944%02d", *reservoirDate), nil
 }
 
-func HeadwaterLevelEncoder(headwater *types.HeadwaterLevel) (string, error) {
+func HeadwaterLevelEncoder(headwater types.Measurement[types.HeadwaterLevel]) (string, error) {
 
-	if headwater == nil {
+	switch headwater.State() {
+	case types.Absent:
 		return "", nil
+	case types.NotMeasured:
+		return "1////", nil
 	}
 
-	headwaterLevel := int(*headwater)
+	measured, _ := headwater.Get()
+	headwaterLevel := int(measured)
 
-	if headwaterLevel == types.CouldNotMeasure {
-		return "1////", nil
+	return fmt.Sprintf("1%04d", headwaterLevel), nil
+}
+
+func AverageReservoirLevelEncoder(averageLevel types.Measurement[types.AverageReservoirLevel]) (string, error) {
+
+	switch averageLevel.State() {
+	case types.Absent:
+		return "", nil
+	case types.NotMeasured:
+		return "2////", nil
 	}
 
-	return fmt.Sprintf("1%04d", headwaterLevel), nil
+	measured, _ := averageLevel.Get()
+	averageWaterLevel := int(measured)
+
+	return fmt.Sprintf("2%04d", averageWaterLevel), nil
 }
 
-func AverageReservoirLevelEncoder(averageLevel *types.AverageReservoirLevel) (string, error) {
+func DownstreamLevelEncoder(downstreamLevel types.Measurement[types.DownstreamLevel]) (string, error) {
 
-	if averageLevel == nil {
+	switch downstreamLevel.State() {
+	case types.Absent:
 		return "", nil
+	case types.NotMeasured:
+		return "4////", nil
 	}
 
-	averageWaterLevel := int(*averageLevel)
+	measured, _ := downstreamLevel.Get()
+	waterLevel := int(measured)
 
-	if averageWaterLevel == types.CouldNotMeasure {
-		return "2////", nil
+	return fmt.Sprintf("4%04d", waterLevel), nil
+}
+
+func ReservoirVolumeEncoder(reservoirVolume types.Measurement[types.ReservoirVolume]) (string, error) {
+
+	switch reservoirVolume.State() {
+	case types.Absent:
+		return "", nil
+	case types.NotMeasured:
+		return "7////", nil
 	}
 
-	return fmt.Sprintf("2%04d", averageWaterLevel), nil
+	measured, _ := reservoirVolume.Get()
+	volume := float64(measured)
+
+	factor, scaled, ok := scaleFactor(volume)
+	if !ok {
+		return "", fmt.Errorf("invalid reservoir volume value for encoding: %v", measured)
+	}
+
+	return fmt.Sprintf("7%d%03d", factor, scaled), nil
 }
 
-func DownstreamLevelEncoder(downstreamLevel *types.DownstreamLevel) (string, error) {
+func IsReservoirWaterInflowEncoder(inflowDate *types.IsReservoirWaterInflowDate) (string, error) {
 
-	if downstreamLevel == nil {
+	if inflowDate == nil {
 		return "", nil
 	}
 
-	waterLevel := int(*downstreamLevel)
+	if *inflowDate > 31 {
+		return "", fmt.Errorf("invalid day value: %d", *inflowDate)
+	}
+
+	return fmt.Sprintf("955%02d", *inflowDate), nil
+}
+
+func InflowEncoder(inflow types.Measurement[types.Inflow]) (string, error) {
 
-	if waterLevel == types.CouldNotMeasure {
+	switch inflow.State() {
+	case types.Absent:
+		return "", nil
+	case types.NotMeasured:
 		return "4////", nil
 	}
 
-	return fmt.Sprintf("4%04d", waterLevel), nil
+	measured, _ := inflow.Get()
+	flow := float64(measured)
+
+	factor, scaled, ok := scaleFactor(flow)
+	if !ok {
+		return "", fmt.Errorf("invalid inflow value for encoding: %v", measured)
+	}
+
+	return fmt.Sprintf("4%d%03d", factor, scaled), nil
 }
 
-func ReservoirVolumeEncoder(reservoirVolume *types.ReservoirVolume) (string, error) {
+func ResetEncoder(reset types.Measurement[types.Reset]) (string, error) {
 
-	if reservoirVolume == nil {
+	switch reset.State() {
+	case types.Absent:
 		return "", nil
+	case types.NotMeasured:
+		return "7////", nil
 	}
 
-	volume := float64(*reservoirVolume)
-	var factor int
+	measured, _ := reset.Get()
+	value := float64(measured)
 
-	if volume == float64(types.CouldNotMeasure) {
-		return "7////", nil
+	factor, scaled, ok := scaleFactor(value)
+	if !ok {
+		return "", fmt.Errorf("invalid reset value for encoding: %v", measured)
 	}
 
-	for volume > 1 {
-		volume /= 10
-		factor++
+	return fmt.Sprintf("7%d%03d", factor, scaled), nil
+}
+
+func IsMeasuredDischargeEncoder(month *types.IsMeasuredDischargeMonth) (string, error) {
+
+	if month == nil {
+		return "", nil
 	}
 
-	scaledVolume := int(volume * 1000)
+	if *month < 1 || *month > 12 {
+		return "", fmt.Errorf("invalid month value: %d", *month)
+	}
 
-	if factor < 1 || factor > 5 {
-		return "", fmt.Errorf("invalid reservoir volume value for encoding: %v", *reservoirVolume)
+	return fmt.Sprintf("966%02d", *month), nil
+}
+
+func MeasuredWaterLevelEncoder(w types.Measurement[types.MeasuredWaterLevel]) (string, error) {
+
+	switch w.State() {
+	case types.Absent:
+		return "", nil
+	case types.NotMeasured:
+		return "1////", nil
 	}
 
-	return fmt.Sprintf("7%d%03d", factor, uint32(scaledVolume)), nil
+	measured, _ := w.Get()
+	waterlevel := int(measured)
+
+	if waterlevel < 0 {
+		waterlevel = 5000 - waterlevel
+	}
+
+	return fmt.Sprintf("1%04d", waterlevel), nil
 }
 
-func IsReservoirWaterInflowEncoder(inflowDate *types.IsReservoirWaterInflowDate) (string, error) {
+func DischargeEncoder(discharge types.Measurement[types.Discharge]) (string, error) {
 
-	if inflowDate == nil {
+	switch discharge.State() {
+	case types.Absent:
 		return "", nil
+	case types.NotMeasured:
+		return "2////", nil
 	}
 
-	if *inflowDate > 31 {
-		return "", fmt.Errorf("invalid day value: %d", *inflowDate)
+	measured, _ := discharge.Get()
+	flow := float64(measured)
+
+	factor, scaled, ok := scaleFactor(flow)
+	if !ok {
+		return "", fmt.Errorf("invalid discharge value for encoding: %v", measured)
 	}
 
-	return fmt.Sprintf("955%02d", *inflowDate), nil
+	return fmt.Sprintf("2%d%03d", factor, scaled), nil
 }
 
-func InflowEncoder(inflow *types.Inflow) (string, error) {
+func CrossSectionAreaEncoder(area types.Measurement[types.CrossSectionArea]) (string, error) {
 
-	if inflow == nil {
+	switch area.State() {
+	case types.Absent:
 		return "", nil
+	case types.NotMeasured:
+		return "3////", nil
 	}
 
-	flow := float64(*inflow)
-	var factor int
+	measured, _ := area.Get()
+	value := float64(measured)
+
+	factor, scaled, ok := scaleFactor(value)
+	if !ok {
+		return "", fmt.Errorf("invalid cross-section area value for encoding: %v", measured)
+	}
 
-	if flow == float64(types.CouldNotMeasure) {
+	return fmt.Sprintf("3%d%03d", factor, scaled), nil
+}
+
+func AverageVelocityEncoder(velocity types.Measurement[types.AverageVelocity]) (string, error) {
+
+	switch velocity.State() {
+	case types.Absent:
+		return "", nil
+	case types.NotMeasured:
 		return "4////", nil
 	}
 
-	for flow > 1 {
-		flow /= 10
-		factor++
+	measured, _ := velocity.Get()
+	value := int(measured)
+
+	if value < 0 || value > 9999 {
+		return "", fmt.Errorf("invalid average velocity value: %d", value)
 	}
 
-	scaledFlow := int(flow * 1000)
+	return fmt.Sprintf("4%04d", value), nil
+}
+
+func MaxDepthEncoder(depth types.Measurement[types.MaxDepth]) (string, error) {
 
-	if factor < 1 || factor > 5 {
-		return "", fmt.Errorf("invalid inflow value for encoding: %v", *inflow)
+	switch depth.State() {
+	case types.Absent:
+		return "", nil
+	case types.NotMeasured:
+		return "5////", nil
 	}
 
-	return fmt.Sprintf("4%d%03d", factor, uint32(scaledFlow)), nil
+	measured, _ := depth.Get()
+	value := int(measured)
+
+	if value < 0 || value > 9999 {
+		return "", fmt.Errorf("invalid max depth value: %d", value)
+	}
+
+	return fmt.Sprintf("5%04d", value), nil
 }
 
-func ResetEncoder(reset *types.Reset) (string, error) {
+func MeasurementTimeEncoder(measurementTime *types.MeasurementTime) (string, error) {
 
-	if reset == nil {
+	if measurementTime == nil {
 		return "", nil
 	}
 
-	value := float64(*reset)
-	var factor int
+	if measurementTime.Day < 1 || measurementTime.Day > 31 {
+		return "", fmt.Errorf("invalid day value: %d", measurementTime.Day)
+	}
 
-	if value == float64(types.CouldNotMeasure) {
-		return "7////", nil
+	if measurementTime.Hour > 23 {
+		return "", fmt.Errorf("invalid hour value: %d", measurementTime.Hour)
+	}
+
+	return fmt.Sprintf("6%02d%02d", measurementTime.Day, measurementTime.Hour), nil
+}
+
+// scaleFactor splits value into the kQQQ form of flow and volume groups,
+// value = 0.QQQ * 10^k with k from 1 to 5, rounding QQQ to the nearest
+// integer so that decoded values encode back to the same group.
+func scaleFactor(value float64) (int, int, bool) {
+
+	if value < 0 {
+		return 0, 0, false
 	}
 
-	for value > 1 {
-		value /= 10
+	scaled := value
+	var factor int
+
+	for scaled >= 1 || factor < 1 {
+		scaled /= 10
 		factor++
 	}
 
-	if factor < 1 || factor > 5 {
-		return "", fmt.Errorf("invalid reset value for encoding: %v", *reset)
+	digits := int(math.Round(scaled * 1000))
+	if digits == 1000 {
+		digits = 100
+		factor++
 	}
 
-	scaledValue := int(value * 1000)
+	if factor > 5 || (digits == 0 && value != 0) {
+		return 0, 0, false
+	}
 
-	return fmt.Sprintf("7%d%03d", factor, uint32(scaledValue)), nil
+	return factor, digits, true
 }
@@ -138,31 +138,31 @@ func TestIsDangerousEncoder(t *testing.T) {
 func TestWaterLevelOnTimeEncoder(t *testing.T) {
 	tests := []struct {
 		name    string
-		input   *types.WaterLevelOnTime
+		input   types.Measurement[types.WaterLevelOnTime]
 		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Valid WaterLevelOnTime",
-			input:   func() *types.WaterLevelOnTime { w := types.WaterLevelOnTime(1234); return &w }(),
+			input:   types.NewMeasured(types.WaterLevelOnTime(1234)),
 			want:    "11234",
 			wantErr: false,
 		},
 		{
-			name:    "Special case WaterLevelOnTime",
-			input:   func() *types.WaterLevelOnTime { w := types.WaterLevelOnTime(types.CouldNotMeasure); return &w }(),
+			name:    "Not measured WaterLevelOnTime",
+			input:   types.NewNotMeasured[types.WaterLevelOnTime](),
 			want:    "1////",
 			wantErr: false,
 		},
 		{
 			name:    "Negative WaterLevelOnTime",
-			input:   func() *types.WaterLevelOnTime { w := types.WaterLevelOnTime(-766); return &w }(),
+			input:   types.NewMeasured(types.WaterLevelOnTime(-766)),
 			want:    "15766",
 			wantErr: false,
 		},
 		{
-			name:    "Nil WaterLevelOnTime",
-			input:   nil,
+			name:    "Absent WaterLevelOnTime",
+			input:   types.Measurement[types.WaterLevelOnTime]{},
 			want:    "",
 			wantErr: false,
 		},
@@ -185,31 +185,31 @@ func TestWaterLevelOnTimeEncoder(t *testing.T) {
 func TestDeltaWaterLevelEncoder(t *testing.T) {
 	tests := []struct {
 		name    string
-		input   *types.DeltaWaterLevel
+		input   types.Measurement[types.DeltaWaterLevel]
 		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Positive DeltaWaterLevel",
-			input:   func() *types.DeltaWaterLevel { d := types.DeltaWaterLevel(123); return &d }(),
+			input:   types.NewMeasured(types.DeltaWaterLevel(123)),
 			want:    "21231",
 			wantErr: false,
 		},
 		{
 			name:    "Negative DeltaWaterLevel",
-			input:   func() *types.DeltaWaterLevel { d := types.DeltaWaterLevel(-23); return &d }(),
+			input:   types.NewMeasured(types.DeltaWaterLevel(-23)),
 			want:    "20232",
 			wantErr: false,
 		},
 		{
-			name:    "Special case DeltaWaterLevel",
-			input:   func() *types.DeltaWaterLevel { d := types.DeltaWaterLevel(types.CouldNotMeasure); return &d }(),
+			name:    "Not measured DeltaWaterLevel",
+			input:   types.NewNotMeasured[types.DeltaWaterLevel](),
 			want:    "2////",
 			wantErr: false,
 		},
 		{
-			name:    "Nil DeltaWaterLevel",
-			input:   nil,
+			name:    "Absent DeltaWaterLevel",
+			input:   types.Measurement[types.DeltaWaterLevel]{},
 			want:    "",
 			wantErr: false,
 		},
@@ -232,31 +232,31 @@ func TestDeltaWaterLevelEncoder(t *testing.T) {
 func TestWaterLevelOn20hEncoder(t *testing.T) {
 	tests := []struct {
 		name    string
-		input   *types.WaterLevelOn20h
+		input   types.Measurement[types.WaterLevelOn20h]
 		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Valid WaterLevelOn20h",
-			input:   func() *types.WaterLevelOn20h { w := types.WaterLevelOn20h(1234); return &w }(),
+			input:   types.NewMeasured(types.WaterLevelOn20h(1234)),
 			want:    "31234",
 			wantErr: false,
 		},
 		{
-			name:    "Special case WaterLevelOn20h",
-			input:   func() *types.WaterLevelOn20h { w := types.WaterLevelOn20h(types.CouldNotMeasure); return &w }(),
+			name:    "Not measured WaterLevelOn20h",
+			input:   types.NewNotMeasured[types.WaterLevelOn20h](),
 			want:    "3////",
 			wantErr: false,
 		},
 		{
 			name:    "Negative WaterLevelOn20h",
-			input:   func() *types.WaterLevelOn20h { w := types.WaterLevelOn20h(-766); return &w }(),
+			input:   types.NewMeasured(types.WaterLevelOn20h(-766)),
 			want:    "35766",
 			wantErr: false,
 		},
 		{
-			name:    "Nil WaterLevelOn20h",
-			input:   nil,
+			name:    "Absent WaterLevelOn20h",
+			input:   types.Measurement[types.WaterLevelOn20h]{},
 			want:    "",
 			wantErr: false,
 		},
@@ -285,25 +285,25 @@ func TestTemperatureEncoder(t *testing.T) {
 	}{
 		{
 			name:    "Valid Temperature - both temperatures present",
-			input:   &types.Temperature{WaterTemperature: func() *float64 { f := float64(1.5); return &f }(), AirTemperature: func() *int32 { i := int32(20); return &i }()},
+			input:   &types.Temperature{WaterTemperature: types.NewMeasured(float64(1.5)), AirTemperature: types.NewMeasured(int32(20))},
 			want:    "41520",
 			wantErr: false,
 		},
 		{
 			name:    "Valid Temperature - only water temperature",
-			input:   &types.Temperature{WaterTemperature: func() *float64 { f := float64(1.5); return &f }(), AirTemperature: func() *int32 { i := int32(types.CouldNotMeasure); return &i }()},
+			input:   &types.Temperature{WaterTemperature: types.NewMeasured(float64(1.5)), AirTemperature: types.NewNotMeasured[int32]()},
 			want:    "415//",
 			wantErr: false,
 		},
 		{
 			name:    "Valid Temperature - only air temperature",
-			input:   &types.Temperature{WaterTemperature: func() *float64 { f := float64(types.CouldNotMeasure); return &f }(), AirTemperature: func() *int32 { i := int32(-5); return &i }()},
+			input:   &types.Temperature{WaterTemperature: types.NewNotMeasured[float64](), AirTemperature: types.NewMeasured(int32(-5))},
 			want:    "4//55",
 			wantErr: false,
 		},
 		{
 			name:    "Valid Temperature - nill temperature",
-			input:   &types.Temperature{WaterTemperature: func() *float64 { f := float64(types.CouldNotMeasure); return &f }(), AirTemperature: func() *int32 { i := int32(types.CouldNotMeasure); return &i }()},
+			input:   &types.Temperature{WaterTemperature: types.NewNotMeasured[float64](), AirTemperature: types.NewNotMeasured[int32]()},
 			want:    "4////",
 			wantErr: false,
 		},
@@ -365,6 +365,35 @@ func TestIcePhenomeniaEncoder(t *testing.T) {
 			want:    "51210",
 			wantErr: false,
 		},
+		{
+			name:  "Valid IcePhenomenia - second code below 11 is not paired",
+			state: func() *types.IcePhenomeniaState { s := types.IcePhenomeniaState(0); return &s }(),
+			input: []*types.Phenomenia{
+				{Phenomen: 12, IsUntensity: false},
+				{Phenomen: 5, IsUntensity: false},
+			},
+			want:    "51212 50505",
+			wantErr: false,
+		},
+		{
+			name:  "Valid IcePhenomenia - repeated phenomenia is not paired",
+			state: func() *types.IcePhenomeniaState { s := types.IcePhenomeniaState(0); return &s }(),
+			input: []*types.Phenomenia{
+				{Phenomen: 12, IsUntensity: false},
+				{Phenomen: 12, IsUntensity: false},
+			},
+			want:    "51212 51212",
+			wantErr: false,
+		},
+		{
+			name:  "Valid IcePhenomenia - with 60000 state",
+			state: func() *types.IcePhenomeniaState { s := types.IcePhenomeniaState(1); return &s }(),
+			input: []*types.Phenomenia{
+				{Phenomen: 12, IsUntensity: false},
+			},
+			want:    "51212",
+			wantErr: false,
+		},
 		{
 			name:    "Empty input with non-nil state",
 			state:   func() *types.IcePhenomeniaState { s := types.IcePhenomeniaState(0); return &s }(), // Ненулевое состояние
@@ -409,14 +438,14 @@ func TestIcePhenomeniaStateEncoder(t *testing.T) {
 		wantErr bool
 	}{
 		{
-			name:    "Valid IcePhenomeniaState - 2",
-			input:   func() *types.IcePhenomeniaState { s := types.IcePhenomeniaState(2); return &s }(),
+			name:    "Valid IcePhenomeniaState - 1",
+			input:   func() *types.IcePhenomeniaState { s := types.IcePhenomeniaState(1); return &s }(),
 			want:    "60000",
 			wantErr: false,
 		},
 		{
-			name:    "Valid IcePhenomeniaState - not 2",
-			input:   func() *types.IcePhenomeniaState { s := types.IcePhenomeniaState(1); return &s }(),
+			name:    "Valid IcePhenomeniaState - not 1",
+			input:   func() *types.IcePhenomeniaState { s := types.IcePhenomeniaState(0); return &s }(),
 			want:    "",
 			wantErr: false,
 		},
@@ -445,25 +474,25 @@ func TestIceInfoEncoder(t *testing.T) {
 	}{
 		{
 			name:    "Valid IceInfo - both ice and snow height present",
-			input:   &types.IceInfo{Ice: func() *int32 { h := int32(123); return &h }(), Snow: func() *types.SnowHeight { sh := types.SnowHeight(4); return &sh }()},
+			input:   &types.IceInfo{Ice: types.NewMeasured(int32(123)), Snow: types.NewMeasured(types.SnowHeight(4))},
 			want:    "71234",
 			wantErr: false,
 		},
 		{
 			name:    "Valid IceInfo - only ice height",
-			input:   &types.IceInfo{Ice: func() *int32 { h := int32(123); return &h }(), Snow: func() *types.SnowHeight { sh := types.SnowHeight(types.CouldNotMeasureByte); return &sh }()},
+			input:   &types.IceInfo{Ice: types.NewMeasured(int32(123)), Snow: types.NewNotMeasured[types.SnowHeight]()},
 			want:    "7123/",
 			wantErr: false,
 		},
 		{
 			name:    "Valid IceInfo - only snow height",
-			input:   &types.IceInfo{Ice: func() *int32 { h := int32(types.CouldNotMeasure); return &h }(), Snow: func() *types.SnowHeight { sh := types.SnowHeight(4); return &sh }()},
+			input:   &types.IceInfo{Ice: types.NewNotMeasured[int32](), Snow: types.NewMeasured(types.SnowHeight(4))},
 			want:    "7///4",
 			wantErr: false,
 		},
 		{
 			name:    "Valid IceInfo - only snow height",
-			input:   &types.IceInfo{Ice: func() *int32 { h := int32(types.CouldNotMeasure); return &h }(), Snow: func() *types.SnowHeight { sh := types.SnowHeight(types.CouldNotMeasureByte); return &sh }()},
+			input:   &types.IceInfo{Ice: types.NewNotMeasured[int32](), Snow: types.NewNotMeasured[types.SnowHeight]()},
 			want:    "7////",
 			wantErr: false,
 		},
@@ -492,31 +521,61 @@ func TestIceInfoEncoder(t *testing.T) {
 func TestWaterflowEncoder(t *testing.T) {
 	tests := []struct {
 		name    string
-		input   *types.Waterflow
+		input   types.Measurement[types.Waterflow]
 		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Valid Waterflow",
-			input:   func() *types.Waterflow { wf := types.Waterflow(1230); return &wf }(),
+			input:   types.NewMeasured(types.Waterflow(1230)),
 			want:    "84123",
 			wantErr: false,
 		},
 		{
-			name:    "Special case Waterflow - maximum value",
-			input:   func() *types.Waterflow { wf := types.Waterflow(types.CouldNotMeasure); return &wf }(),
+			name:    "Not measured Waterflow",
+			input:   types.NewNotMeasured[types.Waterflow](),
 			want:    "8////",
 			wantErr: false,
 		},
+		{
+			name:    "Valid Waterflow - power of ten",
+			input:   types.NewMeasured(types.Waterflow(10)),
+			want:    "82100",
+			wantErr: false,
+		},
+		{
+			name:    "Valid Waterflow - below one",
+			input:   types.NewMeasured(types.Waterflow(0.57)),
+			want:    "81057",
+			wantErr: false,
+		},
+		{
+			name:    "Valid Waterflow - zero",
+			input:   types.NewMeasured(types.Waterflow(0)),
+			want:    "81000",
+			wantErr: false,
+		},
+		{
+			name:    "Valid Waterflow - rounded",
+			input:   types.NewMeasured(types.Waterflow(0.9996)),
+			want:    "81100",
+			wantErr: false,
+		},
+		{
+			name:    "Invalid Waterflow - negative",
+			input:   types.NewMeasured(types.Waterflow(-5)),
+			want:    "",
+			wantErr: true,
+		},
 		{
 			name:    "Invalid Waterflow - too small",
-			input:   func() *types.Waterflow { wf := types.Waterflow(0.0001); return &wf }(),
+			input:   types.NewMeasured(types.Waterflow(0.0001)),
 			want:    "",
 			wantErr: true,
 		},
 		{
-			name:    "Nil Waterflow",
-			input:   nil,
+			name:    "Absent Waterflow",
+			input:   types.Measurement[types.Waterflow]{},
 			want:    "",
 			wantErr: false,
 		},
@@ -545,22 +604,19 @@ func TestPrecipitationEncoder(t *testing.T) {
 	}{
 		{
 			name:    "Valid Precipitation - both value and duration",
-			input:   &types.Precipitation{Value: func() *float64 { v := float64(0.4); return &v }(), Duration: func() *types.PrecipitationDuration { d := types.PrecipitationDuration(4); return &d }()},
+			input:   &types.Precipitation{Value: types.NewMeasured(float64(0.4)), Duration: types.NewMeasured(types.PrecipitationDuration(4))},
 			want:    "09944",
 			wantErr: false,
 		},
 		{
-			name: "Valid Precipitation - only value",
-			input: &types.Precipitation{Value: func() *float64 { v := float64(0.4); return &v }(), Duration: func() *types.PrecipitationDuration {
-				d := types.PrecipitationDuration(types.CouldNotMeasureByte)
-				return &d
-			}()},
+			name:    "Valid Precipitation - only value",
+			input:   &types.Precipitation{Value: types.NewMeasured(float64(0.4)), Duration: types.NewNotMeasured[types.PrecipitationDuration]()},
 			want:    "0994/",
 			wantErr: false,
 		},
 		{
 			name:    "Valid Precipitation - only duration",
-			input:   &types.Precipitation{Value: func() *float64 { v := float64(types.CouldNotMeasure); return &v }(), Duration: func() *types.PrecipitationDuration { d := types.PrecipitationDuration(4); return &d }()},
+			input:   &types.Precipitation{Value: types.NewNotMeasured[float64](), Duration: types.NewMeasured(types.PrecipitationDuration(4))},
 			want:    "0///4",
 			wantErr: false,
 		},
@@ -630,25 +686,25 @@ func TestIsReservoirEncoder(t *testing.T) {
 func TestHeadwaterLevelEncoder(t *testing.T) {
 	tests := []struct {
 		name    string
-		input   *types.HeadwaterLevel
+		input   types.Measurement[types.HeadwaterLevel]
 		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Valid HeadwaterLevel",
-			input:   func() *types.HeadwaterLevel { h := types.HeadwaterLevel(1234); return &h }(),
+			input:   types.NewMeasured(types.HeadwaterLevel(1234)),
 			want:    "11234",
 			wantErr: false,
 		},
 		{
-			name:    "Special case HeadwaterLevel - maximum value",
-			input:   func() *types.HeadwaterLevel { h := types.HeadwaterLevel(types.CouldNotMeasure); return &h }(),
+			name:    "Not measured HeadwaterLevel",
+			input:   types.NewNotMeasured[types.HeadwaterLevel](),
 			want:    "1////",
 			wantErr: false,
 		},
 		{
-			name:    "Nil HeadwaterLevel",
-			input:   nil,
+			name:    "Absent HeadwaterLevel",
+			input:   types.Measurement[types.HeadwaterLevel]{},
 			want:    "",
 			wantErr: false,
 		},
@@ -671,28 +727,25 @@ func TestHeadwaterLevelEncoder(t *testing.T) {
 func TestAverageReservoirLevelEncoder(t *testing.T) {
 	tests := []struct {
 		name    string
-		input   *types.AverageReservoirLevel
+		input   types.Measurement[types.AverageReservoirLevel]
 		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Valid AverageReservoirLevel",
-			input:   func() *types.AverageReservoirLevel { a := types.AverageReservoirLevel(1234); return &a }(),
+			input:   types.NewMeasured(types.AverageReservoirLevel(1234)),
 			want:    "21234",
 			wantErr: false,
 		},
 		{
-			name: "Special case AverageReservoirLevel - maximum value",
-			input: func() *types.AverageReservoirLevel {
-				a := types.AverageReservoirLevel(types.CouldNotMeasure)
-				return &a
-			}(),
+			name:    "Not measured AverageReservoirLevel",
+			input:   types.NewNotMeasured[types.AverageReservoirLevel](),
 			want:    "2////",
 			wantErr: false,
 		},
 		{
-			name:    "Nil AverageReservoirLevel",
-			input:   nil,
+			name:    "Absent AverageReservoirLevel",
+			input:   types.Measurement[types.AverageReservoirLevel]{},
 			want:    "",
 			wantErr: false,
 		},
@@ -715,25 +768,25 @@ func TestAverageReservoirLevelEncoder(t *testing.T) {
 func TestDownstreamLevelEncoder(t *testing.T) {
 	tests := []struct {
 		name    string
-		input   *types.DownstreamLevel
+		input   types.Measurement[types.DownstreamLevel]
 		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Valid DownstreamLevel",
-			input:   func() *types.DownstreamLevel { d := types.DownstreamLevel(1234); return &d }(),
+			input:   types.NewMeasured(types.DownstreamLevel(1234)),
 			want:    "41234",
 			wantErr: false,
 		},
 		{
-			name:    "Special case DownstreamLevel - maximum value",
-			input:   func() *types.DownstreamLevel { d := types.DownstreamLevel(types.CouldNotMeasure); return &d }(),
+			name:    "Not measured DownstreamLevel",
+			input:   types.NewNotMeasured[types.DownstreamLevel](),
 			want:    "4////",
 			wantErr: false,
 		},
 		{
-			name:    "Nil DownstreamLevel",
-			input:   nil,
+			name:    "Absent DownstreamLevel",
+			input:   types.Measurement[types.DownstreamLevel]{},
 			want:    "",
 			wantErr: false,
 		},
@@ -756,31 +809,31 @@ func TestDownstreamLevelEncoder(t *testing.T) {
 func TestReservoirVolumeEncoder(t *testing.T) {
 	tests := []struct {
 		name    string
-		input   *types.ReservoirVolume
+		input   types.Measurement[types.ReservoirVolume]
 		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Valid ReservoirVolume",
-			input:   func() *types.ReservoirVolume { rv := types.ReservoirVolume(1230); return &rv }(),
+			input:   types.NewMeasured(types.ReservoirVolume(1230)),
 			want:    "74123",
 			wantErr: false,
 		},
 		{
-			name:    "Special case ReservoirVolume - maximum value",
-			input:   func() *types.ReservoirVolume { rv := types.ReservoirVolume(types.CouldNotMeasure); return &rv }(),
+			name:    "Not measured ReservoirVolume",
+			input:   types.NewNotMeasured[types.ReservoirVolume](),
 			want:    "7////",
 			wantErr: false,
 		},
 		{
 			name:    "Invalid ReservoirVolume - too small",
-			input:   func() *types.ReservoirVolume { rv := types.ReservoirVolume(0.0001); return &rv }(),
+			input:   types.NewMeasured(types.ReservoirVolume(0.0001)),
 			want:    "",
 			wantErr: true,
 		},
 		{
-			name:    "Nil ReservoirVolume",
-			input:   nil,
+			name:    "Absent ReservoirVolume",
+			input:   types.Measurement[types.ReservoirVolume]{},
 			want:    "",
 			wantErr: false,
 		},
@@ -844,31 +897,31 @@ func TestIsReservoirWaterInflowEncoder(t *testing.T) {
 func TestInflowEncoder(t *testing.T) {
 	tests := []struct {
 		name    string
-		input   *types.Inflow
+		input   types.Measurement[types.Inflow]
 		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Valid Inflow",
-			input:   func() *types.Inflow { i := types.Inflow(23.5); return &i }(),
+			input:   types.NewMeasured(types.Inflow(23.5)),
 			want:    "42235",
 			wantErr: false,
 		},
 		{
-			name:    "Special case Inflow - maximum value",
-			input:   func() *types.Inflow { i := types.Inflow(types.CouldNotMeasure); return &i }(),
+			name:    "Not measured Inflow",
+			input:   types.NewNotMeasured[types.Inflow](),
 			want:    "4////",
 			wantErr: false,
 		},
 		{
 			name:    "Invalid Inflow - too small",
-			input:   func() *types.Inflow { i := types.Inflow(0.0001); return &i }(),
+			input:   types.NewMeasured(types.Inflow(0.0001)),
 			want:    "",
 			wantErr: true,
 		},
 		{
-			name:    "Nil Inflow",
-			input:   nil,
+			name:    "Absent Inflow",
+			input:   types.Measurement[types.Inflow]{},
 			want:    "",
 			wantErr: false,
 		},
@@ -891,31 +944,31 @@ func TestInflowEncoder(t *testing.T) {
 func TestResetEncoder(t *testing.T) {
 	tests := []struct {
 		name    string
-		input   *types.Reset
+		input   types.Measurement[types.Reset]
 		want    string
 		wantErr bool
 	}{
 		{
 			name:    "Valid Reset",
-			input:   func() *types.Reset { r := types.Reset(234.0); return &r }(),
+			input:   types.NewMeasured(types.Reset(234.0)),
 			want:    "73234",
 			wantErr: false,
 		},
 		{
-			name:    "Special case Reset - maximum value",
-			input:   func() *types.Reset { r := types.Reset(types.CouldNotMeasure); return &r }(),
+			name:    "Not measured Reset",
+			input:   types.NewNotMeasured[types.Reset](),
 			want:    "7////",
 			wantErr: false,
 		},
 		{
 			name:    "Invalid Reset - too small",
-			input:   func() *types.Reset { r := types.Reset(0.0001); return &r }(),
+			input:   types.NewMeasured(types.Reset(0.0001)),
 			want:    "",
 			wantErr: true,
 		},
 		{
-			name:    "Nil Reset",
-			input:   nil,
+			name:    "Absent Reset",
+			input:   types.Measurement[types.Reset]{},
 			want:    "",
 			wantErr: false,
 		},
@@ -934,3 +987,308 @@ func TestResetEncoder(t *testing.T) {
 		})
 	}
 }
+
+func TestIsMeasuredDischargeEncoder(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   *types.IsMeasuredDischargeMonth
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Valid month",
+			input:   func() *types.IsMeasuredDischargeMonth { m := types.IsMeasuredDischargeMonth(4); return &m }(),
+			want:    "96604",
+			wantErr: false,
+		},
+		{
+			name:    "Invalid month",
+			input:   func() *types.IsMeasuredDischargeMonth { m := types.IsMeasuredDischargeMonth(13); return &m }(),
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "Nil month",
+			input:   nil,
+			want:    "",
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsMeasuredDischargeEncoder(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IsMeasuredDischargeEncoder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("IsMeasuredDischargeEncoder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMeasuredWaterLevelEncoder(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   types.Measurement[types.MeasuredWaterLevel]
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Valid MeasuredWaterLevel",
+			input:   types.NewMeasured(types.MeasuredWaterLevel(245)),
+			want:    "10245",
+			wantErr: false,
+		},
+		{
+			name:    "Negative MeasuredWaterLevel",
+			input:   types.NewMeasured(types.MeasuredWaterLevel(-12)),
+			want:    "15012",
+			wantErr: false,
+		},
+		{
+			name:    "Not measured MeasuredWaterLevel",
+			input:   types.NewNotMeasured[types.MeasuredWaterLevel](),
+			want:    "1////",
+			wantErr: false,
+		},
+		{
+			name:    "Absent MeasuredWaterLevel",
+			input:   types.Measurement[types.MeasuredWaterLevel]{},
+			want:    "",
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MeasuredWaterLevelEncoder(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MeasuredWaterLevelEncoder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MeasuredWaterLevelEncoder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDischargeEncoder(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   types.Measurement[types.Discharge]
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Valid Discharge",
+			input:   types.NewMeasured(types.Discharge(1230)),
+			want:    "24123",
+			wantErr: false,
+		},
+		{
+			name:    "Not measured Discharge",
+			input:   types.NewNotMeasured[types.Discharge](),
+			want:    "2////",
+			wantErr: false,
+		},
+		{
+			name:    "Invalid Discharge - too small",
+			input:   types.NewMeasured(types.Discharge(0.0001)),
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "Absent Discharge",
+			input:   types.Measurement[types.Discharge]{},
+			want:    "",
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DischargeEncoder(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DischargeEncoder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("DischargeEncoder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCrossSectionAreaEncoder(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   types.Measurement[types.CrossSectionArea]
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Valid CrossSectionArea",
+			input:   types.NewMeasured(types.CrossSectionArea(56.7)),
+			want:    "32567",
+			wantErr: false,
+		},
+		{
+			name:    "Not measured CrossSectionArea",
+			input:   types.NewNotMeasured[types.CrossSectionArea](),
+			want:    "3////",
+			wantErr: false,
+		},
+		{
+			name:    "Absent CrossSectionArea",
+			input:   types.Measurement[types.CrossSectionArea]{},
+			want:    "",
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CrossSectionAreaEncoder(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CrossSectionAreaEncoder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("CrossSectionAreaEncoder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAverageVelocityEncoder(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   types.Measurement[types.AverageVelocity]
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Valid AverageVelocity",
+			input:   types.NewMeasured(types.AverageVelocity(87)),
+			want:    "40087",
+			wantErr: false,
+		},
+		{
+			name:    "Invalid AverageVelocity - negative",
+			input:   types.NewMeasured(types.AverageVelocity(-1)),
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "Absent AverageVelocity",
+			input:   types.Measurement[types.AverageVelocity]{},
+			want:    "",
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AverageVelocityEncoder(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("AverageVelocityEncoder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("AverageVelocityEncoder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMaxDepthEncoder(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   types.Measurement[types.MaxDepth]
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Valid MaxDepth",
+			input:   types.NewMeasured(types.MaxDepth(310)),
+			want:    "50310",
+			wantErr: false,
+		},
+		{
+			name:    "Not measured MaxDepth",
+			input:   types.NewNotMeasured[types.MaxDepth](),
+			want:    "5////",
+			wantErr: false,
+		},
+		{
+			name:    "Absent MaxDepth",
+			input:   types.Measurement[types.MaxDepth]{},
+			want:    "",
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MaxDepthEncoder(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MaxDepthEncoder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MaxDepthEncoder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMeasurementTimeEncoder(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   *types.MeasurementTime
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "Valid MeasurementTime",
+			input:   &types.MeasurementTime{Day: 14, Hour: 9},
+			want:    "61409",
+			wantErr: false,
+		},
+		{
+			name:    "Invalid MeasurementTime - day",
+			input:   &types.MeasurementTime{Day: 0, Hour: 9},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid MeasurementTime - hour",
+			input:   &types.MeasurementTime{Day: 14, Hour: 24},
+			want:    "",
+			wantErr: true,
+		},
+		{
+			name:    "Nil MeasurementTime",
+			input:   nil,
+			want:    "",
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MeasurementTimeEncoder(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MeasurementTimeEncoder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MeasurementTimeEncoder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package decoder
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Envelope is the transport metadata removed from a raw message.
+type Envelope struct {
+	BulletinType string
+	Sender       string
+	Serial       string
+	Time         *BulletinTime
+	Headers      []string
+}
+
+// BulletinTime is the YYGGgg date-time group of a bulletin heading.
+type BulletinTime struct {
+	Day    byte
+	Hour   byte
+	Minute byte
+}
+
+// Resolve places the bulletin time on the latest matching day not after
+// reference, as ResolveDate does for telegram dates.
+func (b *BulletinTime) Resolve(reference time.Time) (time.Time, error) {
+
+	date, err := ResolveDate(reference, b.Day, b.Hour)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return date.Add(time.Duration(b.Minute) * time.Minute), nil
+}
+
+// DefaultEnvelopePatterns recognise the headings our communication channel
+// wraps KN-15 messages in. A pattern must match a whole line; its named
+// groups type, sender, time (DDHHMM) and serial fill the Envelope.
+var DefaultEnvelopePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`^ZCZC(?:\s+(?P<serial>\d+))?$`),
+	regexp.MustCompile(`^(?P<type>[A-Z]{4}\d{2})\s+(?P<sender>[A-Z]{4})\s+(?P<time>\d{6})(?:\s+(?P<serial>[A-Z]{3}))?$`),
+	regexp.MustCompile(`^(?P<type>ЩЭ[А-Я]+)\s+(?P<sender>\d+)\s+(?P<time>\d{6})(?:\s+(?P<serial>\d+))?$`),
+	regexp.MustCompile(`^(?:NNNN|НННН)$`),
+}
+
+// StripEnvelope removes the heading lines matched by patterns from s, or by
+// DefaultEnvelopePatterns when none are given. Removed lines are replaced
+// with spaces so offsets into the returned text match the original one.
+func StripEnvelope(s string, patterns ...*regexp.Regexp) (string, *Envelope) {
+
+	if len(patterns) == 0 {
+		patterns = DefaultEnvelopePatterns
+	}
+
+	envelope := &Envelope{}
+	var builder strings.Builder
+	builder.Grow(len(s))
+
+	for len(s) > 0 {
+		line := s
+		rest := ""
+		if end := strings.IndexByte(s, '\n'); end >= 0 {
+			line, rest = s[:end+1], s[end+1:]
+		}
+		s = rest
+
+		if !envelope.match(strings.TrimSpace(line), patterns) {
+			builder.WriteString(line)
+			continue
+		}
+
+		for i := 0; i < len(line); i++ {
+			if line[i] == '\n' || line[i] == '\r' {
+				builder.WriteByte(line[i])
+			} else {
+				builder.WriteByte(' ')
+			}
+		}
+	}
+
+	return builder.String(), envelope
+}
+
+func (e *Envelope) match(line string, patterns []*regexp.Regexp) bool {
+
+	if line == "" {
+		return false
+	}
+
+	for _, pattern := range patterns {
+		match := pattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		e.Headers = append(e.Headers, line)
+
+		for i, name := range pattern.SubexpNames() {
+			if match[i] == "" {
+				continue
+			}
+			switch name {
+			case "type":
+				e.BulletinType = match[i]
+			case "sender":
+				e.Sender = match[i]
+			case "serial":
+				e.Serial = match[i]
+			case "time":
+				e.Time = parseBulletinTime(match[i])
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
+func parseBulletinTime(s string) *BulletinTime {
+
+	if len(s) != 6 {
+		return nil
+	}
+
+	day, err := strconv.Atoi(s[:2])
+	if err != nil || day < 1 || day > 31 {
+		return nil
+	}
+
+	hour, err := strconv.Atoi(s[2:4])
+	if err != nil || hour > 23 {
+		return nil
+	}
+
+	minute, err := strconv.Atoi(s[4:])
+	if err != nil || minute > 59 {
+		return nil
+	}
+
+	return &BulletinTime{
+		Day:    byte(day),
+		Hour:   byte(hour),
+		Minute: byte(minute),
+	}
+}
@@ -0,0 +1,83 @@
+package decoder
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestStripEnvelope(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		patterns     []*regexp.Regexp
+		wantEnvelope Envelope
+		wantPostCode []string
+	}{
+		{
+			name:  "WMO heading with serial",
+			input: "ZCZC 042\r\nSRRS40 UKMS 140800\r\n10950 31081 10245=\r\n10951 31081 10300=\r\nNNNN\r\n",
+			wantEnvelope: Envelope{
+				BulletinType: "SRRS40",
+				Sender:       "UKMS",
+				Serial:       "042",
+				Time:         &BulletinTime{Day: 14, Hour: 8, Minute: 0},
+				Headers:      []string{"ZCZC 042", "SRRS40 UKMS 140800", "NNNN"},
+			},
+			wantPostCode: []string{"10950", "10951"},
+		},
+		{
+			name:  "Russian heading",
+			input: "ЩЭГИДРО 34560 312005\n10950 31081 10245=",
+			wantEnvelope: Envelope{
+				BulletinType: "ЩЭГИДРО",
+				Sender:       "34560",
+				Time:         &BulletinTime{Day: 31, Hour: 20, Minute: 5},
+				Headers:      []string{"ЩЭГИДРО 34560 312005"},
+			},
+			wantPostCode: []string{"10950"},
+		},
+		{
+			name:         "No heading",
+			input:        "10950 31081 10245=",
+			wantPostCode: []string{"10950"},
+		},
+		{
+			name:     "Custom pattern",
+			input:    "FROM 77 AT 140800\n10950 31081 10245=",
+			patterns: []*regexp.Regexp{regexp.MustCompile(`^FROM (?P<sender>\d+) AT (?P<time>\d{6})$`)},
+			wantEnvelope: Envelope{
+				Sender:  "77",
+				Time:    &BulletinTime{Day: 14, Hour: 8, Minute: 0},
+				Headers: []string{"FROM 77 AT 140800"},
+			},
+			wantPostCode: []string{"10950"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, envelope := StripEnvelope(tt.input, tt.patterns...)
+
+			if len(body) != len(tt.input) {
+				t.Errorf("StripEnvelope() changed length from %d to %d", len(tt.input), len(body))
+			}
+			if !reflect.DeepEqual(*envelope, tt.wantEnvelope) {
+				t.Errorf("StripEnvelope() envelope = %+v, want %+v", *envelope, tt.wantEnvelope)
+			}
+
+			telegrams, err := NewtelegramsSlice(body)
+			if err != nil {
+				t.Fatalf("NewtelegramsSlice() error = %v", err)
+			}
+
+			var postCodes []string
+			for _, telegram := range telegrams {
+				postCodes = append(postCodes, string(telegram.PostCode))
+			}
+			if !reflect.DeepEqual(postCodes, tt.wantPostCode) {
+				t.Errorf("post codes = %v, want %v", postCodes, tt.wantPostCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package decoder
+
+import (
+	"errors"
+	"fmt"
+)
+
+type Section string
+
+const (
+	SectionMain              Section = "main"
+	SectionPreviousDay       Section = "922"
+	SectionReservoir         Section = "944"
+	SectionReservoirInflow   Section = "955"
+	SectionMeasuredDischarge Section = "966"
+)
+
+type ErrorCode string
+
+const (
+	CodeEmptyTelegram         ErrorCode = "empty_telegram"
+	CodeInvalidGroup          ErrorCode = "invalid_group"
+	CodeUnexpectedIdentifier  ErrorCode = "unexpected_identifier"
+	CodeInvalidDay            ErrorCode = "invalid_day"
+	CodeInvalidHour           ErrorCode = "invalid_hour"
+	CodeInvalidMonth          ErrorCode = "invalid_month"
+	CodeInvalidEndBlockNum    ErrorCode = "invalid_end_block_num"
+	CodeInvalidDangerGroup    ErrorCode = "invalid_danger_group"
+	CodeInvalidValue          ErrorCode = "invalid_value"
+	CodeInvalidSign           ErrorCode = "invalid_sign"
+	CodeInvalidFactor         ErrorCode = "invalid_factor"
+	CodeInvalidDuration       ErrorCode = "invalid_duration"
+	CodeInvalidIceState       ErrorCode = "invalid_ice_state"
+	CodeInvalidPhenomenon     ErrorCode = "invalid_phenomenon"
+	CodeInvalidIntensity      ErrorCode = "invalid_intensity"
+	CodeSectionNotInitialized ErrorCode = "section_not_initialized"
+	CodeDuplicateGroup        ErrorCode = "duplicate_group"
+	CodeUnknownGroup          ErrorCode = "unknown_group"
+)
+
+// DecodeError describes a problem with a single group of the telegram.
+// Block is the index of the group in the input, Offset is its byte
+// offset in the original text and Group is the group as it was written.
+type DecodeError struct {
+	Code    ErrorCode
+	Section Section
+	Block   int
+	Offset  int
+	Group   string
+	Message string
+}
+
+func (e *DecodeError) Error() string {
+	if e.Group == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("block %d %q at offset %d (section %s): %s", e.Block, e.Group, e.Offset, e.Section, e.Message)
+}
+
+func newDecodeError(code ErrorCode, format string, args ...any) *DecodeError {
+	return &DecodeError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// locate attaches the position of g to err, converting foreign errors
+// into a DecodeError with CodeInvalidValue.
+func locate(err error, g group, section Section) *DecodeError {
+
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		decodeErr = newDecodeError(CodeInvalidValue, "%v", err)
+	}
+
+	located := *decodeErr
+	located.Section = section
+	located.Block = g.index
+	located.Offset = g.offset
+	located.Group = g.source
+
+	return &located
+}
@@ -0,0 +1,142 @@
+package decoder
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDecodeErrorPosition(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    DecodeError
+		decoder func(string) error
+	}{
+		{
+			name:  "Invalid water level in main section",
+			input: "10950 31081 1a245 20122=",
+			want: DecodeError{
+				Code:    CodeInvalidGroup,
+				Section: SectionMain,
+				Block:   2,
+				Offset:  12,
+				Group:   "1a245",
+			},
+		},
+		{
+			name:  "Invalid hour",
+			input: "10950  31251 10245",
+			want: DecodeError{
+				Code:    CodeInvalidHour,
+				Section: SectionMain,
+				Block:   1,
+				Offset:  7,
+				Group:   "31251",
+			},
+		},
+		{
+			name:  "Group of wrong length",
+			input: "10950 31081 1024",
+			want: DecodeError{
+				Code:    CodeInvalidGroup,
+				Section: SectionMain,
+				Block:   2,
+				Offset:  12,
+				Group:   "1024",
+			},
+		},
+		{
+			name:  "Invalid factor in 944 section",
+			input: "10950 31081 10245 94431 76123",
+			want: DecodeError{
+				Code:    CodeInvalidFactor,
+				Section: SectionReservoir,
+				Block:   4,
+				Offset:  24,
+				Group:   "76123",
+			},
+		},
+		{
+			name:  "Invalid reset factor in 955 section",
+			input: "10950 31081 95531 4////  76123",
+			want: DecodeError{
+				Code:    CodeInvalidFactor,
+				Section: SectionReservoirInflow,
+				Block:   4,
+				Offset:  25,
+				Group:   "76123",
+			},
+		},
+		{
+			name:  "Unknown phenomenon code",
+			input: "10950 31081 10245 51299",
+			want: DecodeError{
+				Code:    CodeInvalidPhenomenon,
+				Section: SectionMain,
+				Block:   3,
+				Offset:  18,
+				Group:   "51299",
+			},
+		},
+		{
+			name:  "Intensity for phenomenon without intensity",
+			input: "10950 31081 10245 52705",
+			want: DecodeError{
+				Code:    CodeInvalidIntensity,
+				Section: SectionMain,
+				Block:   3,
+				Offset:  18,
+				Group:   "52705",
+			},
+		},
+		{
+			name:  "Zero intensity",
+			input: "10950 31081 10245 51800",
+			want: DecodeError{
+				Code:    CodeInvalidIntensity,
+				Section: SectionMain,
+				Block:   3,
+				Offset:  18,
+				Group:   "51800",
+			},
+		},
+		{
+			name:  "Invalid day in 922 section",
+			input: "10950 31081 10245 92245 10250",
+			want: DecodeError{
+				Code:    CodeInvalidDay,
+				Section: SectionPreviousDay,
+				Block:   3,
+				Offset:  18,
+				Group:   "92245",
+			},
+			decoder: func(s string) error {
+				_, err := NewtelegramsSlice(s)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decode := tt.decoder
+			if decode == nil {
+				decode = func(s string) error {
+					_, err := NewTelegram(s)
+					return err
+				}
+			}
+
+			err := decode(tt.input)
+
+			var got *DecodeError
+			if !errors.As(err, &got) {
+				t.Fatalf("error = %v, want *DecodeError", err)
+			}
+			if got.Code != tt.want.Code || got.Section != tt.want.Section || got.Block != tt.want.Block ||
+				got.Offset != tt.want.Offset || got.Group != tt.want.Group {
+				t.Errorf("DecodeError = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package decoder
+
+import "time"
+
+type Option func(*options)
+
+type options struct {
+	lenient   bool
+	strict    bool
+	reference time.Time
+}
+
+// Lenient makes the decoder record bad groups in the Report and continue
+// with the next group instead of failing on the first one.
+func Lenient() Option {
+	return func(o *options) {
+		o.lenient = true
+	}
+}
+
+// Strict makes groups that are not recognized in their section an error
+// instead of recording them in Telegram.IgnoredGroups.
+func Strict() Option {
+	return func(o *options) {
+		o.strict = true
+	}
+}
+
+// ReferenceTime sets the time telegram dates are resolved against, for
+// example the time the bulletin was sent. It defaults to the current time.
+func ReferenceTime(reference time.Time) Option {
+	return func(o *options) {
+		o.reference = reference
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func (o *options) referenceTime() time.Time {
+	if o.reference.IsZero() {
+		return time.Now()
+	}
+	return o.reference
+}
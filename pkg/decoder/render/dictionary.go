@@ -0,0 +1,199 @@
+package render
+
+import (
+	"fmt"
+
+	types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+)
+
+// quantity is a measured value: its name and the unit written after the
+// number, including any leading space.
+type quantity struct {
+	name string
+	unit string
+}
+
+type dictionary struct {
+	decimalSeparator string
+	heading          string
+	noData           string
+	notMeasured      string
+	dangerous        string
+
+	waterLevel     quantity
+	waterLevel20h  quantity
+	change         string
+	rise           quantity
+	fall           quantity
+	noChange       string
+	waterTemp      quantity
+	airTemp        quantity
+	phenomena      string
+	phenomenaEnded string
+	intensity      string
+	ice            quantity
+	snowCover      string
+	waterflow      quantity
+	precipitation  quantity
+
+	reservoir      string
+	headwaterLevel quantity
+	averageLevel   quantity
+	downstream     quantity
+	volume         quantity
+
+	inflowSection string
+	inflow        quantity
+	reset         quantity
+
+	dischargeSection string
+	dischargeLevel   quantity
+	discharge        quantity
+	area             quantity
+	velocity         quantity
+	maxDepth         quantity
+	measuredAt       string
+
+	snow       map[types.SnowHeight]string
+	duration   map[types.PrecipitationDuration]string
+	months     [12]string
+	day        func(day byte) string
+	phenomenon func(info types.PhenomenonInfo) string
+}
+
+var dictionaries = map[Language]*dictionary{
+	English: {
+		decimalSeparator: ".",
+		heading:          "Post %s, %s %02dh",
+		noData:           "no data",
+		notMeasured:      "%s not measured",
+		dangerous:        "dangerous phenomenon",
+
+		waterLevel:     quantity{"level", " cm"},
+		waterLevel20h:  quantity{"level at 20h", " cm"},
+		change:         "level change",
+		rise:           quantity{"rise", " cm"},
+		fall:           quantity{"fall", " cm"},
+		noChange:       "level unchanged",
+		waterTemp:      quantity{"water", "°C"},
+		airTemp:        quantity{"air", "°C"},
+		phenomena:      "phenomena",
+		phenomenaEnded: "ice phenomena ended",
+		intensity:      "%s %d/10",
+		ice:            quantity{"ice", " cm"},
+		snowCover:      "snow",
+		waterflow:      quantity{"discharge", " m³/s"},
+		precipitation:  quantity{"precipitation", " mm"},
+
+		reservoir:      "reservoir on the %s",
+		headwaterLevel: quantity{"headwater level", " cm"},
+		averageLevel:   quantity{"average level", " cm"},
+		downstream:     quantity{"tailwater level", " cm"},
+		volume:         quantity{"volume", " mln m³"},
+
+		inflowSection: "inflow on the %s",
+		inflow:        quantity{"inflow", " m³/s"},
+		reset:         quantity{"release", " m³/s"},
+
+		dischargeSection: "discharge measured in %s",
+		dischargeLevel:   quantity{"level", " cm"},
+		discharge:        quantity{"discharge", " m³/s"},
+		area:             quantity{"cross-section area", " m²"},
+		velocity:         quantity{"mean velocity", " cm/s"},
+		maxDepth:         quantity{"max depth", " cm"},
+		measuredAt:       "measured on the %s at %02dh",
+
+		snow: map[types.SnowHeight]string{
+			types.NoSnow:     "no snow",
+			types.Less5:      "snow under 5 cm",
+			types.From5to10:  "snow 5–10 cm",
+			types.From11to15: "snow 11–15 cm",
+			types.From16to20: "snow 16–20 cm",
+			types.From21to25: "snow 21–25 cm",
+			types.From26to35: "snow 26–35 cm",
+			types.From36to50: "snow 36–50 cm",
+			types.From51to70: "snow 51–70 cm",
+			types.More70:     "snow over 70 cm",
+		},
+		duration: map[types.PrecipitationDuration]string{
+			types.Less1:     "under 1 h",
+			types.From1To3:  "1–3 h",
+			types.From3To6:  "3–6 h",
+			types.From6To12: "6–12 h",
+			types.More12:    "over 12 h",
+		},
+		months: [12]string{
+			"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+		day:        englishOrdinal,
+		phenomenon: func(info types.PhenomenonInfo) string { return lowerFirst(info.NameEn) },
+	},
+	Russian: {
+		decimalSeparator: ",",
+		heading:          "Пост %s, %s, %02d ч",
+		noData:           "нет данных",
+		notMeasured:      "%s: нет данных",
+		dangerous:        "опасное явление",
+
+		waterLevel:     quantity{"уровень", " см"},
+		waterLevel20h:  quantity{"уровень в 20 ч", " см"},
+		change:         "изменение уровня",
+		rise:           quantity{"подъём", " см"},
+		fall:           quantity{"спад", " см"},
+		noChange:       "уровень без изменений",
+		waterTemp:      quantity{"вода", " °C"},
+		airTemp:        quantity{"воздух", " °C"},
+		phenomena:      "явления",
+		phenomenaEnded: "ледовые явления прекратились",
+		intensity:      "%s %d/10",
+		ice:            quantity{"лёд", " см"},
+		snowCover:      "снег",
+		waterflow:      quantity{"расход", " м³/с"},
+		precipitation:  quantity{"осадки", " мм"},
+
+		reservoir:      "водохранилище, %s",
+		headwaterLevel: quantity{"уровень верхнего бьефа", " см"},
+		averageLevel:   quantity{"средний уровень", " см"},
+		downstream:     quantity{"уровень нижнего бьефа", " см"},
+		volume:         quantity{"объём", " млн м³"},
+
+		inflowSection: "приток, %s",
+		inflow:        quantity{"приток", " м³/с"},
+		reset:         quantity{"сброс", " м³/с"},
+
+		dischargeSection: "расход измерен в %s",
+		dischargeLevel:   quantity{"уровень", " см"},
+		discharge:        quantity{"расход", " м³/с"},
+		area:             quantity{"площадь сечения", " м²"},
+		velocity:         quantity{"средняя скорость", " см/с"},
+		maxDepth:         quantity{"наибольшая глубина", " см"},
+		measuredAt:       "измерен %s в %02d ч",
+
+		snow: map[types.SnowHeight]string{
+			types.NoSnow:     "снега нет",
+			types.Less5:      "снег менее 5 см",
+			types.From5to10:  "снег 5–10 см",
+			types.From11to15: "снег 11–15 см",
+			types.From16to20: "снег 16–20 см",
+			types.From21to25: "снег 21–25 см",
+			types.From26to35: "снег 26–35 см",
+			types.From36to50: "снег 36–50 см",
+			types.From51to70: "снег 51–70 см",
+			types.More70:     "снег более 70 см",
+		},
+		duration: map[types.PrecipitationDuration]string{
+			types.Less1:     "менее 1 ч",
+			types.From1To3:  "1–3 ч",
+			types.From3To6:  "3–6 ч",
+			types.From6To12: "6–12 ч",
+			types.More12:    "более 12 ч",
+		},
+		months: [12]string{
+			"январе", "феврале", "марте", "апреле", "мае", "июне",
+			"июле", "августе", "сентябре", "октябре", "ноябре", "декабре",
+		},
+		day:        func(day byte) string { return fmt.Sprintf("%d-го", day) },
+		phenomenon: func(info types.PhenomenonInfo) string { return lowerFirst(info.NameRu) },
+	},
+}
@@ -0,0 +1,298 @@
+// Package render describes decoded KN-15 telegrams in plain language.
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+)
+
+type Language string
+
+const (
+	Russian Language = "ru"
+	English Language = "en"
+)
+
+// Languages returns the supported languages.
+func Languages() []Language {
+	return []Language{Russian, English}
+}
+
+// Describe renders t as one line of text in lang, for example
+// "Post 10950, 31st 08h: level 245 cm, rise 1 cm, water 4.2°C". Values
+// reported as not measured are named as such and absent groups are left
+// out. Each 944, 955 and 966 section follows after a semicolon.
+func Describe(t *decoder.Telegram, lang Language) (string, error) {
+
+	if t == nil {
+		return "", errors.New("telegram is nil")
+	}
+
+	d, ok := dictionaries[lang]
+	if !ok {
+		return "", fmt.Errorf("unsupported language %q", lang)
+	}
+
+	r := renderer{d: d}
+
+	sections := []string{
+		r.section(fmt.Sprintf(d.heading, t.PostCode, d.day(t.Date), t.Time), r.mainParts(t)),
+	}
+
+	if t.IsReservoirDate != nil && t.Reservoir != nil {
+		sections = append(sections, r.section(fmt.Sprintf(d.reservoir, d.day(byte(*t.IsReservoirDate))), []string{
+			measurement(r, d.headwaterLevel, t.Reservoir.HeadwaterLevel),
+			measurement(r, d.averageLevel, t.Reservoir.AverageReservoirLevel),
+			measurement(r, d.downstream, t.Reservoir.DownstreamLevel),
+			measurement(r, d.volume, t.Reservoir.ReservoirVolume),
+		}))
+	}
+
+	if t.IsReservoirWaterInflowDate != nil && t.ReservoirWaterInflow != nil {
+		sections = append(sections, r.section(fmt.Sprintf(d.inflowSection, d.day(byte(*t.IsReservoirWaterInflowDate))), []string{
+			measurement(r, d.inflow, t.ReservoirWaterInflow.Inflow),
+			measurement(r, d.reset, t.ReservoirWaterInflow.Reset),
+		}))
+	}
+
+	if t.IsMeasuredDischargeMonth != nil && t.MeasuredDischarge != nil {
+		sections = append(sections, r.section(fmt.Sprintf(d.dischargeSection, r.month(byte(*t.IsMeasuredDischargeMonth))), r.dischargeParts(t.MeasuredDischarge)))
+	}
+
+	return strings.Join(sections, "; "), nil
+}
+
+type renderer struct {
+	d *dictionary
+}
+
+// section joins the non-empty parts after heading.
+func (r renderer) section(heading string, parts []string) string {
+
+	var present []string
+	for _, part := range parts {
+		if part != "" {
+			present = append(present, part)
+		}
+	}
+
+	if len(present) == 0 {
+		return heading + ": " + r.d.noData
+	}
+
+	return heading + ": " + strings.Join(present, ", ")
+}
+
+func (r renderer) mainParts(t *decoder.Telegram) []string {
+
+	d := r.d
+	var parts []string
+
+	if t.IsDangerous {
+		parts = append(parts, d.dangerous)
+	}
+
+	parts = append(parts,
+		measurement(r, d.waterLevel, t.WaterLevelOnTime),
+		r.change(t.DeltaWaterLevel),
+		measurement(r, d.waterLevel20h, t.WaterLevelOn20h),
+	)
+
+	if t.Temperature != nil {
+		parts = append(parts,
+			measurement(r, d.waterTemp, t.Temperature.WaterTemperature),
+			measurement(r, d.airTemp, t.Temperature.AirTemperature),
+		)
+	}
+
+	parts = append(parts, r.phenomena(t.IcePhenomeniaState, t.IcePhenomenia)...)
+
+	if t.IceInfo != nil {
+		parts = append(parts, measurement(r, d.ice, t.IceInfo.Ice), r.snow(t.IceInfo.Snow))
+	}
+
+	parts = append(parts, measurement(r, d.waterflow, t.Waterflow))
+
+	if t.Precipitation != nil {
+		parts = append(parts, r.precipitation(t.Precipitation))
+	}
+
+	return parts
+}
+
+func (r renderer) dischargeParts(m *types.MeasuredDischarge) []string {
+
+	d := r.d
+	parts := []string{
+		measurement(r, d.dischargeLevel, m.WaterLevel),
+		measurement(r, d.discharge, m.Discharge),
+		measurement(r, d.area, m.CrossSectionArea),
+		measurement(r, d.velocity, m.AverageVelocity),
+		measurement(r, d.maxDepth, m.MaxDepth),
+	}
+
+	if m.MeasurementTime != nil {
+		parts = append(parts, fmt.Sprintf(d.measuredAt, d.day(m.MeasurementTime.Day), m.MeasurementTime.Hour))
+	}
+
+	return parts
+}
+
+func (r renderer) change(delta types.Measurement[types.DeltaWaterLevel]) string {
+
+	if delta.IsAbsent() {
+		return ""
+	}
+
+	value, ok := delta.Get()
+	switch {
+	case !ok:
+		return fmt.Sprintf(r.d.notMeasured, r.d.change)
+	case value > 0:
+		return r.quantity(r.d.rise, float64(value))
+	case value < 0:
+		return r.quantity(r.d.fall, float64(-value))
+	}
+
+	return r.d.noChange
+}
+
+func (r renderer) phenomena(state *types.IcePhenomeniaState, phenomenia []*types.Phenomenia) []string {
+
+	if state == nil {
+		return nil
+	}
+
+	var parts []string
+
+	if len(phenomenia) != 0 {
+		names := make([]string, 0, len(phenomenia))
+		for _, phenomenon := range phenomenia {
+			names = append(names, r.phenomenon(phenomenon))
+		}
+		parts = append(parts, r.d.phenomena+": "+strings.Join(names, ", "))
+	} else if *state == types.NaN {
+		parts = append(parts, fmt.Sprintf(r.d.notMeasured, r.d.phenomena))
+	}
+
+	if *state == types.Empty {
+		parts = append(parts, r.d.phenomenaEnded)
+	}
+
+	return parts
+}
+
+func (r renderer) phenomenon(p *types.Phenomenia) string {
+
+	name := fmt.Sprintf("%02d", p.Phenomen)
+	if info, ok := types.LookupPhenomenon(p.Phenomen); ok {
+		name = r.d.phenomenon(info)
+	}
+
+	if p.IsUntensity && p.Intensity != nil {
+		return fmt.Sprintf(r.d.intensity, name, *p.Intensity)
+	}
+
+	return name
+}
+
+func (r renderer) snow(snow types.Measurement[types.SnowHeight]) string {
+
+	if snow.IsAbsent() {
+		return ""
+	}
+
+	value, ok := snow.Get()
+	if !ok {
+		return fmt.Sprintf(r.d.notMeasured, r.d.snowCover)
+	}
+
+	if name, ok := r.d.snow[value]; ok {
+		return name
+	}
+
+	return ""
+}
+
+func (r renderer) precipitation(p *types.Precipitation) string {
+
+	text := measurement(r, r.d.precipitation, p.Value)
+	if text == "" {
+		return ""
+	}
+
+	if duration, ok := p.Duration.Get(); ok && p.Value.IsMeasured() {
+		if name, ok := r.d.duration[duration]; ok {
+			text += " (" + name + ")"
+		}
+	}
+
+	return text
+}
+
+func (r renderer) month(month byte) string {
+
+	if month < 1 || month > 12 {
+		return strconv.Itoa(int(month))
+	}
+
+	return r.d.months[month-1]
+}
+
+func (r renderer) quantity(q quantity, value float64) string {
+	return q.name + " " + r.number(value) + q.unit
+}
+
+func (r renderer) number(value float64) string {
+	return strings.Replace(strconv.FormatFloat(value, 'f', -1, 64), ".", r.d.decimalSeparator, 1)
+}
+
+// measurement renders m as q, or as not measured; an absent m renders as
+// an empty string.
+func measurement[T ~int32 | ~float64](r renderer, q quantity, m types.Measurement[T]) string {
+
+	if m.IsAbsent() {
+		return ""
+	}
+
+	value, ok := m.Get()
+	if !ok {
+		return fmt.Sprintf(r.d.notMeasured, q.name)
+	}
+
+	return r.quantity(q, float64(value))
+}
+
+func englishOrdinal(day byte) string {
+
+	suffix := "th"
+	if day%100 < 11 || day%100 > 13 {
+		switch day % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+
+	return strconv.Itoa(int(day)) + suffix
+}
+
+func lowerFirst(s string) string {
+
+	first, size := utf8.DecodeRuneInString(s)
+	if first == utf8.RuneError {
+		return s
+	}
+
+	return string(unicode.ToLower(first)) + s[size:]
+}
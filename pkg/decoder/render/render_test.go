@@ -0,0 +1,93 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+)
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		lang    Language
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "Main section in English",
+			input: "10950 31081 97701 10245 20011 30250 40410 51206 51803 70353 81225 00032",
+			lang:  English,
+			want: "Post 10950, 31st 08h: dangerous phenomenon, level 245 cm, rise 1 cm, level at 20h 250 cm, " +
+				"water 0.4°C, air 10°C, phenomena: slush 6/10, ice run 3/10, ice 35 cm, snow 11–15 cm, " +
+				"discharge 2.25 m³/s, precipitation 3 mm (1–3 h)",
+		},
+		{
+			name:  "Main section in Russian",
+			input: "10950 02201 10245 20052 40410 70353",
+			lang:  Russian,
+			want:  "Пост 10950, 2-го, 20 ч: уровень 245 см, спад 5 см, вода 0,4 °C, воздух 10 °C, лёд 35 см, снег 11–15 см",
+		},
+		{
+			name:  "Not measured values",
+			input: "10950 31081 1//// 2//// 4//// 5//// 7//// 8//// 0////",
+			lang:  English,
+			want: "Post 10950, 31st 08h: level not measured, level change not measured, water not measured, " +
+				"air not measured, phenomena not measured, ice not measured, snow not measured, " +
+				"discharge not measured, precipitation not measured",
+		},
+		{
+			name:  "Phenomena ended",
+			input: "10950 31081 10245 60000",
+			lang:  Russian,
+			want:  "Пост 10950, 31-го, 08 ч: уровень 245 см, ледовые явления прекратились",
+		},
+		{
+			name:  "Reservoir and inflow sections",
+			input: "10950 31081 10245 94414 11234 2//// 95514 42235",
+			lang:  English,
+			want: "Post 10950, 31st 08h: level 245 cm; reservoir on the 14th: headwater level 1234 cm, " +
+				"average level not measured; inflow on the 14th: inflow 23.5 m³/s",
+		},
+		{
+			name:  "Measured discharge section",
+			input: "10950 31081 10245 96604 10245 21230 40087 61408",
+			lang:  Russian,
+			want: "Пост 10950, 31-го, 08 ч: уровень 245 см; расход измерен в апреле: уровень 245 см, " +
+				"расход 2,3 м³/с, средняя скорость 87 см/с, измерен 14-го в 08 ч",
+		},
+		{
+			name:    "Unsupported language",
+			input:   "10950 31081 10245",
+			lang:    Language("de"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			telegram, err := decoder.NewTelegram(tt.input)
+			if err != nil {
+				t.Fatalf("NewTelegram() error = %v", err)
+			}
+
+			got, err := Describe(telegram, tt.lang)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Describe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Describe() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnglishOrdinal(t *testing.T) {
+	tests := map[byte]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 13: "13th", 21: "21st", 22: "22nd", 31: "31st"}
+
+	for day, want := range tests {
+		if got := englishOrdinal(day); got != want {
+			t.Errorf("englishOrdinal(%d) = %v, want %v", day, got, want)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package decoder
+
+// Report collects the problems found while decoding. Errors are groups
+// that could not be decoded, Warnings are groups that were decoded but
+// look suspicious.
+type Report struct {
+	Errors   []*DecodeError
+	Warnings []*DecodeError
+}
+
+func (r *Report) HasErrors() bool {
+	return len(r.Errors) != 0
+}
+
+func (r *Report) addError(err *DecodeError) {
+	r.Errors = append(r.Errors, err)
+}
+
+func (r *Report) addWarning(err *DecodeError) {
+	r.Warnings = append(r.Warnings, err)
+}
@@ -0,0 +1,154 @@
+package decoder
+
+import (
+	"reflect"
+	"testing"
+
+	types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+)
+
+func TestDecodeLenient(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		opts         []Option
+		wantErr      bool
+		wantErrors   []ErrorCode
+		wantWarnings []ErrorCode
+	}{
+		{
+			name:    "Strict mode stops at first bad group",
+			input:   "10950 31081 1a245 2012/ 30250",
+			wantErr: true,
+		},
+		{
+			name:       "Lenient mode collects every bad group",
+			input:      "10950 31081 1a245 2012/ 30250",
+			opts:       []Option{Lenient()},
+			wantErrors: []ErrorCode{CodeInvalidGroup, CodeInvalidSign},
+		},
+		{
+			name:         "Duplicate group is a warning",
+			input:        "10950 31081 10245 10250",
+			wantWarnings: []ErrorCode{CodeDuplicateGroup},
+		},
+		{
+			name:         "Unknown group is a warning by default",
+			input:        "10950 31081 10245 94431 80123",
+			wantWarnings: []ErrorCode{CodeUnknownGroup},
+		},
+		{
+			name:    "Unknown group fails in strict mode",
+			input:   "10950 31081 10245 94431 80123",
+			opts:    []Option{Strict()},
+			wantErr: true,
+		},
+		{
+			name:       "Unknown group is an error in strict lenient mode",
+			input:      "10950 31081 10245 92201 84123",
+			opts:       []Option{Strict(), Lenient()},
+			wantErrors: []ErrorCode{CodeUnknownGroup},
+		},
+		{
+			name:         "Same identifier in different sections is not a duplicate",
+			input:        "10950 31081 10245 94431 10250",
+			wantWarnings: nil,
+		},
+		{
+			name:         "Inflow section after the discharge section is unknown",
+			input:        "10950 31081 96604 15010 95531 73456",
+			wantWarnings: []ErrorCode{CodeUnknownGroup, CodeUnknownGroup},
+		},
+		{
+			name:         "Repeated phenomena are not duplicates",
+			input:        "10950 31081 51206 51506",
+			wantWarnings: nil,
+		},
+		{
+			name:         "Second max depth group is a duplicate",
+			input:        "10950 31081 96604 50310 50320",
+			wantWarnings: []ErrorCode{CodeDuplicateGroup},
+		},
+		{
+			name:       "Measurement day missing in the month",
+			input:      "10950 31081 96602 63110",
+			opts:       []Option{Lenient()},
+			wantErrors: []ErrorCode{CodeInvalidDay},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			telegram, report, err := Decode(tt.input, tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if telegram == nil {
+				t.Fatalf("Decode() returned nil telegram")
+			}
+			if got := problemCodes(report.Errors); !equalCodes(got, tt.wantErrors) {
+				t.Errorf("Decode() errors = %v, want %v", got, tt.wantErrors)
+			}
+			if got := problemCodes(report.Warnings); !equalCodes(got, tt.wantWarnings) {
+				t.Errorf("Decode() warnings = %v, want %v", got, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func TestDecodeLenientKeepsPartialTelegram(t *testing.T) {
+
+	telegram, report, err := Decode("10950 31081 1a245 2012/ 30250", Lenient())
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !report.HasErrors() {
+		t.Fatalf("Decode() report has no errors")
+	}
+	if telegram.PostCode != "10950" || telegram.WaterLevelOn20h != types.NewMeasured[types.WaterLevelOn20h](250) {
+		t.Errorf("Decode() = %+v, want post code and 20h level decoded", telegram)
+	}
+	if !telegram.WaterLevelOnTime.IsAbsent() || !telegram.DeltaWaterLevel.IsAbsent() {
+		t.Errorf("Decode() decoded values from bad groups")
+	}
+}
+
+func TestDecodeIgnoredGroups(t *testing.T) {
+
+	telegram, _, err := Decode("10950 31081 10245 94431 80123 97701")
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	want := []IgnoredGroup{
+		{Group: "80123", Section: SectionReservoir, Block: 4, Offset: 24},
+		{Group: "97701", Section: SectionReservoir, Block: 5, Offset: 30},
+	}
+
+	if !reflect.DeepEqual(telegram.IgnoredGroups, want) {
+		t.Errorf("Decode() ignored groups = %+v, want %+v", telegram.IgnoredGroups, want)
+	}
+}
+
+func problemCodes(problems []*DecodeError) []ErrorCode {
+	var codes []ErrorCode
+	for _, problem := range problems {
+		codes = append(codes, problem.Code)
+	}
+	return codes
+}
+
+func equalCodes(a, b []ErrorCode) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
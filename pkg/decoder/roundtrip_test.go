@@ -0,0 +1,146 @@
+package decoder_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/encoder"
+)
+
+// Groups are enumerated over groupAlphabet, or over the smaller
+// shortGroupAlphabet under -short.
+const (
+	groupAlphabet      = "0123456789/"
+	shortGroupAlphabet = "0159/"
+)
+
+// roundTripGroups lists every group type with the text that has to precede
+// it. Each group is tried with every combination of characters after its
+// identifier.
+var roundTripGroups = []struct {
+	name       string
+	prefix     string
+	identifier string
+	suffix     string
+}{
+	{name: "Date and time", prefix: "10950", identifier: ""},
+	{name: "Danger", prefix: "10950 31081", identifier: "977"},
+	{name: "Water level on time", prefix: "10950 31081", identifier: "1"},
+	{name: "Delta water level", prefix: "10950 31081", identifier: "2"},
+	{name: "Water level on 20h", prefix: "10950 31081", identifier: "3"},
+	{name: "Temperature", prefix: "10950 31081", identifier: "4"},
+	{name: "Ice phenomenia", prefix: "10950 31081", identifier: "5"},
+	{name: "Ice phenomenia state", prefix: "10950 31081", identifier: "6"},
+	{name: "Ice info", prefix: "10950 31081", identifier: "7"},
+	{name: "Waterflow", prefix: "10950 31081", identifier: "8"},
+	{name: "Precipitation", prefix: "10950 31081", identifier: "0"},
+	{name: "Previous day", prefix: "10950 31081 10245", identifier: "922", suffix: "10240"},
+	{name: "Reservoir date", prefix: "10950 31081", identifier: "944", suffix: "10240"},
+	{name: "Headwater level", prefix: "10950 31081 94431", identifier: "1"},
+	{name: "Average reservoir level", prefix: "10950 31081 94431", identifier: "2"},
+	{name: "Downstream level", prefix: "10950 31081 94431", identifier: "4"},
+	{name: "Reservoir volume", prefix: "10950 31081 94431", identifier: "7"},
+	{name: "Inflow date", prefix: "10950 31081", identifier: "955", suffix: "43123"},
+	{name: "Inflow", prefix: "10950 31081 95531", identifier: "4"},
+	{name: "Reset", prefix: "10950 31081 95531", identifier: "7"},
+	{name: "Measured discharge month", prefix: "10950 31081", identifier: "966", suffix: "24123"},
+	{name: "Measured water level", prefix: "10950 31081 96604", identifier: "1"},
+	{name: "Discharge", prefix: "10950 31081 96604", identifier: "2"},
+	{name: "Cross-section area", prefix: "10950 31081 96604", identifier: "3"},
+	{name: "Average velocity", prefix: "10950 31081 96604", identifier: "4"},
+	{name: "Max depth", prefix: "10950 31081 96604", identifier: "5"},
+	{name: "Measurement time", prefix: "10950 31081 96604", identifier: "6"},
+}
+
+func TestRoundTripEveryGroup(t *testing.T) {
+	for _, tt := range roundTripGroups {
+		t.Run(tt.name, func(t *testing.T) {
+			alphabet := groupAlphabet
+			if testing.Short() {
+				alphabet = shortGroupAlphabet
+			}
+
+			var decoded int
+
+			forEachGroup(tt.identifier, alphabet, func(group string) {
+				code := strings.Join(strings.Fields(tt.prefix+" "+group+" "+tt.suffix), " ")
+				if checkRoundTrip(t, code) {
+					decoded++
+				}
+			})
+
+			if decoded == 0 {
+				t.Errorf("no %s group decoded", tt.name)
+			}
+		})
+	}
+}
+
+func TestRoundTripTelegrams(t *testing.T) {
+	tests := []string{
+		"10950 31081 10245 20122 30250 42515 51212 51415 70101 81220 00302=",
+		"10950 31201 97701 1//// 2//// 4//// 5//// 7//// 8//// 0////=",
+		"10950 31081 10245 60000 51203 51212=",
+		"10950 01081 10245 92230 10240 20051 92229 10250=",
+		"10950 31081 10245 94431 12345 22345 42345 73123 95531 43123 73456 96604 15010 21001 31999 40087 50310 61409=",
+	}
+
+	for _, code := range tests {
+		t.Run(code, func(t *testing.T) {
+			if !checkRoundTrip(t, code) {
+				t.Fatalf("telegram %q does not decode", code)
+			}
+		})
+	}
+}
+
+func FuzzRoundTrip(f *testing.F) {
+	for _, tt := range roundTripGroups {
+		f.Add(strings.Join(strings.Fields(tt.prefix+" "+tt.identifier+strings.Repeat("1", 5-len(tt.identifier))+" "+tt.suffix), " "))
+	}
+	f.Add("10950 31081 10245 20122 30250 42515 51212 51415 70101 81220 00302=")
+	f.Add("10950 01081 10245 92230 10240 20051 92229 10250=")
+
+	f.Fuzz(func(t *testing.T, code string) {
+		checkRoundTrip(t, code)
+	})
+}
+
+// checkRoundTrip fails t if a telegram the decoder accepts does not survive
+// an encode and a second decode. It reports whether code decoded at all.
+// Canonicalize compares both decodings, so a clean result also means the
+// canonical form is its own canonical form.
+func checkRoundTrip(t *testing.T, code string) bool {
+	t.Helper()
+
+	canonical, diff, err := encoder.Canonicalize(code)
+	if err != nil {
+		if _, decodeErr := decoder.NewtelegramsSlice(code); decodeErr != nil {
+			return false
+		}
+		t.Fatalf("Canonicalize(%q) error = %v", code, err)
+	}
+
+	for _, d := range diff {
+		if !strings.HasSuffix(d.Field, "IgnoredGroups") {
+			t.Fatalf("Canonicalize(%q) = %q changed %s from %q to %q", code, canonical, d.Field, d.Input, d.Output)
+		}
+	}
+
+	return true
+}
+
+// forEachGroup calls fn with every five character group that starts with
+// identifier and continues with characters of alphabet.
+func forEachGroup(identifier, alphabet string, fn func(string)) {
+
+	if len(identifier) == 5 {
+		fn(identifier)
+		return
+	}
+
+	for i := 0; i < len(alphabet); i++ {
+		forEachGroup(identifier+alphabet[i:i+1], alphabet, fn)
+	}
+}
@@ -0,0 +1,136 @@
+package decoder
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+)
+
+// maxTelegramSize limits a single '='-terminated telegram in a stream.
+const maxTelegramSize = 1 << 20
+
+// Position locates a telegram in a stream. Line is 1-based, Offset is the
+// byte offset of the first group.
+type Position struct {
+	Line   int
+	Offset int
+}
+
+// StreamDecoder reads '='-terminated telegrams from an io.Reader one at a
+// time, so archives do not have to be loaded into memory. Transport
+// headings are stripped as by StripEnvelope.
+type StreamDecoder struct {
+	scanner  *bufio.Scanner
+	opts     *options
+	advance  int
+	offset   int
+	line     int
+	pending  []*Telegram
+	position Position
+	report   *Report
+	envelope *Envelope
+	buffers  groupBuffers
+	err      error
+}
+
+func NewStreamDecoder(r io.Reader, opts ...Option) *StreamDecoder {
+
+	d := &StreamDecoder{
+		opts:   newOptions(opts),
+		line:   1,
+		report: &Report{},
+	}
+
+	d.scanner = bufio.NewScanner(r)
+	d.scanner.Buffer(make([]byte, 0, 64*1024), maxTelegramSize)
+	d.scanner.Split(d.split)
+
+	return d
+}
+
+// Next returns the next decoded telegram. A telegram with 922 sections is
+// returned as several consecutive telegrams. A *DecodeError is returned for
+// a telegram that cannot be decoded and the stream can be read further;
+// any other error, including io.EOF at the end, is final.
+func (d *StreamDecoder) Next() (*Telegram, error) {
+
+	if len(d.pending) > 0 {
+		telegram := d.pending[0]
+		d.pending = d.pending[1:]
+		return telegram, nil
+	}
+
+	for {
+		if d.err != nil {
+			return nil, d.err
+		}
+
+		if !d.scanner.Scan() {
+			d.err = d.scanner.Err()
+			if d.err == nil {
+				d.err = io.EOF
+			}
+			return nil, d.err
+		}
+
+		body, envelope := StripEnvelope(d.scanner.Text())
+		if len(envelope.Headers) != 0 {
+			d.envelope = envelope
+		}
+
+		start, startLine := d.offset, d.line
+		d.offset += d.advance
+		d.line += strings.Count(body, "\n")
+
+		trimmed := strings.TrimLeft(body, " \t\n\r\v\f")
+		if trimmed == "" {
+			continue
+		}
+
+		lead := len(body) - len(trimmed)
+		d.position = Position{
+			Line:   startLine + strings.Count(body[:lead], "\n"),
+			Offset: start + lead,
+		}
+		d.report = &Report{}
+
+		telegrams, err := decodeSequences(d.buffers.parseGroups(body, start), &d.buffers, d.opts, d.report)
+		if err != nil {
+			return nil, err
+		}
+
+		d.pending = telegrams[1:]
+		return telegrams[0], nil
+	}
+}
+
+// Position returns where the telegram last returned by Next starts.
+func (d *StreamDecoder) Position() Position {
+	return d.position
+}
+
+// Report returns the problems found in the telegram last returned by Next.
+func (d *StreamDecoder) Report() *Report {
+	return d.report
+}
+
+// Envelope returns the last transport heading seen in the stream, or nil.
+func (d *StreamDecoder) Envelope() *Envelope {
+	return d.envelope
+}
+
+func (d *StreamDecoder) split(data []byte, atEOF bool) (int, []byte, error) {
+
+	if end := bytes.IndexByte(data, '='); end >= 0 {
+		d.advance = end + 1
+		return end + 1, data[:end], nil
+	}
+
+	if atEOF && len(data) > 0 {
+		d.advance = len(data)
+		return len(data), data, nil
+	}
+
+	return 0, nil, nil
+}
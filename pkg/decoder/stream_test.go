@@ -0,0 +1,61 @@
+package decoder
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestStreamDecoder(t *testing.T) {
+
+	input := "ZCZC 001\n10950 31081\n  10245 20122=\n\n10951 31081 1a245=10952 31081\n10300 92230 10290=\n\n"
+
+	type item struct {
+		postCode string
+		date     byte
+		position Position
+		errCode  ErrorCode
+	}
+
+	want := []item{
+		{postCode: "10950", date: 31, position: Position{Line: 2, Offset: 9}},
+		{errCode: CodeInvalidGroup, position: Position{Line: 5, Offset: 37}},
+		{postCode: "10952", date: 31, position: Position{Line: 5, Offset: 55}},
+		{postCode: "10952", date: 30, position: Position{Line: 5, Offset: 55}},
+	}
+
+	d := NewStreamDecoder(strings.NewReader(input))
+
+	for i, w := range want {
+		telegram, err := d.Next()
+
+		if w.errCode != "" {
+			var decodeErr *DecodeError
+			if !errors.As(err, &decodeErr) || decodeErr.Code != w.errCode {
+				t.Fatalf("item %d: Next() error = %v, want %s", i, err, w.errCode)
+			}
+			if decodeErr.Offset != 49 {
+				t.Errorf("item %d: error offset = %d, want 49", i, decodeErr.Offset)
+			}
+		} else {
+			if err != nil {
+				t.Fatalf("item %d: Next() error = %v", i, err)
+			}
+			if string(telegram.PostCode) != w.postCode || telegram.Date != w.date {
+				t.Errorf("item %d: Next() = %s %d, want %s %d", i, telegram.PostCode, telegram.Date, w.postCode, w.date)
+			}
+		}
+
+		if d.Position() != w.position {
+			t.Errorf("item %d: Position() = %+v, want %+v", i, d.Position(), w.position)
+		}
+	}
+
+	if _, err := d.Next(); err != io.EOF {
+		t.Errorf("Next() at end error = %v, want io.EOF", err)
+	}
+	if d.Envelope() == nil || d.Envelope().Serial != "001" {
+		t.Errorf("Envelope() = %+v, want serial 001", d.Envelope())
+	}
+}
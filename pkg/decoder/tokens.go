@@ -0,0 +1,195 @@
+package decoder
+
+import (
+	types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+)
+
+// GroupKind is the meaning of a group within its telegram.
+type GroupKind string
+
+const (
+	KindPostCode              GroupKind = "post_code"
+	KindDate                  GroupKind = "date"
+	KindPreviousDay           GroupKind = "previous_day"
+	KindDangerous             GroupKind = "dangerous"
+	KindWaterLevel            GroupKind = "water_level"
+	KindDeltaWaterLevel       GroupKind = "delta_water_level"
+	KindWaterLevel20h         GroupKind = "water_level_20h"
+	KindTemperature           GroupKind = "temperature"
+	KindPhenomenon            GroupKind = "phenomenon"
+	KindIcePhenomeniaState    GroupKind = "ice_phenomenia_state"
+	KindIceInfo               GroupKind = "ice_info"
+	KindWaterflow             GroupKind = "waterflow"
+	KindPrecipitation         GroupKind = "precipitation"
+	KindReservoirMarker       GroupKind = "reservoir_marker"
+	KindHeadwaterLevel        GroupKind = "headwater_level"
+	KindAverageReservoirLevel GroupKind = "average_reservoir_level"
+	KindDownstreamLevel       GroupKind = "downstream_level"
+	KindReservoirVolume       GroupKind = "reservoir_volume"
+	KindInflowMarker          GroupKind = "inflow_marker"
+	KindInflow                GroupKind = "inflow"
+	KindReset                 GroupKind = "reset"
+	KindDischargeMarker       GroupKind = "discharge_marker"
+	KindMeasuredWaterLevel    GroupKind = "measured_water_level"
+	KindDischarge             GroupKind = "discharge"
+	KindCrossSectionArea      GroupKind = "cross_section_area"
+	KindAverageVelocity       GroupKind = "average_velocity"
+	KindMaxDepth              GroupKind = "max_depth"
+	KindMeasurementTime       GroupKind = "measurement_time"
+	// KindUnknown is a well-formed group that has no meaning in its section.
+	KindUnknown GroupKind = "unknown"
+	// KindInvalid is a group that is not 5 digits or '/'.
+	KindInvalid GroupKind = "invalid"
+)
+
+// Token is a single group of the input as the decoder sees it. Start and
+// End are the byte span of the group in the input. Value is the decoded
+// value of the group, for example a Measurement[WaterLevelOnTime], a
+// Temperature or the []Phenomenia of a 5 group, and is nil when Err is
+// set.
+type Token struct {
+	Group   string
+	Start   int
+	End     int
+	Section Section
+	Kind    GroupKind
+	Value   any
+	Err     *DecodeError
+}
+
+// Tokenize splits s into groups and classifies each of them the same way
+// Decode does. It never fails: bad and unrecognized groups are returned
+// with Err set, so partial input can be highlighted while it is typed.
+// Every '='-terminated telegram of a bulletin is tokenized on its own.
+func Tokenize(s string, opts ...Option) []Token {
+
+	o := newOptions(opts)
+
+	var tokens []Token
+
+	for _, segment := range splitBulletin(s) {
+
+		groups := parseGroups(segment.text, segment.offset)
+
+		sequences := splitSequence(groups)
+		if len(sequences) == 0 {
+			sequences = []sequence{{groups: groups, section: SectionMain}}
+		}
+
+		reference := o.referenceTime()
+		seen := make(map[int]bool)
+
+		for _, sequence := range sequences {
+			telegram := newTelegram()
+			section := sequence.section
+
+			for i, g := range sequence.groups {
+
+				phenomena := len(telegram.IcePhenomenia)
+				kind, next, err := telegram.decodeGroup(i, g.value, section, reference)
+				section = next
+
+				if seen[g.offset] {
+					continue
+				}
+				seen[g.offset] = true
+
+				token := Token{
+					Group:   g.source,
+					Start:   g.offset,
+					End:     g.offset + len(g.source),
+					Section: section,
+					Kind:    kind,
+				}
+
+				switch {
+				case kind == KindUnknown:
+					token.Err = locate(newDecodeError(CodeUnknownGroup, "group is not recognized in this section"), g, section)
+				case err != nil:
+					token.Err = locate(err, g, section)
+				default:
+					token.Value = telegram.groupValue(kind, phenomena)
+				}
+
+				tokens = append(tokens, token)
+			}
+
+			if sequence.section == SectionMain && !telegram.DateTime.IsZero() {
+				reference = telegram.DateTime
+			}
+		}
+	}
+
+	return tokens
+}
+
+// groupValue returns the value that the last group of kind decoded into t.
+// phenomena is the number of phenomena t had before that group.
+func (t *Telegram) groupValue(kind GroupKind, phenomena int) any {
+
+	switch kind {
+	case KindPostCode:
+		return t.PostCode
+	case KindDate, KindPreviousDay:
+		return t.DateAndTime
+	case KindDangerous:
+		return t.IsDangerous
+	case KindWaterLevel:
+		return t.WaterLevelOnTime
+	case KindDeltaWaterLevel:
+		return t.DeltaWaterLevel
+	case KindWaterLevel20h:
+		return t.WaterLevelOn20h
+	case KindTemperature:
+		return *t.Temperature
+	case KindPhenomenon:
+		if len(t.IcePhenomenia) == phenomena {
+			return *t.IcePhenomeniaState
+		}
+		added := make([]types.Phenomenia, 0, len(t.IcePhenomenia)-phenomena)
+		for _, phenomenon := range t.IcePhenomenia[phenomena:] {
+			added = append(added, *phenomenon)
+		}
+		return added
+	case KindIcePhenomeniaState:
+		return *t.IcePhenomeniaState
+	case KindIceInfo:
+		return *t.IceInfo
+	case KindWaterflow:
+		return t.Waterflow
+	case KindPrecipitation:
+		return *t.Precipitation
+	case KindReservoirMarker:
+		return *t.IsReservoirDate
+	case KindHeadwaterLevel:
+		return t.Reservoir.HeadwaterLevel
+	case KindAverageReservoirLevel:
+		return t.Reservoir.AverageReservoirLevel
+	case KindDownstreamLevel:
+		return t.Reservoir.DownstreamLevel
+	case KindReservoirVolume:
+		return t.Reservoir.ReservoirVolume
+	case KindInflowMarker:
+		return *t.IsReservoirWaterInflowDate
+	case KindInflow:
+		return t.ReservoirWaterInflow.Inflow
+	case KindReset:
+		return t.ReservoirWaterInflow.Reset
+	case KindDischargeMarker:
+		return *t.IsMeasuredDischargeMonth
+	case KindMeasuredWaterLevel:
+		return t.MeasuredDischarge.WaterLevel
+	case KindDischarge:
+		return t.MeasuredDischarge.Discharge
+	case KindCrossSectionArea:
+		return t.MeasuredDischarge.CrossSectionArea
+	case KindAverageVelocity:
+		return t.MeasuredDischarge.AverageVelocity
+	case KindMaxDepth:
+		return t.MeasuredDischarge.MaxDepth
+	case KindMeasurementTime:
+		return *t.MeasuredDischarge.MeasurementTime
+	}
+
+	return nil
+}
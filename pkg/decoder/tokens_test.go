@@ -0,0 +1,72 @@
+package decoder
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+)
+
+func TestTokenize(t *testing.T) {
+
+	input := "10950 31081 10245 51206 96604 21230 7//// 922 30 92230 1a245=\n10951"
+
+	type item struct {
+		group   string
+		start   int
+		section Section
+		kind    GroupKind
+		value   any
+		errCode ErrorCode
+	}
+
+	want := []item{
+		{group: "10950", start: 0, section: SectionMain, kind: KindPostCode, value: types.PostCode("10950")},
+		{group: "31081", start: 6, section: SectionMain, kind: KindDate, value: types.DateAndTime{Date: 31, Time: 8, EndBlockNum: 1}},
+		{group: "10245", start: 12, section: SectionMain, kind: KindWaterLevel, value: types.NewMeasured(types.WaterLevelOnTime(245))},
+		{group: "51206", start: 18, section: SectionMain, kind: KindPhenomenon, value: []types.Phenomenia{{Phenomen: 12, IsUntensity: true, Intensity: intensity(6)}}},
+		{group: "96604", start: 24, section: SectionMeasuredDischarge, kind: KindDischargeMarker, value: types.IsMeasuredDischargeMonth(4)},
+		{group: "21230", start: 30, section: SectionMeasuredDischarge, kind: KindDischarge, value: types.NewMeasured(types.Discharge(2.3))},
+		{group: "7////", start: 36, section: SectionMeasuredDischarge, kind: KindUnknown, errCode: CodeUnknownGroup},
+		{group: "922", start: 42, section: SectionMeasuredDischarge, kind: KindInvalid, errCode: CodeInvalidGroup},
+		{group: "30", start: 46, section: SectionMeasuredDischarge, kind: KindInvalid, errCode: CodeInvalidGroup},
+		{group: "92230", start: 49, section: SectionPreviousDay, kind: KindPreviousDay, value: types.DateAndTime{Date: 30, Time: 8, EndBlockNum: 1}},
+		{group: "1a245", start: 55, section: SectionPreviousDay, kind: KindInvalid, errCode: CodeInvalidGroup},
+		{group: "10951", start: 62, section: SectionMain, kind: KindPostCode, value: types.PostCode("10951")},
+	}
+
+	tokens := Tokenize(input, ReferenceTime(time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)))
+	if len(tokens) != len(want) {
+		t.Fatalf("Tokenize() returned %d tokens, want %d: %+v", len(tokens), len(want), tokens)
+	}
+
+	for i, w := range want {
+		token := tokens[i]
+
+		if token.Group != w.group || token.Start != w.start || token.End != w.start+len(w.group) {
+			t.Errorf("token %d: span = %q [%d:%d], want %q at %d", i, token.Group, token.Start, token.End, w.group, w.start)
+		}
+		if token.Section != w.section || token.Kind != w.kind {
+			t.Errorf("token %d: %s in %s, want %s in %s", i, token.Kind, token.Section, w.kind, w.section)
+		}
+
+		if w.errCode != "" {
+			if token.Err == nil || token.Err.Code != w.errCode {
+				t.Errorf("token %d: Err = %v, want %s", i, token.Err, w.errCode)
+			}
+			continue
+		}
+
+		if token.Err != nil {
+			t.Errorf("token %d: Err = %v", i, token.Err)
+		}
+		if !reflect.DeepEqual(token.Value, w.value) {
+			t.Errorf("token %d: Value = %#v, want %#v", i, token.Value, w.value)
+		}
+	}
+}
+
+func intensity(v byte) *byte {
+	return &v
+}
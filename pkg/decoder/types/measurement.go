@@ -0,0 +1,141 @@
+package decoder_types
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+)
+
+// MeasurementState tells whether a telegram value is reported and measured.
+type MeasurementState byte
+
+const (
+	// Absent means the group carrying the value is not in the telegram.
+	Absent MeasurementState = iota
+	// NotMeasured means the group is present but the value is written as
+	// '/' because it could not be measured.
+	NotMeasured
+	// Measured means the group carries a value.
+	Measured
+)
+
+func (s MeasurementState) String() string {
+
+	switch s {
+	case Absent:
+		return "absent"
+	case NotMeasured:
+		return "not measured"
+	case Measured:
+		return "measured"
+	}
+
+	return fmt.Sprintf("MeasurementState(%d)", byte(s))
+}
+
+// Measurement is a telegram value that may be absent, reported as not
+// measured or measured. The zero value is absent.
+type Measurement[T any] struct {
+	state MeasurementState
+	value T
+}
+
+// NewMeasured returns a measured value.
+func NewMeasured[T any](value T) Measurement[T] {
+	return Measurement[T]{state: Measured, value: value}
+}
+
+// NewNotMeasured returns a value reported as not measured.
+func NewNotMeasured[T any]() Measurement[T] {
+	return Measurement[T]{state: NotMeasured}
+}
+
+// ConvertMeasurement applies convert to the value of m, keeping its state.
+func ConvertMeasurement[T, U any](m Measurement[T], convert func(T) U) Measurement[U] {
+
+	if m.state != Measured {
+		return Measurement[U]{state: m.state}
+	}
+
+	return NewMeasured(convert(m.value))
+}
+
+func (m Measurement[T]) State() MeasurementState {
+	return m.state
+}
+
+// Get returns the value and whether it was measured.
+func (m Measurement[T]) Get() (T, bool) {
+	return m.value, m.state == Measured
+}
+
+func (m Measurement[T]) IsAbsent() bool {
+	return m.state == Absent
+}
+
+func (m Measurement[T]) IsNotMeasured() bool {
+	return m.state == NotMeasured
+}
+
+func (m Measurement[T]) IsMeasured() bool {
+	return m.state == Measured
+}
+
+// SetNotMeasured marks the value as reported but not measured.
+func (m *Measurement[T]) SetNotMeasured() {
+	*m = NewNotMeasured[T]()
+}
+
+func (m Measurement[T]) String() string {
+
+	if m.state != Measured {
+		return m.state.String()
+	}
+
+	return fmt.Sprint(m.value)
+}
+
+// Value implements driver.Valuer. Only a measured value is stored; absent
+// and not measured values are NULL, so storage that needs to tell them
+// apart keeps the state on its own.
+func (m Measurement[T]) Value() (driver.Value, error) {
+
+	if m.state != Measured {
+		return nil, nil
+	}
+
+	v := reflect.ValueOf(m.value)
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return int64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	}
+
+	return nil, fmt.Errorf("measurement of %s cannot be stored", v.Type())
+}
+
+// Scan implements sql.Scanner. NULL scans as absent and any other value as
+// measured.
+func (m *Measurement[T]) Scan(src any) error {
+
+	if src == nil {
+		*m = Measurement[T]{}
+		return nil
+	}
+
+	target := reflect.ValueOf(&m.value).Elem()
+	v := reflect.ValueOf(src)
+
+	if !v.CanConvert(target.Type()) {
+		return fmt.Errorf("cannot scan %T into measurement of %s", src, target.Type())
+	}
+
+	target.Set(v.Convert(target.Type()))
+	m.state = Measured
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package decoder_types
+
+import (
+	"database/sql/driver"
+	"testing"
+)
+
+func TestMeasurement(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     Measurement[WaterLevelOnTime]
+		wantState MeasurementState
+		wantValue driver.Value
+		wantStr   string
+	}{
+		{
+			name:      "Absent",
+			input:     Measurement[WaterLevelOnTime]{},
+			wantState: Absent,
+			wantValue: nil,
+			wantStr:   "absent",
+		},
+		{
+			name:      "Not measured",
+			input:     NewNotMeasured[WaterLevelOnTime](),
+			wantState: NotMeasured,
+			wantValue: nil,
+			wantStr:   "not measured",
+		},
+		{
+			name:      "Measured",
+			input:     NewMeasured(WaterLevelOnTime(-766)),
+			wantState: Measured,
+			wantValue: int64(-766),
+			wantStr:   "-766",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.State(); got != tt.wantState {
+				t.Errorf("State() = %v, want %v", got, tt.wantState)
+			}
+			if got := tt.input.String(); got != tt.wantStr {
+				t.Errorf("String() = %v, want %v", got, tt.wantStr)
+			}
+
+			value, err := tt.input.Value()
+			if err != nil || value != tt.wantValue {
+				t.Errorf("Value() = %v, %v, want %v", value, err, tt.wantValue)
+			}
+
+			converted := ConvertMeasurement(tt.input, func(v WaterLevelOnTime) int32 { return int32(v) })
+			if converted.State() != tt.wantState {
+				t.Errorf("ConvertMeasurement() state = %v, want %v", converted.State(), tt.wantState)
+			}
+
+			var scanned Measurement[WaterLevelOnTime]
+			if err := scanned.Scan(value); err != nil {
+				t.Fatalf("Scan() error = %v", err)
+			}
+			if tt.wantState == NotMeasured {
+				scanned.SetNotMeasured()
+			}
+			if scanned != tt.input {
+				t.Errorf("Scan() = %v, want %v", scanned, tt.input)
+			}
+		})
+	}
+}
+
+func TestMeasurementScanError(t *testing.T) {
+	var m Measurement[float64]
+
+	if err := m.Scan("1.5"); err == nil {
+		t.Errorf("Scan() scanned a string into a float measurement")
+	}
+}
@@ -0,0 +1,153 @@
+package decoder_types
+
+// PhenomenonCode is the EE code of the 5EEii group: an ice phenomenon or a
+// state of the water body.
+type PhenomenonCode byte
+
+const (
+	GreaseIce               PhenomenonCode = 11
+	Slush                   PhenomenonCode = 12
+	InitialBorderIce        PhenomenonCode = 13
+	DriftedBorderIce        PhenomenonCode = 14
+	BorderIce               PhenomenonCode = 15
+	FrazilRun               PhenomenonCode = 16
+	FrazilRunOverIceCover   PhenomenonCode = 17
+	IceRun                  PhenomenonCode = 18
+	IceRunOverIceCover      PhenomenonCode = 19
+	AnchorIce               PhenomenonCode = 20
+	AnchorIceAccumulations  PhenomenonCode = 21
+	StrandedIce             PhenomenonCode = 22
+	IceCoverWithPolynyas    PhenomenonCode = 23
+	PartialFreezeUp         PhenomenonCode = 24
+	IceHummocks             PhenomenonCode = 25
+	IceBridge               PhenomenonCode = 26
+	FreezeUp                PhenomenonCode = 27
+	WaterOnIce              PhenomenonCode = 28
+	DarkenedIce             PhenomenonCode = 29
+	ShoreLeads              PhenomenonCode = 30
+	IceLifted               PhenomenonCode = 31
+	IceShift                PhenomenonCode = 32
+	OpenLeads               PhenomenonCode = 33
+	IceMeltingInPlace       PhenomenonCode = 34
+	ResidualBorderIce       PhenomenonCode = 35
+	IcePiledOnBanks         PhenomenonCode = 36
+	IceJamUpstream          PhenomenonCode = 37
+	IceJamDownstream        PhenomenonCode = 38
+	FrazilJamUpstream       PhenomenonCode = 39
+	FrazilJamDownstream     PhenomenonCode = 40
+	IceJamBreakUp           PhenomenonCode = 41
+	Aufeis                  PhenomenonCode = 42
+	AufeisWater             PhenomenonCode = 43
+	FrozenToBottom          PhenomenonCode = 44
+	DriedUp                 PhenomenonCode = 45
+	WindSetup               PhenomenonCode = 46
+	WindSetdown             PhenomenonCode = 47
+	WaterRelease            PhenomenonCode = 48
+	AquaticVegetationAtBank PhenomenonCode = 49
+	OvergrownChannel        PhenomenonCode = 50
+	DriftwoodRun            PhenomenonCode = 51
+	LogJam                  PhenomenonCode = 52
+	TimberRafting           PhenomenonCode = 53
+	BankCollapse            PhenomenonCode = 54
+	ChannelDeformation      PhenomenonCode = 55
+)
+
+// MaxPhenomenonIntensity is the largest ii value; intensity is given in
+// tenths of the water surface covered.
+const MaxPhenomenonIntensity byte = 10
+
+type PhenomenonCategory string
+
+const (
+	CategoryIce        PhenomenonCategory = "ice"
+	CategoryJam        PhenomenonCategory = "jam"
+	CategoryIcing      PhenomenonCategory = "icing"
+	CategoryWater      PhenomenonCategory = "water"
+	CategoryVegetation PhenomenonCategory = "vegetation"
+	CategoryDebris     PhenomenonCategory = "debris"
+	CategoryChannel    PhenomenonCategory = "channel"
+)
+
+// PhenomenonInfo describes one code of the KN-15 phenomena table.
+type PhenomenonInfo struct {
+	Code             PhenomenonCode
+	NameRu           string
+	NameEn           string
+	Category         PhenomenonCategory
+	IntensityAllowed bool
+}
+
+var phenomena = []PhenomenonInfo{
+	{GreaseIce, "Сало", "Grease ice", CategoryIce, true},
+	{Slush, "Снежура", "Slush", CategoryIce, true},
+	{InitialBorderIce, "Забереги первичные", "Initial border ice", CategoryIce, true},
+	{DriftedBorderIce, "Забереги наносные", "Drifted border ice", CategoryIce, true},
+	{BorderIce, "Забереги", "Border ice", CategoryIce, true},
+	{FrazilRun, "Шугоход", "Frazil run", CategoryIce, true},
+	{FrazilRunOverIceCover, "Шугоход поверх ледяного покрова", "Frazil run over ice cover", CategoryIce, true},
+	{IceRun, "Ледоход", "Ice run", CategoryIce, true},
+	{IceRunOverIceCover, "Ледоход поверх ледяного покрова", "Ice run over ice cover", CategoryIce, true},
+	{AnchorIce, "Внутриводный лед", "Anchor ice", CategoryIce, false},
+	{AnchorIceAccumulations, "Пятры", "Anchor ice accumulations", CategoryIce, false},
+	{StrandedIce, "Осевший лед", "Stranded ice", CategoryIce, false},
+	{IceCoverWithPolynyas, "Ледяной покров с полыньями", "Ice cover with polynyas", CategoryIce, true},
+	{PartialFreezeUp, "Ледостав неполный", "Partial freeze-up", CategoryIce, true},
+	{IceHummocks, "Торосы", "Ice hummocks", CategoryIce, false},
+	{IceBridge, "Ледяная перемычка", "Ice bridge", CategoryIce, false},
+	{FreezeUp, "Ледостав", "Freeze-up", CategoryIce, false},
+	{WaterOnIce, "Вода на льду", "Water on ice", CategoryIce, false},
+	{DarkenedIce, "Лед потемнел", "Darkened ice", CategoryIce, false},
+	{ShoreLeads, "Закраины", "Shore leads", CategoryIce, false},
+	{IceLifted, "Лед подняло", "Ice lifted", CategoryIce, false},
+	{IceShift, "Подвижка льда", "Ice shift", CategoryIce, false},
+	{OpenLeads, "Разводья", "Open leads", CategoryIce, true},
+	{IceMeltingInPlace, "Лед тает на месте", "Ice melting in place", CategoryIce, false},
+	{ResidualBorderIce, "Забереги остаточные", "Residual border ice", CategoryIce, true},
+	{IcePiledOnBanks, "Навалы льда на берегах", "Ice piled on banks", CategoryIce, false},
+	{IceJamUpstream, "Затор льда выше поста", "Ice jam upstream", CategoryJam, false},
+	{IceJamDownstream, "Затор льда ниже поста", "Ice jam downstream", CategoryJam, false},
+	{FrazilJamUpstream, "Зажор льда выше поста", "Frazil jam upstream", CategoryJam, false},
+	{FrazilJamDownstream, "Зажор льда ниже поста", "Frazil jam downstream", CategoryJam, false},
+	{IceJamBreakUp, "Затор искусственно разрушается", "Ice jam being broken up", CategoryJam, false},
+	{Aufeis, "Наледь", "Aufeis", CategoryIcing, false},
+	{AufeisWater, "Наледная вода", "Aufeis water", CategoryIcing, false},
+	{FrozenToBottom, "Промерзание водного объекта", "Frozen to the bottom", CategoryWater, false},
+	{DriedUp, "Пересыхание водного объекта", "Dried up", CategoryWater, false},
+	{WindSetup, "Нагон воды", "Wind setup", CategoryWater, false},
+	{WindSetdown, "Сгон воды", "Wind setdown", CategoryWater, false},
+	{WaterRelease, "Сброс воды", "Water release", CategoryWater, false},
+	{AquaticVegetationAtBank, "Водная растительность у берегов", "Aquatic vegetation at the banks", CategoryVegetation, true},
+	{OvergrownChannel, "Зарастание русла", "Overgrown channel", CategoryVegetation, true},
+	{DriftwoodRun, "Карчеход", "Driftwood run", CategoryDebris, true},
+	{LogJam, "Залом леса", "Log jam", CategoryDebris, false},
+	{TimberRafting, "Лесосплав", "Timber rafting", CategoryDebris, false},
+	{BankCollapse, "Обвал берега", "Bank collapse", CategoryChannel, false},
+	{ChannelDeformation, "Деформация русла", "Channel deformation", CategoryChannel, false},
+}
+
+var phenomenaByCode = func() map[PhenomenonCode]PhenomenonInfo {
+	byCode := make(map[PhenomenonCode]PhenomenonInfo, len(phenomena))
+	for _, info := range phenomena {
+		byCode[info.Code] = info
+	}
+	return byCode
+}()
+
+// Phenomena returns the whole phenomena table ordered by code.
+func Phenomena() []PhenomenonInfo {
+	return append([]PhenomenonInfo(nil), phenomena...)
+}
+
+// LookupPhenomenon returns the table entry for code.
+func LookupPhenomenon(code PhenomenonCode) (PhenomenonInfo, bool) {
+	info, ok := phenomenaByCode[code]
+	return info, ok
+}
+
+func (ct PhenomenonCode) ToByte() byte {
+	return byte(ct)
+}
+
+func (ct *PhenomenonCode) FromByte(b byte) {
+	*ct = PhenomenonCode(b)
+}
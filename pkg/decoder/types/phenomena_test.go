@@ -0,0 +1,30 @@
+package decoder_types
+
+import "testing"
+
+func TestPhenomenaTable(t *testing.T) {
+	var previous PhenomenonCode
+
+	for _, info := range Phenomena() {
+		if info.Code <= previous {
+			t.Errorf("code %02d is out of order after %02d", info.Code, previous)
+		}
+		previous = info.Code
+
+		if info.Code < 11 || info.Code > 99 {
+			t.Errorf("code %02d cannot be told apart from an intensity", info.Code)
+		}
+		if info.NameRu == "" || info.NameEn == "" || info.Category == "" {
+			t.Errorf("code %02d is not described: %+v", info.Code, info)
+		}
+
+		got, ok := LookupPhenomenon(info.Code)
+		if !ok || got != info {
+			t.Errorf("LookupPhenomenon(%02d) = %+v, %v, want %+v", info.Code, got, ok, info)
+		}
+	}
+
+	if _, ok := LookupPhenomenon(99); ok {
+		t.Errorf("LookupPhenomenon(99) found an unknown code")
+	}
+}
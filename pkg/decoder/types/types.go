@@ -1,22 +1,24 @@
 package decoder_types
 
-import "math"
-
-const (
-	CouldNotMeasure          = math.MinInt32
-	CouldNotMeasureByte byte = 100
-)
-
 type Reservoir struct {
-	HeadwaterLevel        *HeadwaterLevel
-	AverageReservoirLevel *AverageReservoirLevel
-	DownstreamLevel       *DownstreamLevel
-	ReservoirVolume       *ReservoirVolume
+	HeadwaterLevel        Measurement[HeadwaterLevel]
+	AverageReservoirLevel Measurement[AverageReservoirLevel]
+	DownstreamLevel       Measurement[DownstreamLevel]
+	ReservoirVolume       Measurement[ReservoirVolume]
 }
 
 type ReservoirWaterInflow struct {
-	Inflow *Inflow
-	Reset  *Reset
+	Inflow Measurement[Inflow]
+	Reset  Measurement[Reset]
+}
+
+type MeasuredDischarge struct {
+	WaterLevel       Measurement[MeasuredWaterLevel]
+	Discharge        Measurement[Discharge]
+	CrossSectionArea Measurement[CrossSectionArea]
+	AverageVelocity  Measurement[AverageVelocity]
+	MaxDepth         Measurement[MaxDepth]
+	MeasurementTime  *MeasurementTime
 }
 
 type PostCode string
@@ -36,25 +38,25 @@ type DeltaWaterLevel int32
 type WaterLevelOn20h int32
 
 type Temperature struct {
-	WaterTemperature *float64
-	AirTemperature   *int32
+	WaterTemperature Measurement[float64]
+	AirTemperature   Measurement[int32]
 }
 
 type Phenomenia struct {
-	Phenomen    byte
+	Phenomen    PhenomenonCode
 	IsUntensity bool
 	Intensity   *byte
 }
 
 type IceInfo struct {
-	Ice  *int32
-	Snow *SnowHeight
+	Ice  Measurement[int32]
+	Snow Measurement[SnowHeight]
 }
 
 type Waterflow float64
 type Precipitation struct {
-	Value    *float64
-	Duration *PrecipitationDuration
+	Value    Measurement[float64]
+	Duration Measurement[PrecipitationDuration]
 }
 
 type IsReservoirDate byte
@@ -72,3 +74,22 @@ type IsReservoirWaterInflowDate byte
 type Inflow float64
 
 type Reset float64
+
+type IsMeasuredDischargeMonth byte
+
+type MeasuredWaterLevel int32
+
+type Discharge float64
+
+type CrossSectionArea float64
+
+// AverageVelocity is the mean flow velocity in cm/s.
+type AverageVelocity int32
+
+// MaxDepth is the maximum depth of the cross-section in cm.
+type MaxDepth int32
+
+type MeasurementTime struct {
+	Day  byte
+	Hour byte
+}
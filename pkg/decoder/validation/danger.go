@@ -0,0 +1,157 @@
+package validation
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+)
+
+// Severity is the hydrological situation at a station derived from its
+// thresholds.
+type Severity string
+
+const (
+	// SeverityUnknown is used for stations without thresholds and for
+	// telegrams that report neither a level nor an ice jam.
+	SeverityUnknown Severity = ""
+	SeverityNone    Severity = "none"
+	// SeverityFavourable means the level reached the favourable level, the
+	// upper limit of normal conditions.
+	SeverityFavourable Severity = "favourable"
+	SeverityAdverse    Severity = "adverse"
+	SeverityDangerous  Severity = "dangerous"
+)
+
+// Thresholds are the water levels, in cm above the gauge zero, at which the
+// situation at a station changes. A nil level is not checked.
+type Thresholds struct {
+	FavourableLevel *int32
+	AdverseLevel    *int32
+	DangerousLevel  *int32
+	// IceJamLevel is the level at which a reported jam is dangerous. A
+	// jam below it is adverse.
+	IceJamLevel *int32
+	// IceJamPhenomena are the phenomena indicating a jam at the station.
+	// Without them every phenomenon of the jam category does.
+	IceJamPhenomena []types.PhenomenonCode
+}
+
+// IsZero reports whether no threshold is set.
+func (th Thresholds) IsZero() bool {
+	return th.FavourableLevel == nil && th.AdverseLevel == nil && th.DangerousLevel == nil && th.IceJamLevel == nil && len(th.IceJamPhenomena) == 0
+}
+
+// Evaluate returns the severity of t under thresholds, SeverityUnknown
+// when there are none or t has no level and no ice jam to compare with
+// them. The higher of the levels at the observation time and at 20h is
+// compared with the level thresholds.
+func Evaluate(t *decoder.Telegram, thresholds Thresholds) Severity {
+
+	if t == nil || thresholds.IsZero() {
+		return SeverityUnknown
+	}
+
+	onTime, levelOk := t.WaterLevelOnTime.Get()
+	level := int32(onTime)
+	if level20h, ok := t.WaterLevelOn20h.Get(); ok && (!levelOk || int32(level20h) > level) {
+		level, levelOk = int32(level20h), true
+	}
+
+	severity := SeverityNone
+	raise := func(s Severity) {
+		if severityRank(s) > severityRank(severity) {
+			severity = s
+		}
+	}
+	reached := func(threshold *int32) bool {
+		return levelOk && threshold != nil && level >= *threshold
+	}
+
+	if reached(thresholds.FavourableLevel) {
+		raise(SeverityFavourable)
+	}
+	if reached(thresholds.AdverseLevel) {
+		raise(SeverityAdverse)
+	}
+	if reached(thresholds.DangerousLevel) {
+		raise(SeverityDangerous)
+	}
+
+	iceJam := hasIceJam(t, thresholds.IceJamPhenomena)
+	if !levelOk && !iceJam {
+		return SeverityUnknown
+	}
+
+	if iceJam {
+		raise(SeverityAdverse)
+		if reached(thresholds.IceJamLevel) {
+			raise(SeverityDangerous)
+		}
+	}
+
+	return severity
+}
+
+// DangerMismatch reports whether the 977 flag of the sender disagrees with
+// severity: a danger report below the adverse level, or no report at the
+// dangerous one.
+func DangerMismatch(isDangerous bool, severity Severity) bool {
+
+	if severity == SeverityUnknown {
+		return false
+	}
+
+	if isDangerous {
+		return severityRank(severity) < severityRank(SeverityAdverse)
+	}
+
+	return severity == SeverityDangerous
+}
+
+// CheckDanger warns when the 977 flag of t disagrees with severity.
+func CheckDanger(t *decoder.Telegram, severity Severity) Warnings {
+
+	if t == nil || !DangerMismatch(bool(t.IsDangerous), severity) {
+		return nil
+	}
+
+	message := fmt.Sprintf("situation is %s but the telegram has no 97701 group", severity)
+	if t.IsDangerous {
+		message = fmt.Sprintf("telegram has a 97701 group but the situation is %s", severity)
+	}
+
+	return Warnings{{Code: CodeDangerMismatch, Field: FieldIsDangerous, Message: message}}
+}
+
+func severityRank(s Severity) int {
+	switch s {
+	case SeverityNone:
+		return 1
+	case SeverityFavourable:
+		return 2
+	case SeverityAdverse:
+		return 3
+	case SeverityDangerous:
+		return 4
+	}
+	return 0
+}
+
+func hasIceJam(t *decoder.Telegram, indicators []types.PhenomenonCode) bool {
+
+	for _, phenomenon := range t.IcePhenomenia {
+		if len(indicators) != 0 {
+			if slices.Contains(indicators, phenomenon.Phenomen) {
+				return true
+			}
+			continue
+		}
+		if info, ok := types.LookupPhenomenon(phenomenon.Phenomen); ok && info.Category == types.CategoryJam {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+)
+
+func level(v int32) *int32 {
+	return &v
+}
+
+func TestEvaluate(t *testing.T) {
+
+	thresholds := Thresholds{
+		FavourableLevel: level(200),
+		AdverseLevel:    level(300),
+		DangerousLevel:  level(400),
+		IceJamLevel:     level(240),
+	}
+
+	tests := []struct {
+		name       string
+		input      string
+		thresholds Thresholds
+		want       Severity
+		warnings   []Code
+	}{
+		{name: "No thresholds", input: "10950 31081 10245", want: SeverityUnknown},
+		{name: "Below favourable", input: "10950 31081 10145", thresholds: thresholds, want: SeverityNone},
+		{name: "Favourable", input: "10950 31081 10245", thresholds: thresholds, want: SeverityFavourable},
+		{name: "Adverse at 20h", input: "10950 31081 10245 30310", thresholds: thresholds, want: SeverityAdverse},
+		{name: "Dangerous with 977", input: "10950 31081 97701 10400", thresholds: thresholds, want: SeverityDangerous},
+		{name: "Missed danger report", input: "10950 31081 10400", thresholds: thresholds, want: SeverityDangerous, warnings: []Code{CodeDangerMismatch}},
+		{name: "False danger report", input: "10950 31081 97701 10245", thresholds: thresholds, want: SeverityFavourable, warnings: []Code{CodeDangerMismatch}},
+		{name: "Not measured level", input: "10950 31081 97701 1////", thresholds: thresholds, want: SeverityUnknown},
+		{name: "Ice jam without level", input: "10950 31081 97701 1//// 53737", thresholds: thresholds, want: SeverityAdverse},
+		{name: "Ice jam below jam level", input: "10950 31081 10145 53737", thresholds: thresholds, want: SeverityAdverse},
+		{name: "Ice jam above jam level", input: "10950 31081 97701 10245 53737", thresholds: thresholds, want: SeverityDangerous},
+		{
+			name:       "Ice jam not indicating at station",
+			input:      "10950 31081 10145 53737",
+			thresholds: Thresholds{IceJamPhenomena: []types.PhenomenonCode{types.FrazilJamUpstream}},
+			want:       SeverityNone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			telegram, err := decoder.NewTelegram(tt.input)
+			if err != nil {
+				t.Fatalf("NewTelegram() error = %v", err)
+			}
+
+			got := Evaluate(telegram, tt.thresholds)
+			if got != tt.want {
+				t.Errorf("Evaluate() = %q, want %q", got, tt.want)
+			}
+
+			var codes []Code
+			for _, warning := range CheckDanger(telegram, got) {
+				codes = append(codes, warning.Code)
+			}
+			if !reflect.DeepEqual(codes, tt.warnings) {
+				t.Errorf("CheckDanger() = %v, want %v", codes, tt.warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+)
+
+// maxDelta is the largest change group 2 can carry.
+const maxDelta = 999
+
+// Observation is an earlier water level of the same post.
+type Observation struct {
+	DateTime   time.Time
+	WaterLevel int32
+}
+
+// FillDelta sets the change of a telegram without group 2 to the
+// difference between its level and previous. It reports whether the
+// change was set; the returned warning records where it came from.
+func FillDelta(t *decoder.Telegram, previous Observation) (Warning, bool) {
+
+	level, ok := t.WaterLevelOnTime.Get()
+	if !ok || !t.DeltaWaterLevel.IsAbsent() {
+		return Warning{}, false
+	}
+
+	delta := int32(level) - previous.WaterLevel
+	if delta > maxDelta || delta < -maxDelta {
+		return Warning{}, false
+	}
+
+	t.DeltaWaterLevel = types.NewMeasured(types.DeltaWaterLevel(delta))
+
+	return Warning{
+		Code:    CodeDeltaComputed,
+		Field:   FieldDeltaWaterLevel,
+		Message: fmt.Sprintf("change %d cm computed from level %d cm at %s", delta, previous.WaterLevel, previous.DateTime.Format(time.RFC3339)),
+	}, true
+}
+
+// ValidateDelta compares the change reported in t with the difference
+// between its level and previous.
+func ValidateDelta(t *decoder.Telegram, previous Observation, config Config) Warnings {
+
+	level, ok := t.WaterLevelOnTime.Get()
+	if !ok {
+		return nil
+	}
+
+	delta, ok := t.DeltaWaterLevel.Get()
+	if !ok {
+		return nil
+	}
+
+	v := validator{config: config}
+
+	actual := int32(level) - previous.WaterLevel
+	if difference := float64(actual - int32(delta)); difference > config.DeltaTolerance || -difference > config.DeltaTolerance {
+		v.add(CodeDeltaHistoryMismatch, FieldDeltaWaterLevel, "change %d cm does not match %d cm since level %d cm at %s",
+			delta, actual, previous.WaterLevel, previous.DateTime.Format(time.RFC3339))
+	}
+
+	return v.warnings
+}
@@ -0,0 +1,105 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+)
+
+var previousObservation = Observation{DateTime: time.Date(2024, time.March, 30, 8, 0, 0, 0, time.UTC), WaterLevel: 240}
+
+func TestValidateDelta(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Code
+	}{
+		{name: "Matching change", input: "10950 31081 10245 20051"},
+		{name: "Change within tolerance", input: "10950 31081 10245 20071"},
+		{name: "Wrong sign", input: "10950 31081 10245 20052", want: []Code{CodeDeltaHistoryMismatch}},
+		{name: "Wrong change", input: "10950 31081 10245 20201", want: []Code{CodeDeltaHistoryMismatch}},
+		{name: "Missing change", input: "10950 31081 10245"},
+		{name: "Not measured change", input: "10950 31081 10245 2////"},
+		{name: "Not measured level", input: "10950 31081 1//// 20201"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			telegram, err := decoder.NewTelegram(tt.input)
+			if err != nil {
+				t.Fatalf("NewTelegram() error = %v", err)
+			}
+
+			var codes []Code
+			for _, warning := range ValidateDelta(telegram, previousObservation, DefaultConfig()) {
+				codes = append(codes, warning.Code)
+			}
+
+			if !reflect.DeepEqual(codes, tt.want) {
+				t.Errorf("ValidateDelta() = %v, want %v", codes, tt.want)
+			}
+		})
+	}
+}
+
+func TestFillDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		previous Observation
+		want     types.Measurement[types.DeltaWaterLevel]
+		filled   bool
+	}{
+		{
+			name:     "Rise",
+			input:    "10950 31081 10245",
+			previous: previousObservation,
+			want:     types.NewMeasured(types.DeltaWaterLevel(5)),
+			filled:   true,
+		},
+		{
+			name:     "Fall",
+			input:    "10950 31081 10245",
+			previous: Observation{DateTime: previousObservation.DateTime, WaterLevel: 260},
+			want:     types.NewMeasured(types.DeltaWaterLevel(-15)),
+			filled:   true,
+		},
+		{
+			name:     "Reported change is kept",
+			input:    "10950 31081 10245 20201",
+			previous: previousObservation,
+			want:     types.NewMeasured(types.DeltaWaterLevel(20)),
+		},
+		{
+			name:     "Not measured change is kept",
+			input:    "10950 31081 10245 2////",
+			previous: previousObservation,
+			want:     types.NewNotMeasured[types.DeltaWaterLevel](),
+		},
+		{
+			name:     "Change beyond group 2",
+			input:    "10950 31081 10245",
+			previous: Observation{DateTime: previousObservation.DateTime, WaterLevel: -900},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			telegram, err := decoder.NewTelegram(tt.input)
+			if err != nil {
+				t.Fatalf("NewTelegram() error = %v", err)
+			}
+
+			warning, filled := FillDelta(telegram, tt.previous)
+			if filled != tt.filled || telegram.DeltaWaterLevel != tt.want {
+				t.Errorf("FillDelta() = %v, %v, want %v, %v", telegram.DeltaWaterLevel, filled, tt.want, tt.filled)
+			}
+			if filled && (warning.Code != CodeDeltaComputed || warning.Field != FieldDeltaWaterLevel) {
+				t.Errorf("FillDelta() warning = %+v", warning)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+// Package validation checks decoded telegrams for physically implausible
+// values. The decoder only checks syntax; findings of this package are
+// warnings and do not prevent a telegram from being stored.
+package validation
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+	types "github.com/IAmFutureHokage/HL-BufferService/pkg/decoder/types"
+)
+
+type Code string
+
+const (
+	CodeOutOfRange             Code = "out_of_range"
+	CodeIceAfterPhenomenaEnded Code = "ice_after_phenomena_ended"
+	CodeWarmWaterUnderIce      Code = "warm_water_under_ice"
+	CodeIceInWarmAir           Code = "ice_in_warm_air"
+	CodeDeltaMismatch          Code = "delta_mismatch"
+	CodeLevelJump              Code = "level_jump"
+	CodeDeltaHistoryMismatch   Code = "delta_history_mismatch"
+	CodeDeltaComputed          Code = "delta_computed"
+	CodeUnknownStation         Code = "unknown_station"
+	CodeInactiveStation        Code = "inactive_station"
+	CodeUnexpectedSection      Code = "unexpected_section"
+	CodeDangerMismatch         Code = "danger_mismatch"
+)
+
+// Field names used in Config.Ranges and Warning.Field.
+const (
+	FieldPostCode              = "post_code"
+	FieldIsDangerous           = "is_dangerous"
+	FieldWaterLevel            = "water_level"
+	FieldDeltaWaterLevel       = "delta_water_level"
+	FieldWaterLevel20h         = "water_level_20h"
+	FieldWaterTemperature      = "water_temperature"
+	FieldAirTemperature        = "air_temperature"
+	FieldIcePhenomenia         = "ice_phenomenia"
+	FieldIce                   = "ice"
+	FieldWaterflow             = "waterflow"
+	FieldPrecipitation         = "precipitation"
+	FieldHeadwaterLevel        = "headwater_level"
+	FieldAverageReservoirLevel = "average_reservoir_level"
+	FieldDownstreamLevel       = "downstream_level"
+	FieldReservoirVolume       = "reservoir_volume"
+	FieldInflow                = "inflow"
+	FieldReset                 = "reset"
+	FieldMeasuredWaterLevel    = "measured_water_level"
+	FieldDischarge             = "discharge"
+	FieldCrossSectionArea      = "cross_section_area"
+	FieldAverageVelocity       = "average_velocity"
+	FieldMaxDepth              = "max_depth"
+)
+
+// Warning is a single finding. Field names the value that looks wrong; for
+// cross-field checks it is the value that is most likely mistaken.
+type Warning struct {
+	Code    Code   `json:"code"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type Warnings []Warning
+
+// Value implements driver.Valuer, storing the warnings as a JSON array.
+func (w Warnings) Value() (driver.Value, error) {
+
+	if w == nil {
+		w = Warnings{}
+	}
+
+	data, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+// Range is an inclusive range of plausible values.
+type Range struct {
+	Min float64 `mapstructure:"min"`
+	Max float64 `mapstructure:"max"`
+}
+
+// Config holds the limits of the checks. Fields missing from Ranges are not
+// range checked and a zero limit disables its cross-field check.
+type Config struct {
+	Ranges map[string]Range `mapstructure:"ranges"`
+	// MaxWaterTemperatureUnderIce is the warmest water, in °C, expected
+	// under an ice cover.
+	MaxWaterTemperatureUnderIce float64 `mapstructure:"max_water_temperature_under_ice"`
+	// MaxAirTemperatureWithIce is the warmest air, in °C, at which ice
+	// phenomena are expected.
+	MaxAirTemperatureWithIce float64 `mapstructure:"max_air_temperature_with_ice"`
+	// MaxLevelChangeBy20h is the largest change, in cm, between the level
+	// at the observation time and the level at 20h.
+	MaxLevelChangeBy20h float64 `mapstructure:"max_level_change_by_20h"`
+	// DeltaLookback is how far back the observation preceding a telegram
+	// is looked for when its change is compared with stored levels. Zero
+	// disables the comparison.
+	DeltaLookback time.Duration `mapstructure:"delta_lookback"`
+	// DeltaTolerance is the largest accepted difference, in cm, between
+	// the reported change and the change since the preceding observation.
+	DeltaTolerance float64 `mapstructure:"delta_tolerance"`
+}
+
+// DefaultConfig returns limits that hold for most rivers. Stations with
+// unusual regimes need their own configuration.
+func DefaultConfig() Config {
+	return Config{
+		Ranges: map[string]Range{
+			FieldWaterLevel:       {Min: -500, Max: 3000},
+			FieldDeltaWaterLevel:  {Min: -300, Max: 300},
+			FieldWaterLevel20h:    {Min: -500, Max: 3000},
+			FieldWaterTemperature: {Min: 0, Max: 35},
+			FieldAirTemperature:   {Min: -60, Max: 50},
+			FieldIce:              {Min: 0, Max: 300},
+			FieldWaterflow:        {Min: 0, Max: 200000},
+			FieldPrecipitation:    {Min: 0, Max: 300},
+		},
+		MaxWaterTemperatureUnderIce: 2,
+		MaxAirTemperatureWithIce:    15,
+		MaxLevelChangeBy20h:         200,
+		DeltaLookback:               24 * time.Hour,
+		DeltaTolerance:              2,
+	}
+}
+
+// Validate checks t against config and returns its findings in a stable
+// order: range checks first, then cross-field checks.
+func Validate(t *decoder.Telegram, config Config) Warnings {
+
+	if t == nil {
+		return nil
+	}
+
+	v := validator{config: config}
+
+	v.checkRanges(t)
+	v.checkIce(t)
+	v.checkLevels(t)
+
+	return v.warnings
+}
+
+type validator struct {
+	config   Config
+	warnings Warnings
+}
+
+func (v *validator) add(code Code, field string, format string, args ...any) {
+	v.warnings = append(v.warnings, Warning{Code: code, Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) checkRanges(t *decoder.Telegram) {
+
+	values := map[string]float64{}
+
+	setMeasured(values, FieldWaterLevel, t.WaterLevelOnTime)
+	setMeasured(values, FieldDeltaWaterLevel, t.DeltaWaterLevel)
+	setMeasured(values, FieldWaterLevel20h, t.WaterLevelOn20h)
+	setMeasured(values, FieldWaterflow, t.Waterflow)
+
+	if t.Temperature != nil {
+		setMeasured(values, FieldWaterTemperature, t.Temperature.WaterTemperature)
+		setMeasured(values, FieldAirTemperature, t.Temperature.AirTemperature)
+	}
+	if t.IceInfo != nil {
+		setMeasured(values, FieldIce, t.IceInfo.Ice)
+	}
+	if t.Precipitation != nil {
+		setMeasured(values, FieldPrecipitation, t.Precipitation.Value)
+	}
+	if t.Reservoir != nil {
+		setMeasured(values, FieldHeadwaterLevel, t.Reservoir.HeadwaterLevel)
+		setMeasured(values, FieldAverageReservoirLevel, t.Reservoir.AverageReservoirLevel)
+		setMeasured(values, FieldDownstreamLevel, t.Reservoir.DownstreamLevel)
+		setMeasured(values, FieldReservoirVolume, t.Reservoir.ReservoirVolume)
+	}
+	if t.ReservoirWaterInflow != nil {
+		setMeasured(values, FieldInflow, t.ReservoirWaterInflow.Inflow)
+		setMeasured(values, FieldReset, t.ReservoirWaterInflow.Reset)
+	}
+	if t.MeasuredDischarge != nil {
+		setMeasured(values, FieldMeasuredWaterLevel, t.MeasuredDischarge.WaterLevel)
+		setMeasured(values, FieldDischarge, t.MeasuredDischarge.Discharge)
+		setMeasured(values, FieldCrossSectionArea, t.MeasuredDischarge.CrossSectionArea)
+		setMeasured(values, FieldAverageVelocity, t.MeasuredDischarge.AverageVelocity)
+		setMeasured(values, FieldMaxDepth, t.MeasuredDischarge.MaxDepth)
+	}
+
+	fields := make([]string, 0, len(values))
+	for field := range values {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		limits, ok := v.config.Ranges[field]
+		if !ok {
+			continue
+		}
+		if value := values[field]; value < limits.Min || value > limits.Max {
+			v.add(CodeOutOfRange, field, "%s %s is outside %s..%s", field, format(value), format(limits.Min), format(limits.Max))
+		}
+	}
+}
+
+func (v *validator) checkIce(t *decoder.Telegram) {
+
+	var ice int32
+	if t.IceInfo != nil {
+		ice, _ = t.IceInfo.Ice.Get()
+	}
+
+	if ice > 0 && t.IcePhenomeniaState != nil && *t.IcePhenomeniaState == types.Empty {
+		v.add(CodeIceAfterPhenomenaEnded, FieldIce, "ice %d cm is reported together with the end of ice phenomena", ice)
+	}
+
+	if t.Temperature == nil {
+		return
+	}
+
+	if water, ok := t.Temperature.WaterTemperature.Get(); ok && ice > 0 && v.config.MaxWaterTemperatureUnderIce != 0 && water > v.config.MaxWaterTemperatureUnderIce {
+		v.add(CodeWarmWaterUnderIce, FieldWaterTemperature, "water %s °C is warmer than %s °C under %d cm of ice", format(water), format(v.config.MaxWaterTemperatureUnderIce), ice)
+	}
+
+	air, ok := t.Temperature.AirTemperature.Get()
+	if !ok || v.config.MaxAirTemperatureWithIce == 0 || float64(air) <= v.config.MaxAirTemperatureWithIce {
+		return
+	}
+
+	for _, phenomenon := range t.IcePhenomenia {
+		if info, ok := types.LookupPhenomenon(phenomenon.Phenomen); ok && info.Category == types.CategoryIce {
+			v.add(CodeIceInWarmAir, FieldIcePhenomenia, "%s is reported at air temperature %d °C", info.NameEn, air)
+			return
+		}
+	}
+}
+
+func (v *validator) checkLevels(t *decoder.Telegram) {
+
+	level, levelOk := t.WaterLevelOnTime.Get()
+
+	if delta, ok := t.DeltaWaterLevel.Get(); ok && levelOk {
+		if limits, ok := v.config.Ranges[FieldWaterLevel]; ok {
+			previous := float64(level - types.WaterLevelOnTime(delta))
+			if previous < limits.Min || previous > limits.Max {
+				v.add(CodeDeltaMismatch, FieldDeltaWaterLevel, "change %d cm puts the previous level %s outside %s..%s", delta, format(previous), format(limits.Min), format(limits.Max))
+			}
+		}
+	}
+
+	if level20h, ok := t.WaterLevelOn20h.Get(); ok && levelOk && v.config.MaxLevelChangeBy20h != 0 {
+		change := float64(int32(level20h) - int32(level))
+		if change > v.config.MaxLevelChangeBy20h || -change > v.config.MaxLevelChangeBy20h {
+			v.add(CodeLevelJump, FieldWaterLevel20h, "level at 20h differs from level %d cm by %s cm", level, format(change))
+		}
+	}
+}
+
+func setMeasured[T ~int32 | ~float64](values map[string]float64, field string, m types.Measurement[T]) {
+	if value, ok := m.Get(); ok {
+		values[field] = float64(value)
+	}
+}
+
+func format(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
@@ -0,0 +1,117 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/decoder"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		config Config
+		want   []Code
+		fields []string
+	}{
+		{
+			name:   "Plausible telegram",
+			input:  "10950 31081 10245 20011 30250 40410 51206 70353 81225 00032",
+			config: DefaultConfig(),
+		},
+		{
+			name:   "Not measured values are not checked",
+			input:  "10950 31081 1//// 2//// 4//// 7//// 8////",
+			config: DefaultConfig(),
+		},
+		{
+			name:   "Level jump of 900 cm",
+			input:  "10950 31081 10245 29001",
+			config: DefaultConfig(),
+			want:   []Code{CodeOutOfRange, CodeDeltaMismatch},
+			fields: []string{FieldDeltaWaterLevel, FieldDeltaWaterLevel},
+		},
+		{
+			name:   "Warm water under ice",
+			input:  "10950 31081 10245 49915 70803",
+			config: DefaultConfig(),
+			want:   []Code{CodeWarmWaterUnderIce},
+			fields: []string{FieldWaterTemperature},
+		},
+		{
+			name:   "Ice with 60000 state",
+			input:  "10950 31081 10245 60000 70353",
+			config: DefaultConfig(),
+			want:   []Code{CodeIceAfterPhenomenaEnded},
+			fields: []string{FieldIce},
+		},
+		{
+			name:   "Slush in warm air",
+			input:  "10950 31081 10245 40025 51212",
+			config: DefaultConfig(),
+			want:   []Code{CodeIceInWarmAir},
+			fields: []string{FieldIcePhenomenia},
+		},
+		{
+			name:   "Level at 20h far from level at 8h",
+			input:  "10950 31081 10245 30550",
+			config: DefaultConfig(),
+			want:   []Code{CodeLevelJump},
+			fields: []string{FieldWaterLevel20h},
+		},
+		{
+			name:   "Configured range",
+			input:  "10950 31081 10245 96604 21230",
+			config: Config{Ranges: map[string]Range{FieldDischarge: {Min: 5, Max: 10}}},
+			want:   []Code{CodeOutOfRange},
+			fields: []string{FieldDischarge},
+		},
+		{
+			name:   "Disabled cross-field checks",
+			input:  "10950 31081 10245 30550 49915 70803",
+			config: Config{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			telegram, err := decoder.NewTelegram(tt.input)
+			if err != nil {
+				t.Fatalf("NewTelegram() error = %v", err)
+			}
+
+			var codes []Code
+			var fields []string
+			for _, warning := range Validate(telegram, tt.config) {
+				codes = append(codes, warning.Code)
+				fields = append(fields, warning.Field)
+			}
+
+			if !reflect.DeepEqual(codes, tt.want) || !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("Validate() = %v %v, want %v %v", codes, fields, tt.want, tt.fields)
+			}
+		})
+	}
+}
+
+func TestWarningsValue(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		input Warnings
+		want  string
+	}{
+		{name: "Nil", input: nil, want: "[]"},
+		{name: "Warning", input: Warnings{{Code: CodeOutOfRange, Field: FieldIce, Message: "ice"}}, want: `[{"code":"out_of_range","field":"ice","message":"ice"}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.input.Value()
+			if err != nil || got != tt.want {
+				t.Errorf("Value() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
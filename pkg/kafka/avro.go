@@ -0,0 +1,210 @@
+package kafka
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+)
+
+// avroCodec is a parsed Avro schema.
+type avroCodec struct {
+	codec  *goavro.Codec
+	schema any
+}
+
+func newAvroCodec(schema string) (*avroCodec, error) {
+
+	codec, err := goavro.NewCodec(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed any
+	if err := json.Unmarshal([]byte(schema), &parsed); err != nil {
+		return nil, err
+	}
+
+	return &avroCodec{codec: codec, schema: parsed}, nil
+}
+
+// encode converts a JSON payload to Avro binary. Optional values may be
+// given plainly rather than wrapped as Avro JSON unions, timestamps as
+// RFC 3339 strings.
+func (c *avroCodec) encode(payload []byte) ([]byte, error) {
+
+	var value any
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	native, err := avroNative(c.schema, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.codec.BinaryFromNative(nil, native)
+}
+
+// avroNative converts value decoded from JSON to the native form goavro
+// expects for schema. Named types may not be referenced by name.
+func avroNative(schema, value any) (any, error) {
+
+	switch schema := schema.(type) {
+	case string:
+		return avroPrimitive(schema, value)
+
+	case []any:
+		if value == nil {
+			for _, branch := range schema {
+				if branch == "null" {
+					return nil, nil
+				}
+			}
+			return nil, fmt.Errorf("null is not one of %v", schema)
+		}
+		for _, branch := range schema {
+			if branch == "null" {
+				continue
+			}
+			if native, err := avroNative(branch, value); err == nil {
+				return goavro.Union(avroName(branch), native), nil
+			}
+		}
+		return nil, fmt.Errorf("%v is not one of %v", value, schema)
+
+	case map[string]any:
+		if logical, ok := schema["logicalType"]; ok {
+			return avroLogical(logical, value)
+		}
+
+		switch schema["type"] {
+		case "record":
+			fields, _ := schema["fields"].([]any)
+			object, ok := value.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("record %v: %v is not an object", schema["name"], value)
+			}
+			record := make(map[string]any, len(fields))
+			for _, field := range fields {
+				field, _ := field.(map[string]any)
+				name, _ := field["name"].(string)
+				fieldValue, ok := object[name]
+				if !ok {
+					fieldValue = field["default"]
+				}
+				native, err := avroNative(field["type"], fieldValue)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", name, err)
+				}
+				record[name] = native
+			}
+			return record, nil
+
+		case "array":
+			items, _ := value.([]any)
+			if value != nil && items == nil {
+				return nil, fmt.Errorf("%v is not an array", value)
+			}
+			array := make([]any, len(items))
+			for i, item := range items {
+				native, err := avroNative(schema["items"], item)
+				if err != nil {
+					return nil, err
+				}
+				array[i] = native
+			}
+			return array, nil
+
+		default:
+			return avroNative(schema["type"], value)
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported schema %v", schema)
+}
+
+func avroPrimitive(schema string, value any) (any, error) {
+
+	number, _ := value.(json.Number)
+
+	switch schema {
+	case "null":
+		if value != nil {
+			return nil, fmt.Errorf("%v is not null", value)
+		}
+		return nil, nil
+	case "boolean":
+		if b, ok := value.(bool); ok {
+			return b, nil
+		}
+	case "int":
+		if i, err := number.Int64(); err == nil && int64(int32(i)) == i {
+			return int32(i), nil
+		}
+	case "long":
+		if i, err := number.Int64(); err == nil {
+			return i, nil
+		}
+	case "float":
+		if f, err := number.Float64(); err == nil {
+			return float32(f), nil
+		}
+	case "double":
+		if f, err := number.Float64(); err == nil {
+			return f, nil
+		}
+	case "string":
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	case "bytes":
+		if s, ok := value.(string); ok {
+			return []byte(s), nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type %s", schema)
+	}
+
+	return nil, fmt.Errorf("%v is not %s", value, schema)
+}
+
+func avroLogical(logical, value any) (any, error) {
+
+	switch logical {
+	case "timestamp-millis", "timestamp-micros":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a timestamp", value)
+		}
+		return time.Parse(time.RFC3339Nano, s)
+	}
+
+	return nil, fmt.Errorf("unsupported logical type %v", logical)
+}
+
+// avroName is the name goavro gives a union branch.
+func avroName(schema any) string {
+
+	switch schema := schema.(type) {
+	case string:
+		return schema
+	case map[string]any:
+		if logical, ok := schema["logicalType"]; ok {
+			return fmt.Sprintf("%v.%v", schema["type"], logical)
+		}
+		if name, ok := schema["name"].(string); ok {
+			if namespace, ok := schema["namespace"].(string); ok && namespace != "" {
+				return namespace + "." + name
+			}
+			return name
+		}
+		return fmt.Sprint(schema["type"])
+	}
+
+	return ""
+}
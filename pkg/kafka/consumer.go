@@ -0,0 +1,267 @@
+package kafka
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+// Headers of the messages sent to the dead-letter topic.
+const (
+	HeaderError           = "error"
+	HeaderSourceTopic     = "source_topic"
+	HeaderSourcePartition = "source_partition"
+	HeaderSourceOffset    = "source_offset"
+)
+
+type ConsumerConfig struct {
+	Topic string `mapstructure:"topic"`
+	Group string `mapstructure:"group"`
+	// DeadLetterTopic receives the messages the handler rejects. Without
+	// it rejected messages are only reported.
+	DeadLetterTopic string `mapstructure:"dead_letter_topic"`
+	// RetryBackoff is the delay before a message that failed is handled
+	// again or a dead letter that could not be sent is sent again.
+	RetryBackoff time.Duration `mapstructure:"retry_backoff"`
+}
+
+// Handler processes a consumed message. Returning a *DeadLetterError, or
+// several joined with errors.Join, rejects the message; any other error
+// makes the consumer handle it again.
+type Handler func(ctx context.Context, msg *sarama.ConsumerMessage) error
+
+// DeadLetterError rejects a message. Value replaces the value of the
+// message in the dead-letter topic when only a part of it was rejected.
+type DeadLetterError struct {
+	Value []byte
+	Err   error
+}
+
+func (e *DeadLetterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DeadLetterError) Unwrap() error {
+	return e.Err
+}
+
+// DeadLetter rejects the whole message because of err.
+func DeadLetter(err error) error {
+	return &DeadLetterError{Err: err}
+}
+
+// Consumer reads a topic in a consumer group. The offset of a message is
+// committed after the handler returned, so a message is handled again if
+// the service stops before; messages of a partition are handled in order.
+type Consumer struct {
+	group    sarama.ConsumerGroup
+	producer sarama.SyncProducer
+	config   ConsumerConfig
+	handler  Handler
+	report   func(error)
+}
+
+// NewConsumer joins the consumer group. Rejected messages are sent with
+// producer.
+func NewConsumer(brokers []string, config ConsumerConfig, producer sarama.SyncProducer, handler Handler) (*Consumer, error) {
+
+	consumerConfig := sarama.NewConfig()
+	consumerConfig.Consumer.Offsets.AutoCommit.Enable = false
+	consumerConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+	consumerConfig.Consumer.Return.Errors = true
+
+	group, err := sarama.NewConsumerGroup(brokers, config.Group, consumerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return newConsumer(group, config, producer, handler), nil
+}
+
+func newConsumer(group sarama.ConsumerGroup, config ConsumerConfig, producer sarama.SyncProducer, handler Handler) *Consumer {
+
+	if config.RetryBackoff <= 0 {
+		config.RetryBackoff = 5 * time.Second
+	}
+
+	return &Consumer{
+		group:    group,
+		producer: producer,
+		config:   config,
+		handler:  handler,
+		report:   func(error) {},
+	}
+}
+
+// Run consumes until ctx is done. Errors are passed to report.
+func (c *Consumer) Run(ctx context.Context, report func(error)) {
+
+	if report != nil {
+		c.report = report
+	}
+
+	go func() {
+		for err := range c.group.Errors() {
+			c.report(err)
+		}
+	}()
+
+	for ctx.Err() == nil {
+		// Consume returns when the group rebalances.
+		if err := c.group.Consume(ctx, []string{c.config.Topic}, c); err != nil {
+			c.report(err)
+			c.wait(ctx)
+		}
+	}
+}
+
+func (c *Consumer) Close() error {
+	return c.group.Close()
+}
+
+// Setup implements sarama.ConsumerGroupHandler.
+func (c *Consumer) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// Cleanup implements sarama.ConsumerGroupHandler.
+func (c *Consumer) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// ConsumeClaim implements sarama.ConsumerGroupHandler.
+func (c *Consumer) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+
+	ctx := session.Context()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+
+			letters, ok := c.handle(ctx, msg)
+			if !ok {
+				return nil
+			}
+			if !c.deadLetter(ctx, msg, letters) {
+				return nil
+			}
+
+			session.MarkMessage(msg, "")
+			session.Commit()
+		}
+	}
+}
+
+// handle runs the handler until it accepts or rejects msg and returns the
+// rejections. It reports false if ctx was done first.
+func (c *Consumer) handle(ctx context.Context, msg *sarama.ConsumerMessage) ([]*DeadLetterError, bool) {
+
+	for {
+		err := c.handler(ctx, msg)
+		if err == nil {
+			return nil, true
+		}
+
+		if letters, ok := deadLetters(err); ok {
+			return letters, true
+		}
+
+		c.report(err)
+		if !c.wait(ctx) {
+			return nil, false
+		}
+	}
+}
+
+// deadLetter sends the rejections of msg to the dead-letter topic. Only the
+// letters not yet sent are retried: the handler already stored what it
+// accepted and is not run again. It reports false if ctx was done first.
+func (c *Consumer) deadLetter(ctx context.Context, msg *sarama.ConsumerMessage, letters []*DeadLetterError) bool {
+
+	if len(letters) == 0 {
+		return true
+	}
+
+	if c.config.DeadLetterTopic == "" {
+		for _, letter := range letters {
+			c.report(letter)
+		}
+		return true
+	}
+
+	for len(letters) != 0 {
+
+		letter := letters[0]
+
+		value := letter.Value
+		if value == nil {
+			value = msg.Value
+		}
+
+		headers := map[string]string{
+			HeaderError:           letter.Err.Error(),
+			HeaderSourceTopic:     msg.Topic,
+			HeaderSourcePartition: strconv.Itoa(int(msg.Partition)),
+			HeaderSourceOffset:    strconv.FormatInt(msg.Offset, 10),
+		}
+
+		if err := SendKeyedMessage(c.producer, c.config.DeadLetterTopic, string(msg.Key), value, headers); err != nil {
+			c.report(err)
+			if !c.wait(ctx) {
+				return false
+			}
+			continue
+		}
+
+		letters = letters[1:]
+	}
+
+	return true
+}
+
+// wait sleeps for the retry backoff. It reports false if ctx was done
+// first.
+func (c *Consumer) wait(ctx context.Context) bool {
+
+	timer := time.NewTimer(c.config.RetryBackoff)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// deadLetters returns the rejections err consists of. It reports false if
+// err contains anything else.
+func deadLetters(err error) ([]*DeadLetterError, bool) {
+
+	if letter, ok := err.(*DeadLetterError); ok {
+		return []*DeadLetterError{letter}, true
+	}
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return nil, false
+	}
+
+	var letters []*DeadLetterError
+	for _, err := range joined.Unwrap() {
+		more, ok := deadLetters(err)
+		if !ok {
+			return nil, false
+		}
+		letters = append(letters, more...)
+	}
+
+	return letters, len(letters) != 0
+}
@@ -0,0 +1,188 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+)
+
+type testSession struct {
+	sarama.ConsumerGroupSession
+	ctx     context.Context
+	marked  []int64
+	commits int
+}
+
+func (s *testSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+func (s *testSession) Commit() {
+	s.commits++
+}
+
+type testClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *testClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+func TestConsumeClaim(t *testing.T) {
+
+	producer := mocks.NewSyncProducer(t, nil)
+	defer producer.Close()
+
+	var dead []string
+	deadChecker := func(msg *sarama.ProducerMessage) error {
+		value, _ := msg.Value.Encode()
+		headers := map[string]string{}
+		for _, header := range msg.Headers {
+			headers[string(header.Key)] = string(header.Value)
+		}
+		if msg.Topic != "raw.dlq" || headers[HeaderSourceTopic] != "raw" {
+			return fmt.Errorf("unexpected dead letter %v %v", msg.Topic, headers)
+		}
+		dead = append(dead, fmt.Sprintf("%s@%s: %s", value, headers[HeaderSourceOffset], headers[HeaderError]))
+		return nil
+	}
+	for i := 0; i < 3; i++ {
+		producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(deadChecker)
+	}
+
+	calls := map[int64]int{}
+	handler := func(ctx context.Context, msg *sarama.ConsumerMessage) error {
+		calls[msg.Offset]++
+		switch string(msg.Value) {
+		case "bad":
+			return DeadLetter(errors.New("cannot decode"))
+		case "flaky":
+			if calls[msg.Offset] == 1 {
+				return errors.New("storage unavailable")
+			}
+		case "partly bad":
+			return errors.Join(
+				&DeadLetterError{Value: []byte("first"), Err: errors.New("no group 1")},
+				&DeadLetterError{Value: []byte("third"), Err: errors.New("no group 2")},
+			)
+		}
+		return nil
+	}
+
+	consumer := newConsumer(nil, ConsumerConfig{Topic: "raw", DeadLetterTopic: "raw.dlq", RetryBackoff: time.Millisecond}, producer, handler)
+
+	var reported []error
+	consumer.report = func(err error) { reported = append(reported, err) }
+
+	claim := &testClaim{messages: make(chan *sarama.ConsumerMessage, 4)}
+	for i, value := range []string{"good", "bad", "flaky", "partly bad"} {
+		claim.messages <- &sarama.ConsumerMessage{Topic: "raw", Offset: int64(i), Value: []byte(value)}
+	}
+	close(claim.messages)
+
+	session := &testSession{ctx: context.Background()}
+	if err := consumer.ConsumeClaim(session, claim); err != nil {
+		t.Fatalf("ConsumeClaim() error = %v", err)
+	}
+
+	if fmt.Sprint(session.marked) != "[0 1 2 3]" || session.commits != 4 {
+		t.Errorf("marked %v with %d commits, want every offset committed once", session.marked, session.commits)
+	}
+	if calls[2] != 2 {
+		t.Errorf("flaky message handled %d times, want 2", calls[2])
+	}
+	if len(reported) != 1 {
+		t.Errorf("reported %v, want the storage error", reported)
+	}
+	if want := "[bad@1: cannot decode first@3: no group 1 third@3: no group 2]"; fmt.Sprint(dead) != want {
+		t.Errorf("dead letters %v, want %v", dead, want)
+	}
+}
+
+func TestConsumeClaimDeadLetterRetry(t *testing.T) {
+
+	producer := mocks.NewSyncProducer(t, nil)
+	defer producer.Close()
+
+	var dead []string
+	deadChecker := func(msg *sarama.ProducerMessage) error {
+		value, _ := msg.Value.Encode()
+		dead = append(dead, string(value))
+		return nil
+	}
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(deadChecker)
+	producer.ExpectSendMessageAndFail(errors.New("broker unavailable"))
+	producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(deadChecker)
+
+	calls := 0
+	handler := func(ctx context.Context, msg *sarama.ConsumerMessage) error {
+		calls++
+		return errors.Join(
+			&DeadLetterError{Value: []byte("first"), Err: errors.New("no group 1")},
+			&DeadLetterError{Value: []byte("third"), Err: errors.New("no group 2")},
+		)
+	}
+
+	consumer := newConsumer(nil, ConsumerConfig{Topic: "raw", DeadLetterTopic: "raw.dlq", RetryBackoff: time.Millisecond}, producer, handler)
+
+	var reported []error
+	consumer.report = func(err error) { reported = append(reported, err) }
+
+	claim := &testClaim{messages: make(chan *sarama.ConsumerMessage, 1)}
+	claim.messages <- &sarama.ConsumerMessage{Topic: "raw", Value: []byte("partly bad")}
+	close(claim.messages)
+
+	session := &testSession{ctx: context.Background()}
+	if err := consumer.ConsumeClaim(session, claim); err != nil {
+		t.Fatalf("ConsumeClaim() error = %v", err)
+	}
+
+	// The accepted part of the message must not be stored twice.
+	if calls != 1 {
+		t.Errorf("handled %d times, want 1", calls)
+	}
+	if fmt.Sprint(dead) != "[first third]" {
+		t.Errorf("dead letters %v, want each sent once", dead)
+	}
+	if len(reported) != 1 {
+		t.Errorf("reported %v, want the producer error", reported)
+	}
+	if fmt.Sprint(session.marked) != "[0]" || session.commits != 1 {
+		t.Errorf("marked %v with %d commits, want the offset committed once", session.marked, session.commits)
+	}
+}
+
+func TestDeadLetters(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		err   error
+		count int
+		ok    bool
+	}{
+		{name: "single", err: DeadLetter(errors.New("x")), count: 1, ok: true},
+		{name: "joined", err: errors.Join(DeadLetter(errors.New("x")), DeadLetter(errors.New("y"))), count: 2, ok: true},
+		{name: "other", err: errors.New("x")},
+		{name: "mixed", err: errors.Join(DeadLetter(errors.New("x")), errors.New("y"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			letters, ok := deadLetters(tt.err)
+			if len(letters) != tt.count || ok != tt.ok {
+				t.Errorf("deadLetters() = %d letters, %v, want %d, %v", len(letters), ok, tt.count, tt.ok)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package kafka
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/Shopify/sarama"
@@ -10,10 +11,44 @@ import (
 type KafkaConfig struct {
 	BrokerList []string `mapstructure:"broker_list"`
 	Topic      string   `mapstructure:"topic"`
+	// Topics routes parameter families to their own topics. Families
+	// missing from it are published to Topic.
+	Topics map[string]string `mapstructure:"topics"`
+	// Format is the encoding of the payloads: json, protobuf or avro.
+	// Protobuf and Avro need SchemaRegistry.
+	Format         Format               `mapstructure:"format"`
+	SchemaRegistry SchemaRegistryConfig `mapstructure:"schema_registry"`
+	// Consumer reads raw telegrams when its topic is set.
+	Consumer ConsumerConfig `mapstructure:"consumer"`
 }
 
+// TopicFor returns the topic of a parameter family.
+func (c KafkaConfig) TopicFor(family string) string {
+	if topic, ok := c.Topics[family]; ok && topic != "" {
+		return topic
+	}
+	return c.Topic
+}
+
+// Headers of the messages of the service.
+const (
+	HeaderSchemaVersion = "schema_version"
+	HeaderContentType   = "content_type"
+	HeaderTelegramId    = "telegram_id"
+	HeaderGroupId       = "group_id"
+	HeaderTransferId    = "transfer_id"
+	HeaderMessageId     = "message_id"
+)
+
+const ContentTypeJSON = "application/json"
+
 type MessageProducer interface {
 	Serialize() ([]byte, error)
+	// Key selects the partition, so messages with the same key are read in
+	// the order they were sent. An empty key leaves the choice to the
+	// producer.
+	Key() string
+	Headers() map[string]string
 }
 
 func NewKafkaProducer(config KafkaConfig) (sarama.SyncProducer, error) {
@@ -36,15 +71,37 @@ func SendMessageToKafka(producer sarama.SyncProducer, topic string, messageProdu
 		return fmt.Errorf("Ошибка серилизации: %v", err)
 	}
 
+	return SendKeyedMessage(producer, topic, messageProducer.Key(), messageBytes, messageProducer.Headers())
+}
+
+// SendKeyedMessage sends value under key with headers.
+func SendKeyedMessage(producer sarama.SyncProducer, topic, key string, value []byte, headers map[string]string) error {
+
+	if _, _, err := producer.SendMessage(NewMessage(topic, key, value, headers)); err != nil {
+		return fmt.Errorf("Не удалось отправить сообщение в Kafka: %v", err)
+	}
+
+	return nil
+}
+
+// NewMessage builds a message to send. Headers are sorted by name.
+func NewMessage(topic, key string, value []byte, headers map[string]string) *sarama.ProducerMessage {
+
 	msg := &sarama.ProducerMessage{
 		Topic: topic,
-		Value: sarama.StringEncoder(messageBytes),
+		Value: sarama.ByteEncoder(value),
 	}
 
-	_, _, err = producer.SendMessage(msg)
-	if err != nil {
-		return fmt.Errorf("Не удалось отправить сообщение в Kafka: %v", err)
+	if key != "" {
+		msg.Key = sarama.StringEncoder(key)
 	}
 
-	return nil
+	for name, value := range headers {
+		msg.Headers = append(msg.Headers, sarama.RecordHeader{Key: []byte(name), Value: []byte(value)})
+	}
+	sort.Slice(msg.Headers, func(i, j int) bool {
+		return string(msg.Headers[i].Key) < string(msg.Headers[j].Key)
+	})
+
+	return msg
 }
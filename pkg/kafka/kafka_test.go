@@ -0,0 +1,79 @@
+package kafka
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+)
+
+type testMessage struct {
+	key     string
+	headers map[string]string
+}
+
+func (m testMessage) Serialize() ([]byte, error) {
+	return []byte(`{}`), nil
+}
+
+func (m testMessage) Key() string {
+	return m.key
+}
+
+func (m testMessage) Headers() map[string]string {
+	return m.headers
+}
+
+func TestSendMessageToKafka(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		message testMessage
+		key     sarama.Encoder
+		headers string
+	}{
+		{
+			name: "keyed",
+			message: testMessage{key: "10001", headers: map[string]string{
+				HeaderTransferId:    "t",
+				HeaderContentType:   ContentTypeJSON,
+				HeaderSchemaVersion: "1",
+			}},
+			key:     sarama.StringEncoder("10001"),
+			headers: "[content_type=application/json schema_version=1 transfer_id=t]",
+		},
+		{
+			name:    "without key",
+			message: testMessage{},
+			headers: "[]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			producer := mocks.NewSyncProducer(t, nil)
+			defer producer.Close()
+
+			producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+				if msg.Key != tt.key {
+					return fmt.Errorf("key = %v, want %v", msg.Key, tt.key)
+				}
+				headers := []string{}
+				for _, header := range msg.Headers {
+					headers = append(headers, string(header.Key)+"="+string(header.Value))
+				}
+				if got := fmt.Sprint(headers); got != tt.headers {
+					return errors.New("headers = " + got + ", want " + tt.headers)
+				}
+				return nil
+			})
+
+			if err := SendMessageToKafka(producer, "levels", tt.message); err != nil {
+				t.Fatalf("SendMessageToKafka() error = %v", err)
+			}
+		})
+	}
+}
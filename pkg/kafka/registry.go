@@ -0,0 +1,139 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+type SchemaRegistryConfig struct {
+	URL      string        `mapstructure:"url"`
+	Username string        `mapstructure:"username"`
+	Password string        `mapstructure:"password"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+}
+
+type SchemaType string
+
+const (
+	SchemaAvro     SchemaType = "AVRO"
+	SchemaProtobuf SchemaType = "PROTOBUF"
+)
+
+type Schema struct {
+	Type   SchemaType
+	Schema string
+}
+
+// SchemaRegistry is a client of a Confluent compatible schema registry.
+type SchemaRegistry struct {
+	config SchemaRegistryConfig
+	client *http.Client
+
+	mu  sync.Mutex
+	ids map[registration]int
+}
+
+type registration struct {
+	subject string
+	schema  Schema
+}
+
+func NewSchemaRegistry(config SchemaRegistryConfig) *SchemaRegistry {
+
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &SchemaRegistry{
+		config: config,
+		client: &http.Client{Timeout: timeout},
+		ids:    map[registration]int{},
+	}
+}
+
+// Register returns the id of schema under subject, registering it on first
+// use. Ids are cached, so the registry is asked once per schema.
+func (r *SchemaRegistry) Register(ctx context.Context, subject string, schema Schema) (int, error) {
+
+	key := registration{subject: subject, schema: schema}
+
+	r.mu.Lock()
+	id, ok := r.ids[key]
+	r.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	body, err := json.Marshal(struct {
+		Schema     string     `json:"schema"`
+		SchemaType SchemaType `json:"schemaType,omitempty"`
+	}{schema.Schema, schema.Type})
+	if err != nil {
+		return 0, err
+	}
+
+	endpoint := strings.TrimRight(r.config.URL, "/") + "/subjects/" + url.PathEscape(subject) + "/versions"
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	if r.config.Username != "" {
+		request.SetBasicAuth(r.config.Username, r.config.Password)
+	}
+
+	response, err := r.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+
+	var result struct {
+		Id        int    `json:"id"`
+		ErrorCode int    `json:"error_code"`
+		Message   string `json:"message"`
+	}
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil && response.StatusCode/100 == 2 {
+		return 0, fmt.Errorf("schema registry: %w", err)
+	}
+	if response.StatusCode/100 != 2 {
+		return 0, fmt.Errorf("schema registry: subject %s: %s %s", subject, response.Status, result.Message)
+	}
+
+	r.mu.Lock()
+	r.ids[key] = result.Id
+	r.mu.Unlock()
+
+	return result.Id, nil
+}
+
+// Frame prefixes payload with the header of the Confluent wire format: a
+// zero magic byte and the schema id as a big-endian 32-bit integer.
+func Frame(id int, payload []byte) []byte {
+
+	message := make([]byte, 5, 5+len(payload))
+	binary.BigEndian.PutUint32(message[1:], uint32(id))
+
+	return append(message, payload...)
+}
+
+// Unframe splits a message in the Confluent wire format into the schema id
+// and the payload.
+func Unframe(message []byte) (int, []byte, error) {
+
+	if len(message) < 5 || message[0] != 0 {
+		return 0, nil, errors.New("not in the schema registry wire format")
+	}
+
+	return int(binary.BigEndian.Uint32(message[1:5])), message[5:], nil
+}
@@ -0,0 +1,236 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testRegistry stands in for a schema registry. It gives every new schema
+// of a subject the next id.
+type testRegistry struct {
+	mu       sync.Mutex
+	ids      map[string]int
+	requests int
+}
+
+func newTestRegistry(t *testing.T) (*testRegistry, *httptest.Server) {
+
+	registry := &testRegistry{ids: map[string]int{}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+
+		registry.mu.Lock()
+		defer registry.mu.Unlock()
+		registry.requests++
+
+		subject, ok := strings.CutPrefix(r.URL.Path, "/subjects/")
+		subject, ok2 := strings.CutSuffix(subject, "/versions")
+		if r.Method != http.MethodPost || !ok || !ok2 {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]any{"error_code": 40401, "message": "Subject not found"})
+			return
+		}
+
+		var body struct {
+			Schema     string `json:"schema"`
+			SchemaType string `json:"schemaType"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Schema == "" {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(map[string]any{"error_code": 42201, "message": "Invalid schema"})
+			return
+		}
+
+		key := subject + "\x00" + body.SchemaType + "\x00" + body.Schema
+		id, ok := registry.ids[key]
+		if !ok {
+			id = len(registry.ids) + 1
+			registry.ids[key] = id
+		}
+		json.NewEncoder(w).Encode(map[string]int{"id": id})
+	}))
+	t.Cleanup(server.Close)
+
+	return registry, server
+}
+
+func TestSchemaRegistry(t *testing.T) {
+
+	registry, server := newTestRegistry(t)
+	client := NewSchemaRegistry(SchemaRegistryConfig{URL: server.URL})
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		subject string
+		schema  Schema
+		want    int
+		wantErr bool
+	}{
+		{name: "new", subject: "levels-value", schema: Schema{Type: SchemaAvro, Schema: `"int"`}, want: 1},
+		{name: "cached", subject: "levels-value", schema: Schema{Type: SchemaAvro, Schema: `"int"`}, want: 1},
+		{name: "other subject", subject: "ice-value", schema: Schema{Type: SchemaAvro, Schema: `"int"`}, want: 2},
+		{name: "rejected", subject: "ice-value", schema: Schema{Type: SchemaAvro}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := client.Register(ctx, tt.subject, tt.schema)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Register() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if id != tt.want {
+				t.Errorf("Register() = %d, want %d", id, tt.want)
+			}
+		})
+	}
+
+	if registry.requests != 3 {
+		t.Errorf("registry asked %d times, want 3", registry.requests)
+	}
+}
+
+func TestFrame(t *testing.T) {
+
+	framed := Frame(258, []byte("payload"))
+	if want := []byte("\x00\x00\x00\x01\x02payload"); !bytes.Equal(framed, want) {
+		t.Fatalf("Frame() = %q, want %q", framed, want)
+	}
+
+	id, payload, err := Unframe(framed)
+	if err != nil || id != 258 || string(payload) != "payload" {
+		t.Errorf("Unframe() = %d, %q, %v", id, payload, err)
+	}
+
+	for _, message := range [][]byte{nil, []byte("\x01\x00\x00\x00\x01x")} {
+		if _, _, err := Unframe(message); err == nil {
+			t.Errorf("Unframe(%q) error = nil", message)
+		}
+	}
+}
+
+const testAvroSchema = `{
+  "type": "record",
+  "name": "Level",
+  "fields": [
+    {"name": "post_code", "type": "string"},
+    {"name": "date", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+    {"name": "water_level", "type": ["null", "int"], "default": null},
+    {"name": "phenomena", "type": {"type": "array", "items": "int"}, "default": []}
+  ]
+}`
+
+type encodableMessage struct {
+	testMessage
+	payload string
+}
+
+func (m encodableMessage) Serialize() ([]byte, error) {
+	return []byte(m.payload), nil
+}
+
+func (m encodableMessage) AvroSchema() string {
+	return testAvroSchema
+}
+
+func (m encodableMessage) ProtoSchema() (string, proto.Message) {
+	return "syntax = \"proto3\";", &timestamppb.Timestamp{}
+}
+
+func TestSerializer(t *testing.T) {
+
+	_, server := newTestRegistry(t)
+	ctx := context.Background()
+	date := time.Date(2024, time.April, 12, 8, 0, 0, 0, time.UTC)
+
+	if _, err := NewSerializer(KafkaConfig{Format: FormatAvro}); err == nil {
+		t.Error("NewSerializer() without a registry error = nil")
+	}
+
+	t.Run("avro", func(t *testing.T) {
+
+		serializer, err := NewSerializer(KafkaConfig{Format: FormatAvro, SchemaRegistry: SchemaRegistryConfig{URL: server.URL}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		codec, _ := goavro.NewCodec(testAvroSchema)
+
+		tests := []struct {
+			payload string
+			level   any
+			wantErr bool
+		}{
+			{payload: `{"post_code":"10001","date":"2024-04-12T11:00:00+03:00","water_level":120}`, level: map[string]any{"int": int32(120)}},
+			{payload: `{"post_code":"10001","date":"2024-04-12T08:00:00Z"}`, level: nil},
+			{payload: `{"post_code":"10001","date":"2024-04-12T08:00:00Z","water_level":"high"}`, wantErr: true},
+		}
+
+		for _, tt := range tests {
+			encoded, err := serializer.Encode(ctx, "levels", encodableMessage{testMessage{key: "10001"}, tt.payload})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Encode(%s) error = %v, wantErr %v", tt.payload, err, tt.wantErr)
+			}
+			if err != nil {
+				continue
+			}
+
+			value, _ := encoded.Serialize()
+			id, payload, err := Unframe(value)
+			if err != nil || id != 1 {
+				t.Fatalf("Unframe() = %d, %v", id, err)
+			}
+			native, _, err := codec.NativeFromBinary(payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+			record := native.(map[string]any)
+			if !record["date"].(time.Time).Equal(date) || !equalNative(record["water_level"], tt.level) {
+				t.Errorf("decoded %v", record)
+			}
+			if encoded.Key() != "10001" || encoded.Headers()[HeaderContentType] != ContentTypeAvro {
+				t.Errorf("key %q, headers %v", encoded.Key(), encoded.Headers())
+			}
+		}
+	})
+
+	t.Run("protobuf", func(t *testing.T) {
+
+		serializer, err := NewSerializer(KafkaConfig{Format: FormatProtobuf, SchemaRegistry: SchemaRegistryConfig{URL: server.URL}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		encoded, err := serializer.Encode(ctx, "levels", encodableMessage{payload: `"2024-04-12T08:00:00Z"`})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		value, _ := encoded.Serialize()
+		id, payload, err := Unframe(value)
+		if err != nil || id != 2 || payload[0] != 0 {
+			t.Fatalf("Unframe() = %d, %q, %v, want schema 2 and message index 0", id, payload, err)
+		}
+
+		var timestamp timestamppb.Timestamp
+		if err := proto.Unmarshal(payload[1:], &timestamp); err != nil || !timestamp.AsTime().Equal(date) {
+			t.Errorf("decoded %v, %v", timestamp.AsTime(), err)
+		}
+	})
+}
+
+func equalNative(got, want any) bool {
+	gotJSON, _ := json.Marshal(got)
+	wantJSON, _ := json.Marshal(want)
+	return string(gotJSON) == string(wantJSON)
+}
@@ -0,0 +1,215 @@
+package kafka
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Format is the encoding of message payloads.
+type Format string
+
+const (
+	FormatJSON     Format = "json"
+	FormatProtobuf Format = "protobuf"
+	FormatAvro     Format = "avro"
+)
+
+const (
+	ContentTypeProtobuf = "application/x-protobuf"
+	ContentTypeAvro     = "application/avro"
+)
+
+// ProtoEncodable is implemented by messages with a protobuf schema.
+type ProtoEncodable interface {
+	// ProtoSchema returns the source of the .proto file declaring the
+	// message and an empty message to read the JSON payload into.
+	ProtoSchema() (string, proto.Message)
+}
+
+// AvroEncodable is implemented by messages with an Avro schema.
+type AvroEncodable interface {
+	AvroSchema() string
+}
+
+// Serializer encodes messages in the configured format. JSON payloads are
+// sent as Serialize returns them. Protobuf and Avro payloads are converted
+// from JSON and framed with the id their schema has in the registry under
+// the subject <topic>-value.
+type Serializer struct {
+	format   Format
+	registry *SchemaRegistry
+
+	mu     sync.Mutex
+	codecs map[string]*avroCodec
+}
+
+func NewSerializer(config KafkaConfig) (*Serializer, error) {
+
+	switch config.Format {
+	case "", FormatJSON:
+		return &Serializer{format: FormatJSON}, nil
+	case FormatProtobuf, FormatAvro:
+		if config.SchemaRegistry.URL == "" {
+			return nil, fmt.Errorf("format %s needs a schema registry", config.Format)
+		}
+		return &Serializer{
+			format:   config.Format,
+			registry: NewSchemaRegistry(config.SchemaRegistry),
+			codecs:   map[string]*avroCodec{},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unknown format %q", config.Format)
+}
+
+// Encode returns m with its payload in the format of s. A nil Serializer
+// keeps JSON.
+func (s *Serializer) Encode(ctx context.Context, topic string, m MessageProducer) (MessageProducer, error) {
+
+	if s == nil || s.format == FormatJSON {
+		return m, nil
+	}
+
+	payload, err := m.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	subject := topic + "-value"
+	encoded := encodedMessage{MessageProducer: m}
+
+	switch s.format {
+	case FormatProtobuf:
+		encoded.payload, err = s.encodeProto(ctx, subject, m, payload)
+		encoded.contentType = ContentTypeProtobuf
+	case FormatAvro:
+		encoded.payload, err = s.encodeAvro(ctx, subject, m, payload)
+		encoded.contentType = ContentTypeAvro
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return encoded, nil
+}
+
+func (s *Serializer) encodeProto(ctx context.Context, subject string, m MessageProducer, payload []byte) ([]byte, error) {
+
+	encodable, ok := m.(ProtoEncodable)
+	if !ok {
+		return nil, fmt.Errorf("%T has no protobuf schema", m)
+	}
+
+	source, message := encodable.ProtoSchema()
+	if err := protojson.Unmarshal(payload, message); err != nil {
+		return nil, err
+	}
+
+	value, err := proto.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.registry.Register(ctx, subject, Schema{Type: SchemaProtobuf, Schema: source})
+	if err != nil {
+		return nil, err
+	}
+
+	return Frame(id, append(messageIndexes(message.ProtoReflect().Descriptor()), value...)), nil
+}
+
+func (s *Serializer) encodeAvro(ctx context.Context, subject string, m MessageProducer, payload []byte) ([]byte, error) {
+
+	encodable, ok := m.(AvroEncodable)
+	if !ok {
+		return nil, fmt.Errorf("%T has no Avro schema", m)
+	}
+
+	schema := encodable.AvroSchema()
+	codec, err := s.codec(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := codec.encode(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.registry.Register(ctx, subject, Schema{Type: SchemaAvro, Schema: schema})
+	if err != nil {
+		return nil, err
+	}
+
+	return Frame(id, value), nil
+}
+
+func (s *Serializer) codec(schema string) (*avroCodec, error) {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if codec, ok := s.codecs[schema]; ok {
+		return codec, nil
+	}
+
+	codec, err := newAvroCodec(schema)
+	if err != nil {
+		return nil, err
+	}
+	s.codecs[schema] = codec
+
+	return codec, nil
+}
+
+// messageIndexes locates a message in its .proto file as the wire format
+// requires: the count and the indexes of the message and its parents as
+// zigzag varints, or a single zero for the first message of the file.
+func messageIndexes(message protoreflect.MessageDescriptor) []byte {
+
+	var indexes []int
+	for d := protoreflect.Descriptor(message); d != nil; d = d.Parent() {
+		if _, ok := d.(protoreflect.MessageDescriptor); ok {
+			indexes = append([]int{d.Index()}, indexes...)
+		}
+	}
+
+	if len(indexes) == 1 && indexes[0] == 0 {
+		return []byte{0}
+	}
+
+	encoded := binary.AppendVarint(nil, int64(len(indexes)))
+	for _, index := range indexes {
+		encoded = binary.AppendVarint(encoded, int64(index))
+	}
+
+	return encoded
+}
+
+// encodedMessage is a message with its payload converted by a Serializer.
+type encodedMessage struct {
+	MessageProducer
+	payload     []byte
+	contentType string
+}
+
+func (e encodedMessage) Serialize() ([]byte, error) {
+	return e.payload, nil
+}
+
+func (e encodedMessage) Headers() map[string]string {
+
+	headers := map[string]string{}
+	for name, value := range e.MessageProducer.Headers() {
+		headers[name] = value
+	}
+	headers[HeaderContentType] = e.contentType
+
+	return headers
+}
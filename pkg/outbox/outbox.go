@@ -0,0 +1,218 @@
+// Package outbox publishes messages stored in the same transaction as the
+// change they announce. A relay publishes them until the destination
+// accepts them, so every message is delivered at least once; its Id is
+// sent in the message_id header for consumers to drop duplicates.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/publisher"
+	"github.com/google/uuid"
+)
+
+type Message struct {
+	Id       uuid.UUID
+	Topic    string
+	Key      string
+	Headers  map[string]string
+	Payload  []byte
+	Attempts int
+}
+
+// NewMessage returns a message with a new Id carrying the value, key and
+// headers of producer.
+func NewMessage(topic string, producer kafka.MessageProducer) (Message, error) {
+
+	payload, err := producer.Serialize()
+	if err != nil {
+		return Message{}, err
+	}
+
+	return Message{
+		Id:      uuid.New(),
+		Topic:   topic,
+		Key:     producer.Key(),
+		Headers: producer.Headers(),
+		Payload: payload,
+	}, nil
+}
+
+// Store keeps the messages. Messages are added by the stores of the
+// changes they announce, in the same transaction.
+type Store interface {
+	// Claim returns up to limit unsent messages due at now in the order
+	// they were added, and hides them from other claims until lease ends.
+	// A message is held back while an earlier unsent message with the same
+	// key waits for its next attempt or is claimed, also by a relay claiming
+	// at the same time.
+	Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]Message, error)
+	MarkSent(ctx context.Context, id uuid.UUID, at time.Time) error
+	// MarkFailed records a failed attempt and when to try again.
+	MarkFailed(ctx context.Context, id uuid.UUID, attempts int, next time.Time, reason string) error
+	// Purge removes what was sent before.
+	Purge(ctx context.Context, before time.Time) error
+}
+
+type Config struct {
+	// Interval is how often the relay looks for messages.
+	Interval time.Duration `mapstructure:"interval"`
+	// BatchSize is the number of messages claimed at once.
+	BatchSize int `mapstructure:"batch_size"`
+	// Lease is how long claimed messages are hidden from other relays.
+	Lease time.Duration `mapstructure:"lease"`
+	// MinBackoff and MaxBackoff bound the delay after a failed attempt,
+	// which doubles with every attempt.
+	MinBackoff time.Duration `mapstructure:"min_backoff"`
+	MaxBackoff time.Duration `mapstructure:"max_backoff"`
+	// Retention is how long sent messages are kept. Zero keeps them.
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Interval:   time.Second,
+		BatchSize:  100,
+		Lease:      time.Minute,
+		MinBackoff: time.Second,
+		MaxBackoff: 5 * time.Minute,
+		Retention:  7 * 24 * time.Hour,
+	}
+}
+
+// Relay publishes stored messages.
+type Relay struct {
+	store     Store
+	publisher publisher.Publisher
+	config    Config
+	now       func() time.Time
+}
+
+func NewRelay(store Store, publisher publisher.Publisher, config Config) *Relay {
+	return &Relay{
+		store:     store,
+		publisher: publisher,
+		config:    config,
+		now:       time.Now,
+	}
+}
+
+// Flush publishes the messages due now, one batch at a time, until none is
+// left or a batch had failures. It returns the number of messages sent.
+// Messages with the key of a failed one are not published before it.
+func (r *Relay) Flush(ctx context.Context) (int, error) {
+
+	sent := 0
+
+	for {
+		messages, err := r.store.Claim(ctx, r.now(), r.config.Lease, r.config.BatchSize)
+		if err != nil || len(messages) == 0 {
+			return sent, err
+		}
+
+		var errs []error
+		failed := map[string]uuid.UUID{}
+		for _, message := range messages {
+			if id, ok := failed[message.Key]; ok {
+				if err := r.holdBack(ctx, message, id); err != nil {
+					errs = append(errs, err)
+				}
+				continue
+			}
+			if err := r.publish(ctx, message); err != nil {
+				errs = append(errs, err)
+				if message.Key != "" {
+					failed[message.Key] = message.Id
+				}
+				continue
+			}
+			sent++
+		}
+
+		if len(errs) != 0 || len(messages) < r.config.BatchSize {
+			return sent, errors.Join(errs...)
+		}
+	}
+}
+
+// Run flushes every Config.Interval and purges old messages until ctx is
+// done. Errors are passed to report.
+func (r *Relay) Run(ctx context.Context, report func(error)) {
+
+	interval := r.config.Interval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastPurge := time.Time{}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := r.Flush(ctx); err != nil && report != nil {
+			report(err)
+		}
+
+		if now := r.now(); r.config.Retention > 0 && now.Sub(lastPurge) >= time.Hour {
+			lastPurge = now
+			if err := r.store.Purge(ctx, now.Add(-r.config.Retention)); err != nil && report != nil {
+				report(err)
+			}
+		}
+	}
+}
+
+func (r *Relay) publish(ctx context.Context, message Message) error {
+
+	headers := make(map[string]string, len(message.Headers)+1)
+	for name, value := range message.Headers {
+		headers[name] = value
+	}
+	headers[kafka.HeaderMessageId] = message.Id.String()
+
+	err := r.publisher.Publish(ctx, publisher.Message{
+		Topic:   message.Topic,
+		Key:     message.Key,
+		Headers: headers,
+		Value:   message.Payload,
+	})
+	if err == nil {
+		return r.store.MarkSent(ctx, message.Id, r.now())
+	}
+
+	attempts := message.Attempts + 1
+	next := r.now().Add(r.backoff(attempts))
+	if markErr := r.store.MarkFailed(ctx, message.Id, attempts, next, err.Error()); markErr != nil {
+		return errors.Join(err, markErr)
+	}
+
+	return fmt.Errorf("message %s attempt %d: %w", message.Id, attempts, err)
+}
+
+// holdBack releases a message claimed after the failed message with its
+// key without counting an attempt. Claim returns it again once the failed
+// one was sent.
+func (r *Relay) holdBack(ctx context.Context, message Message, failed uuid.UUID) error {
+	return r.store.MarkFailed(ctx, message.Id, message.Attempts, r.now(), fmt.Sprintf("held back behind message %s", failed))
+}
+
+func (r *Relay) backoff(attempts int) time.Duration {
+
+	backoff := r.config.MinBackoff
+	for i := 1; i < attempts && backoff < r.config.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, r.config.MaxBackoff)
+}
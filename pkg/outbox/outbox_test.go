@@ -0,0 +1,228 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/publisher"
+	"github.com/google/uuid"
+)
+
+func TestRelay(t *testing.T) {
+
+	now := time.Date(2024, time.April, 12, 8, 0, 0, 0, time.UTC)
+
+	published := publisher.NewMemoryPublisher()
+
+	store := &memoryStore{}
+	first := Message{Id: uuid.New(), Topic: "levels", Key: "10001", Headers: map[string]string{"schema_version": "1"}, Payload: []byte("1")}
+	second := Message{Id: uuid.New(), Topic: "ice", Key: "10002", Headers: map[string]string{"schema_version": "1"}, Payload: []byte("2")}
+	store.add(first, second)
+
+	relay := NewRelay(store, published, Config{BatchSize: 10, Lease: time.Minute, MinBackoff: time.Second, MaxBackoff: 4 * time.Second})
+	relay.now = func() time.Time { return now }
+
+	published.Hook = func(m publisher.Message) error {
+		if m.Topic == second.Topic {
+			return errors.New("broker unavailable")
+		}
+		return nil
+	}
+
+	if sent, err := relay.Flush(context.Background()); sent != 1 || err == nil {
+		t.Fatalf("Flush() = %d, %v, want 1 and the broker error", sent, err)
+	}
+	if failed := store.get(second.Id); failed.Attempts != 1 || !failed.next.Equal(now.Add(time.Second)) {
+		t.Fatalf("failed message = %+v, want a retry after 1s", failed)
+	}
+
+	// The failed message waits for its backoff.
+	now = now.Add(500 * time.Millisecond)
+	if sent, err := relay.Flush(context.Background()); sent != 0 || err != nil {
+		t.Fatalf("Flush() during backoff = %d, %v", sent, err)
+	}
+
+	now = now.Add(time.Second)
+	published.Hook = nil
+	if sent, err := relay.Flush(context.Background()); sent != 1 || err != nil {
+		t.Fatalf("Flush() after backoff = %d, %v", sent, err)
+	}
+
+	if sent, err := relay.Flush(context.Background()); sent != 0 || err != nil {
+		t.Fatalf("Flush() with everything sent = %d, %v", sent, err)
+	}
+	messages := published.Messages()
+	if len(messages) != 2 {
+		t.Fatalf("published %d messages, want both once", len(messages))
+	}
+	for i, want := range []Message{first, second} {
+		got := messages[i]
+		if got.Topic != want.Topic || got.Key != want.Key || string(got.Value) != string(want.Payload) ||
+			got.Headers["message_id"] != want.Id.String() || got.Headers["schema_version"] != "1" {
+			t.Errorf("published %+v, want %+v", got, want)
+		}
+	}
+}
+
+func TestRelayKeyOrder(t *testing.T) {
+
+	now := time.Date(2024, time.April, 12, 8, 0, 0, 0, time.UTC)
+
+	published := publisher.NewMemoryPublisher()
+
+	store := &memoryStore{}
+	first := Message{Id: uuid.New(), Topic: "levels", Key: "10001", Payload: []byte("1")}
+	second := Message{Id: uuid.New(), Topic: "ice", Key: "10001", Payload: []byte("2")}
+	other := Message{Id: uuid.New(), Topic: "levels", Key: "10002", Payload: []byte("3")}
+	store.add(first, second, other)
+
+	relay := NewRelay(store, published, Config{BatchSize: 10, Lease: time.Minute, MinBackoff: time.Second, MaxBackoff: 4 * time.Second})
+	relay.now = func() time.Time { return now }
+
+	published.Hook = func(m publisher.Message) error {
+		if string(m.Value) == "1" {
+			return errors.New("broker unavailable")
+		}
+		return nil
+	}
+
+	// The second message waits for the first, other posts are not held up.
+	if sent, err := relay.Flush(context.Background()); sent != 1 || err == nil {
+		t.Fatalf("Flush() = %d, %v, want 1 and the broker error", sent, err)
+	}
+	if held := store.get(second.Id); held.Attempts != 0 || held.leased.After(now) {
+		t.Fatalf("held back message = %+v, want it released without an attempt", held)
+	}
+
+	now = now.Add(500 * time.Millisecond)
+	if sent, err := relay.Flush(context.Background()); sent != 0 || err != nil {
+		t.Fatalf("Flush() during backoff = %d, %v", sent, err)
+	}
+
+	now = now.Add(time.Second)
+	published.Hook = nil
+	if sent, err := relay.Flush(context.Background()); sent != 2 || err != nil {
+		t.Fatalf("Flush() after backoff = %d, %v", sent, err)
+	}
+
+	var order []string
+	for _, m := range published.Messages() {
+		order = append(order, string(m.Value))
+	}
+	if strings.Join(order, ",") != "3,1,2" {
+		t.Errorf("published %v, want 3,1,2", order)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+
+	relay := NewRelay(nil, nil, Config{MinBackoff: time.Second, MaxBackoff: 5 * time.Second})
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 3, want: 4 * time.Second},
+		{attempts: 4, want: 5 * time.Second},
+		{attempts: 40, want: 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := relay.backoff(tt.attempts); got != tt.want {
+			t.Errorf("backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+type storedMessage struct {
+	Message
+	order  int
+	next   time.Time
+	leased time.Time
+	sent   bool
+}
+
+type memoryStore struct {
+	mu       sync.Mutex
+	messages []storedMessage
+}
+
+func (m *memoryStore) add(messages ...Message) {
+	for _, message := range messages {
+		m.messages = append(m.messages, storedMessage{Message: message, order: len(m.messages)})
+	}
+}
+
+func (m *memoryStore) get(id uuid.UUID) storedMessage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, message := range m.messages {
+		if message.Id == id {
+			return message
+		}
+	}
+	return storedMessage{}
+}
+
+func (m *memoryStore) Claim(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]Message, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sort.Slice(m.messages, func(i, j int) bool { return m.messages[i].order < m.messages[j].order })
+
+	var claimed []Message
+	waiting := map[string]bool{}
+	for i := range m.messages {
+		message := &m.messages[i]
+		if message.sent {
+			continue
+		}
+		if message.next.After(now) || message.leased.After(now) {
+			waiting[message.Key] = message.Key != ""
+			continue
+		}
+		if waiting[message.Key] || len(claimed) == limit {
+			continue
+		}
+		message.leased = now.Add(lease)
+		claimed = append(claimed, message.Message)
+	}
+
+	return claimed, nil
+}
+
+func (m *memoryStore) MarkSent(ctx context.Context, id uuid.UUID, at time.Time) error {
+	return m.update(id, func(message *storedMessage) { message.sent = true })
+}
+
+func (m *memoryStore) MarkFailed(ctx context.Context, id uuid.UUID, attempts int, next time.Time, reason string) error {
+	return m.update(id, func(message *storedMessage) {
+		message.Attempts = attempts
+		message.next = next
+		message.leased = time.Time{}
+	})
+}
+
+func (m *memoryStore) Purge(ctx context.Context, before time.Time) error {
+	return nil
+}
+
+func (m *memoryStore) update(id uuid.UUID, change func(*storedMessage)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.messages {
+		if m.messages[i].Id == id {
+			change(&m.messages[i])
+			return nil
+		}
+	}
+	return errors.New("not found")
+}
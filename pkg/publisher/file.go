@@ -0,0 +1,74 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+type FileConfig struct {
+	Path string `mapstructure:"path"`
+}
+
+// FilePublisher appends every message to a file as a line of JSON. Values
+// that are JSON are written as they are, others base64 encoded.
+type FilePublisher struct {
+	mu   sync.Mutex
+	file *os.File
+	now  func() time.Time
+}
+
+type fileLine struct {
+	Time        time.Time         `json:"time"`
+	Topic       string            `json:"topic"`
+	Key         string            `json:"key,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Value       json.RawMessage   `json:"value,omitempty"`
+	ValueBase64 []byte            `json:"value_base64,omitempty"`
+}
+
+func NewFilePublisher(config FileConfig) (*FilePublisher, error) {
+
+	file, err := os.OpenFile(config.Path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FilePublisher{file: file, now: time.Now}, nil
+}
+
+// Publish returns when the line was synced to disk.
+func (p *FilePublisher) Publish(ctx context.Context, m Message) error {
+
+	line := fileLine{
+		Time:    p.now(),
+		Topic:   m.Topic,
+		Key:     m.Key,
+		Headers: m.Headers,
+	}
+	if json.Valid(m.Value) {
+		line.Value = m.Value
+	} else {
+		line.ValueBase64 = m.Value
+	}
+
+	encoded, err := json.Marshal(line)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.file.Write(append(encoded, '\n')); err != nil {
+		return err
+	}
+
+	return p.file.Sync()
+}
+
+func (p *FilePublisher) Close() error {
+	return p.file.Close()
+}
@@ -0,0 +1,25 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+	"github.com/Shopify/sarama"
+)
+
+type KafkaPublisher struct {
+	producer sarama.SyncProducer
+}
+
+// NewKafkaPublisher publishes with producer and closes it on Close.
+func NewKafkaPublisher(producer sarama.SyncProducer) *KafkaPublisher {
+	return &KafkaPublisher{producer: producer}
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, m Message) error {
+	return kafka.SendKeyedMessage(p.producer, m.Topic, m.Key, m.Value, m.Headers)
+}
+
+func (p *KafkaPublisher) Close() error {
+	return p.producer.Close()
+}
@@ -0,0 +1,49 @@
+package publisher
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryPublisher keeps the published messages, for tests and for running
+// without any destination.
+type MemoryPublisher struct {
+	// Hook is called with every message before it is kept. An error it
+	// returns is returned by Publish and the message is not kept.
+	Hook func(Message) error
+
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(ctx context.Context, m Message) error {
+
+	if p.Hook != nil {
+		if err := p.Hook(m); err != nil {
+			return err
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.messages = append(p.messages, m)
+
+	return nil
+}
+
+// Messages returns the kept messages in the order they were published.
+func (p *MemoryPublisher) Messages() []Message {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]Message(nil), p.messages...)
+}
+
+func (p *MemoryPublisher) Close() error {
+	return nil
+}
@@ -0,0 +1,67 @@
+package publisher
+
+import (
+	"context"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATSHeaderKey carries Message.Key, NATS messages have no keys.
+const NATSHeaderKey = "key"
+
+type NATSConfig struct {
+	URL string `mapstructure:"url"`
+	// Timeout bounds connecting and waiting for the server to accept a
+	// message.
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+// NATSPublisher publishes messages to the subject named by their topic
+// through core NATS, which delivers them at most once: the server drops a
+// message nobody is subscribed to, and one lost with the connection after
+// Publish returned is not resent.
+type NATSPublisher struct {
+	conn    *nats.Conn
+	timeout time.Duration
+}
+
+func NewNATSPublisher(config NATSConfig) (*NATSPublisher, error) {
+
+	timeout := timeoutOr(config.Timeout, 5*time.Second)
+
+	conn, err := nats.Connect(config.URL, nats.Timeout(timeout))
+	if err != nil {
+		return nil, err
+	}
+
+	return &NATSPublisher{conn: conn, timeout: timeout}, nil
+}
+
+// Publish waits for the server to answer a PING sent after m, so it fails
+// when the connection is lost before m reaches the server. It does not mean
+// any subscriber received m.
+func (p *NATSPublisher) Publish(ctx context.Context, m Message) error {
+
+	msg := nats.NewMsg(m.Topic)
+	msg.Data = m.Value
+	for name, value := range m.Headers {
+		msg.Header.Set(name, value)
+	}
+	if m.Key != "" {
+		msg.Header.Set(NATSHeaderKey, m.Key)
+	}
+
+	if err := p.conn.PublishMsg(msg); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	return p.conn.FlushWithContext(ctx)
+}
+
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
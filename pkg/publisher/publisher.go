@@ -0,0 +1,76 @@
+// Package publisher sends messages to Kafka, NATS, a webhook, a JSON lines
+// file or memory. The destination is chosen through Config, so the service
+// runs without a broker where none is needed.
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+)
+
+type Message struct {
+	// Topic is the Kafka topic or NATS subject.
+	Topic string
+	// Key orders the messages of a Kafka partition. Other publishers send
+	// it as a header.
+	Key     string
+	Headers map[string]string
+	Value   []byte
+}
+
+type Publisher interface {
+	// Publish returns when the destination accepted m.
+	Publish(ctx context.Context, m Message) error
+	Close() error
+}
+
+type Type string
+
+const (
+	TypeKafka   Type = "kafka"
+	TypeNATS    Type = "nats"
+	TypeWebhook Type = "webhook"
+	TypeFile    Type = "file"
+	TypeMemory  Type = "memory"
+)
+
+type Config struct {
+	Type    Type          `mapstructure:"type"`
+	NATS    NATSConfig    `mapstructure:"nats"`
+	Webhook WebhookConfig `mapstructure:"webhook"`
+	File    FileConfig    `mapstructure:"file"`
+}
+
+// New returns the publisher of config.Type, Kafka when it is not set.
+// Kafka publishers connect to the brokers of kafkaConfig.
+func New(config Config, kafkaConfig kafka.KafkaConfig) (Publisher, error) {
+
+	switch config.Type {
+	case "", TypeKafka:
+		producer, err := kafka.NewKafkaProducer(kafkaConfig)
+		if err != nil {
+			return nil, err
+		}
+		return NewKafkaPublisher(producer), nil
+	case TypeNATS:
+		return NewNATSPublisher(config.NATS)
+	case TypeWebhook:
+		return NewWebhookPublisher(config.Webhook), nil
+	case TypeFile:
+		return NewFilePublisher(config.File)
+	case TypeMemory:
+		return NewMemoryPublisher(), nil
+	}
+
+	return nil, fmt.Errorf("unknown publisher type %q", config.Type)
+}
+
+func timeoutOr(timeout, fallback time.Duration) time.Duration {
+	if timeout > 0 {
+		return timeout
+	}
+	return fallback
+}
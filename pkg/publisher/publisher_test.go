@@ -0,0 +1,131 @@
+package publisher
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+)
+
+func TestNew(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		config  Config
+		want    Publisher
+		wantErr bool
+	}{
+		{name: "Memory", config: Config{Type: TypeMemory}, want: &MemoryPublisher{}},
+		{name: "File", config: Config{Type: TypeFile, File: FileConfig{Path: filepath.Join(t.TempDir(), "out.jsonl")}}, want: &FilePublisher{}},
+		{name: "Webhook", config: Config{Type: TypeWebhook, Webhook: WebhookConfig{URL: "http://localhost"}}, want: &WebhookPublisher{}},
+		{name: "Unknown", config: Config{Type: "carrier-pigeon"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.config, kafka.KafkaConfig{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			defer got.Close()
+			if fmt.Sprintf("%T", got) != fmt.Sprintf("%T", tt.want) {
+				t.Errorf("New() = %T, want %T", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilePublisher(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "transfers.jsonl")
+	publisher, err := NewFilePublisher(FileConfig{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messages := []Message{
+		{Topic: "levels", Key: "10950", Headers: map[string]string{kafka.HeaderTransferId: "1"}, Value: []byte(`{"waterlevels":[]}`)},
+		{Topic: "levels", Key: "10951", Value: []byte{0, 1, 2}},
+	}
+	for _, m := range messages {
+		if err := publisher.Publish(context.Background(), m); err != nil {
+			t.Fatalf("Publish() error = %v", err)
+		}
+	}
+	if err := publisher.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var lines []fileLine
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var line fileLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("line %q: %v", scanner.Text(), err)
+		}
+		lines = append(lines, line)
+	}
+
+	if len(lines) != 2 {
+		t.Fatalf("wrote %d lines, want 2", len(lines))
+	}
+	if lines[0].Key != "10950" || string(lines[0].Value) != `{"waterlevels":[]}` || lines[0].Headers[kafka.HeaderTransferId] != "1" {
+		t.Errorf("JSON line = %+v", lines[0])
+	}
+	if lines[1].Value != nil || string(lines[1].ValueBase64) != string([]byte{0, 1, 2}) {
+		t.Errorf("binary line = %+v", lines[1])
+	}
+}
+
+func TestWebhookPublisher(t *testing.T) {
+
+	var received *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		if r.Header.Get(WebhookHeaderTopic) == "closed" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		message Message
+		wantErr bool
+	}{
+		{name: "Accepted", message: Message{Topic: "levels", Key: "10950", Headers: map[string]string{kafka.HeaderContentType: kafka.ContentTypeJSON}, Value: []byte("{}")}},
+		{name: "Rejected", message: Message{Topic: "closed"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publisher := NewWebhookPublisher(WebhookConfig{URL: server.URL})
+			defer publisher.Close()
+			if err := publisher.Publish(context.Background(), tt.message); (err != nil) != tt.wantErr {
+				t.Fatalf("Publish() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if received.Header.Get(WebhookHeaderKey) != tt.message.Key {
+				t.Errorf("%s = %q, want %q", WebhookHeaderKey, received.Header.Get(WebhookHeaderKey), tt.message.Key)
+			}
+			if got := received.Header.Get(WebhookHeaderPrefix + kafka.HeaderContentType); got != tt.message.Headers[kafka.HeaderContentType] {
+				t.Errorf("content type header = %q", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/IAmFutureHokage/HL-BufferService/pkg/kafka"
+)
+
+// Request headers of the webhook publisher. Message headers are sent with
+// WebhookHeaderPrefix before their names.
+const (
+	WebhookHeaderTopic  = "X-Topic"
+	WebhookHeaderKey    = "X-Key"
+	WebhookHeaderPrefix = "X-Header-"
+)
+
+type WebhookConfig struct {
+	URL     string            `mapstructure:"url"`
+	Headers map[string]string `mapstructure:"headers"`
+	Timeout time.Duration     `mapstructure:"timeout"`
+}
+
+// WebhookPublisher posts the value of every message to a URL. Any status
+// other than 2xx is an error.
+type WebhookPublisher struct {
+	config WebhookConfig
+	client *http.Client
+}
+
+func NewWebhookPublisher(config WebhookConfig) *WebhookPublisher {
+	return &WebhookPublisher{
+		config: config,
+		client: &http.Client{Timeout: timeoutOr(config.Timeout, 10*time.Second)},
+	}
+}
+
+func (p *WebhookPublisher) Publish(ctx context.Context, m Message) error {
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.URL, bytes.NewReader(m.Value))
+	if err != nil {
+		return err
+	}
+
+	contentType := m.Headers[kafka.HeaderContentType]
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	request.Header.Set("Content-Type", contentType)
+	request.Header.Set(WebhookHeaderTopic, m.Topic)
+	if m.Key != "" {
+		request.Header.Set(WebhookHeaderKey, m.Key)
+	}
+	for name, value := range m.Headers {
+		request.Header.Set(WebhookHeaderPrefix+name, value)
+	}
+	for name, value := range p.config.Headers {
+		request.Header.Set(name, value)
+	}
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook %s: %s", p.config.URL, response.Status)
+	}
+
+	return nil
+}
+
+func (p *WebhookPublisher) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}